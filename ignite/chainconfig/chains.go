@@ -0,0 +1,79 @@
+package chainconfig
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"strings"
+)
+
+// skippedChainDirs are the directories where chains are not searched.
+var skippedChainDirs = []string{"node_modules", "vendor"}
+
+// LocateChains returns the directories of the chains of a repository with several chains,
+// e.g. chains/mars and chains/venus. The chains are the directories under root with a config
+// file, the directories of a chain are not searched for other chains.
+func LocateChains(root string) (dirs []string, err error) {
+	err = filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.IsDir() || path == root {
+			return nil
+		}
+		if strings.HasPrefix(d.Name(), ".") {
+			return filepath.SkipDir
+		}
+		for _, dir := range skippedChainDirs {
+			if d.Name() == dir {
+				return filepath.SkipDir
+			}
+		}
+
+		if _, err := LocateDefault(path); errors.Is(err, ErrCouldntLocateConfig) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		dirs = append(dirs, path)
+		return filepath.SkipDir
+	})
+	return dirs, err
+}
+
+// LocateChain returns the directory of the chain name of the repository at root. name is
+// the name of the directory of the chain or its path relative to root.
+func LocateChain(root, name string) (dir string, err error) {
+	dirs, err := LocateChains(root)
+	if err != nil {
+		return "", err
+	}
+
+	var (
+		found []string
+		names []string
+	)
+	for _, dir := range dirs {
+		rel, err := filepath.Rel(root, dir)
+		if err != nil {
+			return "", err
+		}
+		if filepath.Clean(name) == rel || name == filepath.Base(dir) {
+			found = append(found, dir)
+		}
+		names = append(names, filepath.ToSlash(rel))
+	}
+
+	switch len(found) {
+	case 0:
+		if len(names) == 0 {
+			return "", fmt.Errorf("chain %s not found: no chain in %s", name, root)
+		}
+		return "", fmt.Errorf("chain %s not found, the chains are: %s", name, strings.Join(names, ", "))
+	case 1:
+		return found[0], nil
+	default:
+		return "", fmt.Errorf("several chains are named %s, use the path of the chain instead: %s", name, strings.Join(names, ", "))
+	}
+}
@@ -0,0 +1,48 @@
+package chainconfig
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocateChain(t *testing.T) {
+	root := t.TempDir()
+	for _, path := range []string{
+		"chains/mars/config.yml",
+		"chains/mars/vue/node_modules/pkg/config.yml",
+		"chains/venus/config.yaml",
+		"chains/venus/testnet/config.yml",
+		"legacy/venus/config.yml",
+		"node_modules/pkg/config.yml",
+		".git/config.yml",
+	} {
+		path = filepath.Join(root, path)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, nil, 0o644))
+	}
+
+	dirs, err := LocateChains(root)
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		filepath.Join(root, "chains/mars"),
+		filepath.Join(root, "chains/venus"),
+		filepath.Join(root, "legacy/venus"),
+	}, dirs)
+
+	dir, err := LocateChain(root, "mars")
+	require.NoError(t, err)
+	require.Equal(t, filepath.Join(root, "chains/mars"), dir)
+
+	dir, err = LocateChain(root, "legacy/venus")
+	require.NoError(t, err)
+	require.Equal(t, filepath.Join(root, "legacy/venus"), dir)
+
+	_, err = LocateChain(root, "venus")
+	require.EqualError(t, err, "several chains are named venus, use the path of the chain instead: chains/mars, chains/venus, legacy/venus")
+
+	_, err = LocateChain(root, "earth")
+	require.EqualError(t, err, "chain earth not found, the chains are: chains/mars, chains/venus, legacy/venus")
+}
@@ -4,13 +4,20 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"net/url"
 	"os"
 	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
 
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
 	"github.com/goccy/go-yaml"
+	"github.com/goccy/go-yaml/parser"
 	"github.com/imdario/mergo"
 
 	"github.com/ignite-hq/cli/ignite/pkg/xfilepath"
+	"github.com/ignite-hq/cli/ignite/pkg/xstrings"
 )
 
 var (
@@ -59,6 +66,9 @@ type Config struct {
 	Accounts  []Account              `yaml:"accounts"`
 	Validator Validator              `yaml:"validator"`
 	Faucet    Faucet                 `yaml:"faucet"`
+	Relayer   Relayer                `yaml:"relayer"`
+	CORS      CORS                   `yaml:"cors"`
+	Contracts []Contract             `yaml:"contracts"`
 	Client    Client                 `yaml:"client"`
 	Build     Build                  `yaml:"build"`
 	Init      Init                   `yaml:"init"`
@@ -86,20 +96,94 @@ type Account struct {
 
 	// The RPCAddress off the chain that account is issued at.
 	RPCAddress string `yaml:"rpc_address,omitempty"`
+
+	// Gas is used by the transactions sent by the account.
+	Gas `yaml:",inline"`
+}
+
+// Gas holds the gas settings of the transactions sent by an account.
+type Gas struct {
+	// GasPrice is the price of a unit of gas, e.g. 0.025stake, the transactions have no
+	// fees when it is empty.
+	GasPrice string `yaml:"gas_price,omitempty"`
+
+	// GasAdjustment is the factor the estimated gas of the transactions is multiplied by.
+	GasAdjustment float64 `yaml:"gas_adjustment,omitempty"`
+
+	// MaxGas is the gas limit of the transactions, their gas is not estimated when it is set.
+	MaxGas uint64 `yaml:"max_gas,omitempty"`
+}
+
+func (g Gas) validate(section string) error {
+	if _, err := sdktypes.ParseDecCoins(g.GasPrice); err != nil {
+		return &ValidationError{fmt.Sprintf("%s gas_price is invalid: %s", section, err)}
+	}
+	if g.GasAdjustment < 0 {
+		return &ValidationError{fmt.Sprintf("%s gas_adjustment cannot be negative", section)}
+	}
+	if g.GasAdjustment > 0 && g.MaxGas > 0 {
+		return &ValidationError{fmt.Sprintf("%s gas_adjustment cannot be used with max_gas", section)}
+	}
+	return nil
 }
 
 // Validator holds info related to validator settings.
 type Validator struct {
 	Name   string `yaml:"name"`
 	Staked string `yaml:"staked"`
+
+	// Signer configures a remote signer for the validator.
+	Signer Signer `yaml:"signer"`
+}
+
+// Signer configures a remote signer, like tmkms or horcrux, that signs blocks for the validator
+// instead of the node.
+type Signer struct {
+	// Address is the address the node listens on for the signer to connect to, e.g.
+	// tcp://127.0.0.1:26659 or unix:///tmp/signer.sock. It sets priv_validator_laddr
+	// in config.toml.
+	Address string `yaml:"address"`
+
+	// Builtin runs a software signer with the validator key of the chain while the chain
+	// is served, so remote signing can be developed without an external signer.
+	Builtin bool `yaml:"builtin"`
 }
 
 // Build holds build configs.
 type Build struct {
 	Main    string   `yaml:"main"`
 	Binary  string   `yaml:"binary"`
+	Tags    []string `yaml:"tags"`
 	LDFlags []string `yaml:"ldflags"`
-	Proto   Proto    `yaml:"proto"`
+
+	// CGO enables or disables cgo when building the app's binary.
+	// When it is not set, the value from the environment is used.
+	CGO *bool `yaml:"cgo"`
+
+	// Mocks enables the generation of mocks for the expected keepers of the app's modules
+	// each time the code is generated from proto files.
+	Mocks bool `yaml:"mocks"`
+
+	Proto Proto `yaml:"proto"`
+
+	// Wasm configures the build of the CosmWasm contracts of the app.
+	Wasm Wasm `yaml:"wasm"`
+}
+
+// Wasm configures the build of the CosmWasm contracts located in the contracts directory of
+// the app, each contract is built by the rust-optimizer in Docker.
+type Wasm struct {
+	// Optimizer builds the contracts when they change while the chain is served.
+	Optimizer bool `yaml:"optimizer"`
+
+	// Image is the Docker image of the optimizer.
+	Image string `yaml:"image"`
+
+	// Store stores the built contracts each time the chain starts from its genesis.
+	Store bool `yaml:"store"`
+
+	// From is the account that stores the contracts, the first account by default.
+	From string `yaml:"from"`
 }
 
 // Proto holds proto build configs.
@@ -112,6 +196,103 @@ type Proto struct {
 	ThirdPartyPaths []string `yaml:"third_party_paths"`
 }
 
+// Relayer configures the IBC relayer run while the chain is served.
+type Relayer struct {
+	// Paths are the ids of the relayer paths, configured with `ignite relayer configure`,
+	// that are linked and relayed while the chain is served. The paths are linked again
+	// each time the state of the chain is reset.
+	Paths []string `yaml:"paths"`
+
+	// Gas is used by the transactions of the relayer on the chain, the relayer doesn't
+	// estimate the gas so gas_adjustment is not supported.
+	Gas `yaml:",inline"`
+}
+
+// Contract declares a CosmWasm contract deployed each time the chain starts from its genesis.
+type Contract struct {
+	// Name identifies the contract, it is the default label of the contract.
+	Name string `yaml:"name"`
+
+	// Code is the path of the source of the contract, e.g. contracts/counter, its wasm code
+	// is the one built by the optimizer.
+	Code string `yaml:"code"`
+
+	// Wasm is the path of the wasm code of the contract, it is used instead of Code.
+	Wasm string `yaml:"wasm"`
+
+	// From is the account that deploys the contract, the first account by default.
+	From string `yaml:"from"`
+
+	Instantiate Instantiate `yaml:"instantiate"`
+
+	// Migrations are applied in order once the contract is instantiated.
+	Migrations []Migration `yaml:"migrations"`
+}
+
+// Instantiate configures the instantiation of a contract.
+type Instantiate struct {
+	// Msg is the instantiate message of the contract.
+	Msg map[string]interface{} `yaml:"msg"`
+
+	// Label is the label of the contract, the name by default.
+	Label string `yaml:"label"`
+
+	// Admin is the account name or the address allowed to migrate the contract, the
+	// deployer by default.
+	Admin string `yaml:"admin"`
+
+	// Funds are the coins sent to the contract.
+	Funds []string `yaml:"funds"`
+}
+
+// Migration migrates a contract to a new code.
+type Migration struct {
+	// Code is the path of the source of the new code of the contract.
+	Code string `yaml:"code"`
+
+	// Wasm is the path of the new wasm code of the contract, it is used instead of Code.
+	Wasm string `yaml:"wasm"`
+
+	// Msg is the migrate message of the contract.
+	Msg map[string]interface{} `yaml:"msg"`
+}
+
+// CORS configures the origins allowed to query the RPC, the API and gRPC-web of the node from
+// browsers.
+type CORS struct {
+	// AllowedOrigins are the allowed origins, e.g. https://app.example.com, all origins
+	// are allowed when it is empty.
+	AllowedOrigins []string `yaml:"allowed_origins"`
+}
+
+// Origins returns the allowed origins, "*" when all origins are allowed.
+func (c CORS) Origins() []string {
+	if len(c.AllowedOrigins) == 0 {
+		return []string{"*"}
+	}
+	return c.AllowedOrigins
+}
+
+// AllowsAll returns true when all origins are allowed.
+func (c CORS) AllowsAll() bool {
+	for _, origin := range c.Origins() {
+		if origin == "*" {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate checks that the allowed origins are valid.
+func (c CORS) Validate() error {
+	for _, origin := range c.AllowedOrigins {
+		if err := validateOrigin(origin); err != nil {
+			return &ValidationError{fmt.Sprintf("invalid cors origin %q: %s", origin, err)}
+		}
+	}
+	return nil
+}
+
 // Client configures code generation for clients.
 type Client struct {
 	// Vuex configures code generation for Vuex.
@@ -161,6 +342,12 @@ type Faucet struct {
 
 	// Port number for faucet server to listen at.
 	Port int `yaml:"port"`
+
+	// RequireAPIKey only serves the requests made with one of the API keys of the faucet.
+	RequireAPIKey bool `yaml:"require_api_key"`
+
+	// Gas is used by the transactions of the faucet.
+	Gas `yaml:",inline"`
 }
 
 // Init overwrites sdk configurations with given values.
@@ -179,6 +366,133 @@ type Init struct {
 
 	// KeyringBackend is the default keyring backend to use for blockchain initialization
 	KeyringBackend string `yaml:"keyring-backend"`
+
+	// GenesisTime overwrites the genesis time of the chain, in RFC3339 format.
+	GenesisTime string `yaml:"genesis_time"`
+
+	// InitialHeight overwrites the height of the first block of the chain.
+	InitialHeight int64 `yaml:"initial_height"`
+
+	// ConsensusParams overwrites the consensus params of the genesis.
+	ConsensusParams ConsensusParams `yaml:"consensus_params"`
+
+	// Node sets the pruning and the mempool of the node.
+	Node Node `yaml:"node"`
+}
+
+// Presets of the node.
+const (
+	// NodePresetLight keeps the latest states and blocks only, for development on small disks.
+	NodePresetLight = "light"
+
+	// NodePresetDefault uses the defaults of the Cosmos SDK and Tendermint.
+	NodePresetDefault = "default"
+
+	// NodePresetArchive keeps all the states and blocks.
+	NodePresetArchive = "archive"
+)
+
+// pruningStrategies are the pruning strategies of the Cosmos SDK.
+var pruningStrategies = []string{"default", "nothing", "everything", "custom"}
+
+// Node holds the pruning and the mempool settings of the node, they overwrite the settings
+// of the preset. Settings that are not set keep the value of app.toml and config.toml.
+type Node struct {
+	// Preset is one of light, default or archive.
+	Preset string `yaml:"preset"`
+
+	// Pruning is the pruning strategy of the states, "custom" uses the pruning-keep-recent
+	// and pruning-interval of app.toml that can be set in init.app.
+	Pruning string `yaml:"pruning"`
+
+	// MinRetainBlocks is the min age of the blocks deleted from Tendermint, 0 keeps all blocks.
+	MinRetainBlocks *int64 `yaml:"min-retain-blocks"`
+
+	// MempoolSize is the max number of transactions of the mempool.
+	MempoolSize *int64 `yaml:"mempool-size"`
+}
+
+// nodePresets are the settings of the presets by name.
+var nodePresets = map[string]Node{
+	NodePresetLight: {
+		Pruning:         "everything",
+		MinRetainBlocks: int64Ptr(1000),
+		MempoolSize:     int64Ptr(1000),
+	},
+	NodePresetDefault: {
+		Pruning:         "default",
+		MinRetainBlocks: int64Ptr(0),
+		MempoolSize:     int64Ptr(5000),
+	},
+	NodePresetArchive: {
+		Pruning:         "nothing",
+		MinRetainBlocks: int64Ptr(0),
+		MempoolSize:     int64Ptr(5000),
+	},
+}
+
+// Resolve returns the settings of the preset overwritten by the settings of n.
+func (n Node) Resolve() Node {
+	resolved := nodePresets[n.Preset]
+	resolved.Preset = n.Preset
+	if n.Pruning != "" {
+		resolved.Pruning = n.Pruning
+	}
+	if n.MinRetainBlocks != nil {
+		resolved.MinRetainBlocks = n.MinRetainBlocks
+	}
+	if n.MempoolSize != nil {
+		resolved.MempoolSize = n.MempoolSize
+	}
+	return resolved
+}
+
+func (n Node) validate() error {
+	if _, ok := nodePresets[n.Preset]; n.Preset != "" && !ok {
+		return &ValidationError{fmt.Sprintf("node preset must be one of light, default or archive, got %q", n.Preset)}
+	}
+	if n.Pruning != "" && !xstrings.SliceContains(pruningStrategies, n.Pruning) {
+		return &ValidationError{fmt.Sprintf("node pruning must be one of %s, got %q", strings.Join(pruningStrategies, ", "), n.Pruning)}
+	}
+	if n.MinRetainBlocks != nil && *n.MinRetainBlocks < 0 {
+		return &ValidationError{"node min-retain-blocks cannot be negative"}
+	}
+	if n.MempoolSize != nil && *n.MempoolSize <= 0 {
+		return &ValidationError{"node mempool-size must be positive"}
+	}
+	return nil
+}
+
+func int64Ptr(i int64) *int64 {
+	return &i
+}
+
+// ConsensusParams holds the consensus params of the genesis. Params that are not set
+// keep their default value.
+type ConsensusParams struct {
+	Block    BlockParams    `yaml:"block"`
+	Evidence EvidenceParams `yaml:"evidence"`
+}
+
+// BlockParams holds the block consensus params.
+type BlockParams struct {
+	// MaxBytes is the max size of a block in bytes.
+	MaxBytes *int64 `yaml:"max_bytes"`
+
+	// MaxGas is the max gas of a block, -1 means unlimited.
+	MaxGas *int64 `yaml:"max_gas"`
+}
+
+// EvidenceParams holds the evidence consensus params.
+type EvidenceParams struct {
+	// MaxAgeNumBlocks is the max age of an evidence in blocks.
+	MaxAgeNumBlocks *int64 `yaml:"max_age_num_blocks"`
+
+	// MaxAgeDuration is the max age of an evidence, e.g. "48h".
+	MaxAgeDuration string `yaml:"max_age_duration"`
+
+	// MaxBytes is the max size of the evidences of a block in bytes.
+	MaxBytes *int64 `yaml:"max_bytes"`
 }
 
 // Host keeps configuration related to started servers.
@@ -213,6 +527,107 @@ func ParseFile(path string) (Config, error) {
 	return Parse(file)
 }
 
+// UpdateFaucetName sets the name of the faucet account in the config file at path.
+// The account that was used by the faucet is renamed too so the new account still
+// gets its coins when the chain is initialized again. Comments and formatting of the
+// file are kept, and the file is replaced in a single step.
+func UpdateFaucetName(path, name string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	conf, err := Parse(strings.NewReader(string(data)))
+	if err != nil {
+		return err
+	}
+
+	file, err := parser.ParseBytes(data, parser.ParseComments)
+	if err != nil {
+		return err
+	}
+
+	paths := []string{"$.faucet.name"}
+	if conf.Faucet.Name != nil {
+		for i, acc := range conf.Accounts {
+			if acc.Name == *conf.Faucet.Name {
+				paths = append(paths, fmt.Sprintf("$.accounts[%d].name", i))
+			}
+		}
+	}
+
+	for _, p := range paths {
+		ypath, err := yaml.PathString(p)
+		if err != nil {
+			return err
+		}
+		if err := ypath.ReplaceWithReader(file, strings.NewReader(name)); err != nil {
+			return err
+		}
+	}
+
+	tmpPath := path + ".tmp"
+	if err := os.WriteFile(tmpPath, []byte(file.String()), 0644); err != nil {
+		return err
+	}
+
+	return os.Rename(tmpPath, path)
+}
+
+// GenesisChanges returns the changes to apply to the genesis of the chain. The values of the
+// genesis section overwrite the genesis time, initial height and consensus params of the
+// init section.
+func (c Config) GenesisChanges() (map[string]interface{}, error) {
+	changes := make(map[string]interface{})
+
+	if c.Init.GenesisTime != "" {
+		changes["genesis_time"] = c.Init.GenesisTime
+	}
+
+	// Tendermint encodes 64-bit integers as strings in the genesis.
+	if c.Init.InitialHeight != 0 {
+		changes["initial_height"] = strconv.FormatInt(c.Init.InitialHeight, 10)
+	}
+
+	setInt := func(params map[string]interface{}, key string, value *int64) {
+		if value != nil {
+			params[key] = strconv.FormatInt(*value, 10)
+		}
+	}
+
+	block := make(map[string]interface{})
+	setInt(block, "max_bytes", c.Init.ConsensusParams.Block.MaxBytes)
+	setInt(block, "max_gas", c.Init.ConsensusParams.Block.MaxGas)
+
+	evidence := make(map[string]interface{})
+	setInt(evidence, "max_age_num_blocks", c.Init.ConsensusParams.Evidence.MaxAgeNumBlocks)
+	setInt(evidence, "max_bytes", c.Init.ConsensusParams.Evidence.MaxBytes)
+	if d := c.Init.ConsensusParams.Evidence.MaxAgeDuration; d != "" {
+		duration, err := time.ParseDuration(d)
+		if err != nil {
+			return nil, err
+		}
+		evidence["max_age_duration"] = strconv.FormatInt(duration.Nanoseconds(), 10)
+	}
+
+	consensusParams := make(map[string]interface{})
+	if len(block) > 0 {
+		consensusParams["block"] = block
+	}
+	if len(evidence) > 0 {
+		consensusParams["evidence"] = evidence
+	}
+	if len(consensusParams) > 0 {
+		changes["consensus_params"] = consensusParams
+	}
+
+	if err := mergo.Merge(&changes, c.Genesis, mergo.WithOverride); err != nil {
+		return nil, err
+	}
+
+	return changes, nil
+}
+
 // validate validates user config.
 func validate(conf Config) error {
 	if len(conf.Accounts) == 0 {
@@ -221,6 +636,84 @@ func validate(conf Config) error {
 	if conf.Validator.Name == "" {
 		return &ValidationError{"validator is required"}
 	}
+	if signer := conf.Validator.Signer; signer.Address != "" || signer.Builtin {
+		if !strings.HasPrefix(signer.Address, "tcp://") && !strings.HasPrefix(signer.Address, "unix://") {
+			return &ValidationError{"validator signer address must start with tcp:// or unix://"}
+		}
+	}
+	if err := conf.CORS.Validate(); err != nil {
+		return err
+	}
+	for _, account := range conf.Accounts {
+		if err := account.Gas.validate(fmt.Sprintf("account %s", account.Name)); err != nil {
+			return err
+		}
+	}
+	if err := conf.Faucet.Gas.validate("faucet"); err != nil {
+		return err
+	}
+	if err := conf.Relayer.Gas.validate("relayer"); err != nil {
+		return err
+	}
+	if conf.Relayer.GasAdjustment != 0 {
+		return &ValidationError{"relayer gas_adjustment is not supported, use max_gas to set the gas limit"}
+	}
+	if err := validateContracts(conf.Contracts); err != nil {
+		return err
+	}
+	if conf.Init.GenesisTime != "" {
+		if _, err := time.Parse(time.RFC3339, conf.Init.GenesisTime); err != nil {
+			return &ValidationError{fmt.Sprintf("genesis_time must be in RFC3339 format: %s", err)}
+		}
+	}
+	if conf.Init.InitialHeight < 0 {
+		return &ValidationError{"initial_height cannot be negative"}
+	}
+	if d := conf.Init.ConsensusParams.Evidence.MaxAgeDuration; d != "" {
+		if _, err := time.ParseDuration(d); err != nil {
+			return &ValidationError{fmt.Sprintf("invalid evidence max_age_duration: %s", err)}
+		}
+	}
+	return conf.Init.Node.validate()
+}
+
+func validateContracts(contracts []Contract) error {
+	names := make(map[string]bool)
+	for _, contract := range contracts {
+		if contract.Name == "" {
+			return &ValidationError{"contract name is required"}
+		}
+		if names[contract.Name] {
+			return &ValidationError{fmt.Sprintf("contract %q is declared more than once", contract.Name)}
+		}
+		names[contract.Name] = true
+
+		if (contract.Code == "") == (contract.Wasm == "") {
+			return &ValidationError{fmt.Sprintf("contract %q requires either code or wasm", contract.Name)}
+		}
+		for i, m := range contract.Migrations {
+			if (m.Code == "") == (m.Wasm == "") {
+				return &ValidationError{fmt.Sprintf("migration %d of contract %q requires either code or wasm", i+1, contract.Name)}
+			}
+		}
+	}
+	return nil
+}
+
+func validateOrigin(origin string) error {
+	if origin == "*" {
+		return nil
+	}
+	u, err := url.Parse(origin)
+	if err != nil {
+		return err
+	}
+	if u.Scheme != "http" && u.Scheme != "https" || u.Host == "" {
+		return errors.New("origin must be * or a http(s) url")
+	}
+	if u.Path != "" && u.Path != "/" {
+		return errors.New("origin cannot have a path")
+	}
 	return nil
 }
 
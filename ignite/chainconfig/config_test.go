@@ -1,6 +1,8 @@
 package chainconfig
 
 import (
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -139,3 +141,269 @@ faucet:
 	require.NoError(t, err)
 	require.Equal(t, ":4700", FaucetHost(conf))
 }
+
+func TestBuildParse(t *testing.T) {
+	confyml := `
+accounts:
+  - name: me
+    coins: ["1000token", "100000000stake"]
+validator:
+  name: me
+  staked: "100000000stake"
+build:
+  main: "cmd/marsd"
+  tags: ["netgo", "ledger"]
+  ldflags: ["-X main.Version=development"]
+  cgo: false
+`
+
+	conf, err := Parse(strings.NewReader(confyml))
+	require.NoError(t, err)
+
+	cgo := false
+	require.Equal(t, "cmd/marsd", conf.Build.Main)
+	require.Equal(t, []string{"netgo", "ledger"}, conf.Build.Tags)
+	require.Equal(t, []string{"-X main.Version=development"}, conf.Build.LDFlags)
+	require.Equal(t, &cgo, conf.Build.CGO)
+	require.Equal(t, DefaultConf.Build.Proto, conf.Build.Proto)
+}
+
+func TestUpdateFaucetName(t *testing.T) {
+	confyml := `# accounts of the chain
+accounts:
+  - name: me
+    coins: ["1000token", "100000000stake"]
+  - name: faucet
+    coins: ["5000token"]
+validator:
+  name: me
+  staked: "100000000stake"
+faucet:
+  name: faucet
+  coins: ["5token"]
+`
+	path := filepath.Join(t.TempDir(), "config.yml")
+	require.NoError(t, os.WriteFile(path, []byte(confyml), 0644))
+
+	require.NoError(t, UpdateFaucetName(path, "faucet2"))
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Contains(t, string(data), "# accounts of the chain")
+
+	conf, err := Parse(strings.NewReader(string(data)))
+	require.NoError(t, err)
+	require.Equal(t, "faucet2", *conf.Faucet.Name)
+	require.Equal(t, "me", conf.Accounts[0].Name)
+	require.Equal(t, "faucet2", conf.Accounts[1].Name)
+	require.Equal(t, []string{"5000token"}, conf.Accounts[1].Coins)
+}
+
+func TestGenesisChanges(t *testing.T) {
+	confyml := `
+accounts:
+  - name: me
+    coins: ["1000token"]
+validator:
+  name: me
+  staked: "100stake"
+init:
+  genesis_time: "2022-06-01T00:00:00Z"
+  initial_height: 100
+  consensus_params:
+    block:
+      max_bytes: 200000
+      max_gas: -1
+    evidence:
+      max_age_duration: "1h"
+genesis:
+  consensus_params:
+    block:
+      max_gas: "40000000"
+`
+
+	conf, err := Parse(strings.NewReader(confyml))
+	require.NoError(t, err)
+
+	changes, err := conf.GenesisChanges()
+	require.NoError(t, err)
+	require.Equal(t, map[string]interface{}{
+		"genesis_time":   "2022-06-01T00:00:00Z",
+		"initial_height": "100",
+		"consensus_params": map[string]interface{}{
+			"block": map[string]interface{}{
+				"max_bytes": "200000",
+				"max_gas":   "40000000",
+			},
+			"evidence": map[string]interface{}{
+				"max_age_duration": "3600000000000",
+			},
+		},
+	}, changes)
+}
+
+func TestParseInvalidInit(t *testing.T) {
+	tests := []struct {
+		name string
+		init string
+	}{
+		{"genesis time", `genesis_time: "2022-06-01"`},
+		{"initial height", `initial_height: -1`},
+		{"evidence max age duration", "consensus_params:\n    evidence:\n      max_age_duration: \"1 day\""},
+		{"node preset", "node:\n    preset: tiny"},
+		{"node pruning", "node:\n    pruning: sometimes"},
+		{"node mempool size", "node:\n    mempool-size: 0"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			confyml := `
+accounts:
+  - name: me
+    coins: ["1000token"]
+validator:
+  name: me
+  staked: "100stake"
+init:
+  ` + tt.init
+
+			_, err := Parse(strings.NewReader(confyml))
+			require.Error(t, err)
+
+			var validationErr *ValidationError
+			require.ErrorAs(t, err, &validationErr)
+		})
+	}
+}
+
+func TestParseCORS(t *testing.T) {
+	confyml := `
+accounts:
+  - name: me
+    coins: ["1000token"]
+validator:
+  name: me
+  staked: "100stake"
+`
+	conf, err := Parse(strings.NewReader(confyml))
+	require.NoError(t, err)
+	require.Equal(t, []string{"*"}, conf.CORS.Origins())
+	require.True(t, conf.CORS.AllowsAll())
+
+	conf, err = Parse(strings.NewReader(confyml + `
+cors:
+  allowed_origins: ["https://app.example.com", "http://localhost:8080"]
+`))
+	require.NoError(t, err)
+	require.Equal(t, []string{"https://app.example.com", "http://localhost:8080"}, conf.CORS.Origins())
+	require.False(t, conf.CORS.AllowsAll())
+
+	for _, origin := range []string{"app.example.com", "ftp://example.com", "https://example.com/app"} {
+		_, err = Parse(strings.NewReader(confyml + "cors:\n  allowed_origins: [\"" + origin + "\"]\n"))
+		var validationErr *ValidationError
+		require.ErrorAs(t, err, &validationErr, origin)
+	}
+}
+
+func TestParseGas(t *testing.T) {
+	confyml := `
+accounts:
+  - name: me
+    coins: ["1000token"]
+    gas_price: 0.025stake
+    gas_adjustment: 1.5
+validator:
+  name: me
+  staked: "100stake"
+faucet:
+  name: me
+  max_gas: 200000
+relayer:
+  gas_price: 0.01stake
+`
+	conf, err := Parse(strings.NewReader(confyml))
+	require.NoError(t, err)
+	require.Equal(t, Gas{GasPrice: "0.025stake", GasAdjustment: 1.5}, conf.Accounts[0].Gas)
+	require.Equal(t, Gas{MaxGas: 200000}, conf.Faucet.Gas)
+	require.Equal(t, Gas{GasPrice: "0.01stake"}, conf.Relayer.Gas)
+
+	for _, invalid := range []string{
+		strings.Replace(confyml, "0.01stake", "stake", 1),
+		strings.Replace(confyml, "max_gas: 200000", "max_gas: 200000\n  gas_adjustment: 1.5", 1),
+		strings.Replace(confyml, "gas_price: 0.01stake", "gas_price: 0.01stake\n  gas_adjustment: 1.5", 1),
+	} {
+		_, err = Parse(strings.NewReader(invalid))
+		var validationErr *ValidationError
+		require.ErrorAs(t, err, &validationErr, invalid)
+	}
+}
+
+func TestParseNode(t *testing.T) {
+	confyml := `
+accounts:
+  - name: me
+    coins: ["1000token"]
+validator:
+  name: me
+  staked: "100stake"
+init:
+  node:
+    preset: light
+    min-retain-blocks: 100
+`
+	conf, err := Parse(strings.NewReader(confyml))
+	require.NoError(t, err)
+
+	node := conf.Init.Node.Resolve()
+	require.Equal(t, NodePresetLight, node.Preset)
+	require.Equal(t, "everything", node.Pruning)
+	require.EqualValues(t, 100, *node.MinRetainBlocks)
+	require.EqualValues(t, 1000, *node.MempoolSize)
+
+	require.Equal(t, Node{}, Node{}.Resolve())
+}
+
+func TestParseContracts(t *testing.T) {
+	confyml := `
+accounts:
+  - name: me
+    coins: ["1000token"]
+validator:
+  name: me
+  staked: "100stake"
+`
+	conf, err := Parse(strings.NewReader(confyml + `
+contracts:
+  - name: counter
+    code: contracts/counter
+    instantiate:
+      msg:
+        count: 0
+      admin: me
+      funds: ["10token"]
+    migrations:
+      - wasm: artifacts/counter_v2.wasm
+        msg:
+          reset: true
+`))
+	require.NoError(t, err)
+	require.Len(t, conf.Contracts, 1)
+	contract := conf.Contracts[0]
+	require.Equal(t, "counter", contract.Name)
+	require.Equal(t, "contracts/counter", contract.Code)
+	require.Equal(t, "me", contract.Instantiate.Admin)
+	require.Equal(t, []string{"10token"}, contract.Instantiate.Funds)
+	require.EqualValues(t, 0, contract.Instantiate.Msg["count"])
+	require.Equal(t, []Migration{{Wasm: "artifacts/counter_v2.wasm", Msg: map[string]interface{}{"reset": true}}}, contract.Migrations)
+
+	for _, contracts := range []string{
+		"  - code: contracts/counter\n",
+		"  - name: counter\n",
+		"  - name: counter\n    code: contracts/counter\n    wasm: counter.wasm\n",
+		"  - name: counter\n    wasm: counter.wasm\n  - name: counter\n    wasm: counter.wasm\n",
+		"  - name: counter\n    wasm: counter.wasm\n    migrations:\n      - msg: {}\n",
+	} {
+		_, err = Parse(strings.NewReader(confyml + "contracts:\n" + contracts))
+		var validationErr *ValidationError
+		require.ErrorAs(t, err, &validationErr, contracts)
+	}
+}
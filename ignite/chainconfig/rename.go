@@ -0,0 +1,294 @@
+package chainconfig
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	crisistypes "github.com/cosmos/cosmos-sdk/x/crisis/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/goccy/go-yaml"
+	"github.com/goccy/go-yaml/ast"
+	"github.com/goccy/go-yaml/parser"
+)
+
+const genesisKey = "genesis"
+
+// Rename sets the chain id and the staking denom of the chain in the config file at path,
+// empty values are left unchanged.
+//
+// The denom replaces the current staking denom in the coins of the accounts, the validator
+// and the faucet, and it is set as the denom of the staking, mint, crisis and gov modules
+// in the genesis so the chain stays consistent. The values are edited in place, so the order
+// of the keys, the comments and the formatting of the file are kept.
+func Rename(path, chainID, denom string) error {
+	if chainID == "" && denom == "" {
+		return errors.New("a chain id or a denom is required")
+	}
+	if denom != "" {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return err
+		}
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	conf, err := Parse(bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+
+	file, err := parser.ParseBytes(data, parser.ParseComments)
+	if err != nil {
+		return err
+	}
+
+	var edits []genesisEdit
+
+	if chainID != "" {
+		edits = append(edits, genesisEdit{[]string{"chain_id"}, chainID})
+	}
+
+	if denom != "" {
+		oldDenom := BondDenom(conf)
+
+		replaceCoin := func(p, coin string) error {
+			c, err := sdk.ParseCoinNormalized(coin)
+			if err != nil || c.Denom != oldDenom {
+				return nil
+			}
+			return replacePath(file, p, fmt.Sprintf("%q", c.Amount.String()+denom))
+		}
+
+		for i, acc := range conf.Accounts {
+			for j, coin := range acc.Coins {
+				if err := replaceCoin(fmt.Sprintf("$.accounts[%d].coins[%d]", i, j), coin); err != nil {
+					return err
+				}
+			}
+		}
+		if err := replaceCoin("$.validator.staked", conf.Validator.Staked); err != nil {
+			return err
+		}
+		for i, coin := range conf.Faucet.Coins {
+			if err := replaceCoin(fmt.Sprintf("$.faucet.coins[%d]", i), coin); err != nil {
+				return err
+			}
+		}
+		for i, coin := range conf.Faucet.CoinsMax {
+			if err := replaceCoin(fmt.Sprintf("$.faucet.coins_max[%d]", i), coin); err != nil {
+				return err
+			}
+		}
+
+		edits = append(edits, genesisDenomEdits(conf.Genesis, denom)...)
+	}
+
+	data = []byte(file.String() + "\n")
+	for _, e := range edits {
+		if data, err = setNode(data, append([]string{genesisKey}, e.keys...), e.value); err != nil {
+			return err
+		}
+	}
+
+	tmpPath := path + ".tmp"
+	if err := os.WriteFile(tmpPath, data, 0644); err != nil {
+		return err
+	}
+
+	return os.Rename(tmpPath, path)
+}
+
+// BondDenom returns the staking denom of the chain.
+func BondDenom(conf Config) string {
+	if denom, ok := genesisValue(conf.Genesis, "app_state", "staking", "params", "bond_denom").(string); ok {
+		return denom
+	}
+	return sdk.DefaultBondDenom
+}
+
+// genesisEdit sets value at keys in the genesis.
+type genesisEdit struct {
+	keys  []string
+	value interface{}
+}
+
+// genesisDenomEdits returns the edits that set denom in the genesis of the modules that depend
+// on the staking denom. Amounts already set in the genesis are kept, the defaults of the modules
+// are used otherwise.
+func genesisDenomEdits(genesis map[string]interface{}, denom string) []genesisEdit {
+	edits := []genesisEdit{
+		{[]string{"app_state", "staking", "params", "bond_denom"}, denom},
+		{[]string{"app_state", "mint", "params", "mint_denom"}, denom},
+		{[]string{"app_state", "crisis", "constant_fee", "denom"}, denom},
+	}
+
+	if genesisValue(genesis, "app_state", "crisis", "constant_fee", "amount") == nil {
+		edits = append(edits, genesisEdit{
+			[]string{"app_state", "crisis", "constant_fee", "amount"},
+			crisistypes.DefaultGenesisState().ConstantFee.Amount.String(),
+		})
+	}
+
+	minDeposit, _ := genesisValue(genesis, "app_state", "gov", "deposit_params", "min_deposit").([]interface{})
+	if len(minDeposit) == 0 {
+		minDeposit = []interface{}{
+			map[string]interface{}{"amount": govtypes.DefaultMinDepositTokens.String()},
+		}
+	}
+	for _, coin := range minDeposit {
+		if c, ok := coin.(map[string]interface{}); ok {
+			c["denom"] = denom
+		}
+	}
+
+	return append(edits, genesisEdit{[]string{"app_state", "gov", "deposit_params", "min_deposit"}, minDeposit})
+}
+
+// genesisValue returns the value at keys in the genesis, nil when it is not set.
+func genesisValue(genesis map[string]interface{}, keys ...string) interface{} {
+	var value interface{} = genesis
+	for _, key := range keys {
+		m, ok := value.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		value = m[key]
+	}
+	return value
+}
+
+func replacePath(file *ast.File, path, value string) error {
+	ypath, err := yaml.PathString(path)
+	if err != nil {
+		return err
+	}
+	return ypath.ReplaceWithReader(file, strings.NewReader(value))
+}
+
+// setNode sets value at keys in the YAML document data. An existing value is replaced and the
+// missing keys are inserted at the end of their parent mapping, the other lines are left
+// unchanged so the order of the keys and the comments are kept.
+func setNode(data []byte, keys []string, value interface{}) ([]byte, error) {
+	file, err := parser.ParseBytes(data, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+
+	// values are written in flow style, so they don't depend on the indentation of the parent.
+	flowValue, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+
+	parent := file.Docs[0].Body
+	for i, key := range keys {
+		path := "$." + strings.Join(keys[:i+1], ".")
+
+		values, ok := mappingValues(parent)
+		if !ok {
+			return nil, fmt.Errorf("%s is not a mapping", strings.Join(keys[:i], "."))
+		}
+
+		var child *ast.MappingValueNode
+		for _, v := range values {
+			if v.Key.String() == key {
+				child = v
+				break
+			}
+		}
+
+		switch {
+		case child != nil && i == len(keys)-1:
+			if err := replacePath(file, path, string(flowValue)); err != nil {
+				return nil, err
+			}
+			return []byte(file.String() + "\n"), nil
+		case child != nil:
+			parent = child.Value
+			continue
+		}
+
+		// the missing keys are nested in a new value of the parent.
+		var missing interface{} = value
+		for j := len(keys) - 1; j > i; j-- {
+			missing = map[string]interface{}{keys[j]: missing}
+		}
+
+		if m, ok := parent.(*ast.MappingNode); ok && m.IsFlowStyle {
+			var parentValue map[string]interface{}
+			if err := yaml.NodeToValue(parent, &parentValue); err != nil {
+				return nil, err
+			}
+			parentValue[key] = missing
+
+			flowParent, err := json.Marshal(parentValue)
+			if err != nil {
+				return nil, err
+			}
+			if err := replacePath(file, "$."+strings.Join(keys[:i], "."), string(flowParent)); err != nil {
+				return nil, err
+			}
+			return []byte(file.String() + "\n"), nil
+		}
+
+		missingYAML, err := yaml.Marshal(map[string]interface{}{key: missing})
+		if err != nil {
+			return nil, err
+		}
+
+		indent := strings.Repeat(" ", values[0].Key.GetToken().Position.Column-1)
+		var inserted []string
+		for _, line := range strings.Split(strings.TrimSuffix(string(missingYAML), "\n"), "\n") {
+			inserted = append(inserted, indent+line)
+		}
+
+		lines := strings.Split(strings.TrimSuffix(string(data), "\n"), "\n")
+		last := lastLine(parent)
+		lines = append(lines[:last], append(inserted, lines[last:]...)...)
+
+		return []byte(strings.Join(lines, "\n") + "\n"), nil
+	}
+
+	return data, nil
+}
+
+// mappingValues returns the values of the mapping node, a mapping with a single value is
+// parsed as a mapping value node.
+func mappingValues(node ast.Node) ([]*ast.MappingValueNode, bool) {
+	switch n := node.(type) {
+	case *ast.MappingNode:
+		return n.Values, len(n.Values) > 0
+	case *ast.MappingValueNode:
+		return []*ast.MappingValueNode{n}, true
+	}
+	return nil, false
+}
+
+// lastLine returns the number of the last line of node.
+func lastLine(node ast.Node) int {
+	var v lastLineVisitor
+	ast.Walk(&v, node)
+	return int(v)
+}
+
+// lastLineVisitor holds the number of the last line of the visited nodes.
+type lastLineVisitor int
+
+func (v *lastLineVisitor) Visit(node ast.Node) ast.Visitor {
+	tk := node.GetToken()
+	if tk == nil {
+		return v
+	}
+	if line := tk.Position.Line + strings.Count(tk.Value, "\n"); line > int(*v) {
+		*v = lastLineVisitor(line)
+	}
+	return v
+}
@@ -0,0 +1,91 @@
+package chainconfig
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRename(t *testing.T) {
+	confyml := `# accounts of the chain
+accounts:
+  - name: me
+    coins: ["1000token", "100000000stake"]
+genesis:
+  # state of the modules
+  app_state:
+    gov:
+      deposit_params:
+        min_deposit: [{amount: "500", denom: "stake"}]
+validator:
+  name: me
+  staked: "100000000stake"
+faucet:
+  name: me
+  coins: ["5token", "10stake"]
+`
+	path := filepath.Join(t.TempDir(), "config.yml")
+	require.NoError(t, os.WriteFile(path, []byte(confyml), 0644))
+
+	require.NoError(t, Rename(path, "mars-1", "umars"))
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Contains(t, string(data), "# accounts of the chain")
+	require.Contains(t, string(data), "# state of the modules")
+
+	// the genesis is edited in place, the order of the sections is kept.
+	require.Less(t, strings.Index(string(data), "genesis:"), strings.Index(string(data), "validator:"))
+
+	conf, err := Parse(strings.NewReader(string(data)))
+	require.NoError(t, err)
+	require.Equal(t, []string{"1000token", "100000000umars"}, conf.Accounts[0].Coins)
+	require.Equal(t, "100000000umars", conf.Validator.Staked)
+	require.Equal(t, []string{"5token", "10umars"}, conf.Faucet.Coins)
+	require.Equal(t, "umars", BondDenom(conf))
+	require.Equal(t, "mars-1", conf.Genesis["chain_id"])
+	require.Equal(t, "umars", genesisValue(conf.Genesis, "app_state", "mint", "params", "mint_denom"))
+	require.Equal(t, "umars", genesisValue(conf.Genesis, "app_state", "crisis", "constant_fee", "denom"))
+	require.Equal(t, []interface{}{
+		map[string]interface{}{"amount": "500", "denom": "umars"},
+	}, genesisValue(conf.Genesis, "app_state", "gov", "deposit_params", "min_deposit"))
+
+	// the chain id is updated alone.
+	require.NoError(t, Rename(path, "mars-2", ""))
+	conf, err = ParseFile(path)
+	require.NoError(t, err)
+	require.Equal(t, "mars-2", conf.Genesis["chain_id"])
+	require.Equal(t, "umars", BondDenom(conf))
+}
+
+func TestRenameWithoutGenesis(t *testing.T) {
+	confyml := `accounts:
+  - name: me
+    coins: ["100000000stake"]
+validator:
+  name: me
+  staked: "100000000stake"
+`
+	path := filepath.Join(t.TempDir(), "config.yml")
+	require.NoError(t, os.WriteFile(path, []byte(confyml), 0644))
+
+	require.NoError(t, Rename(path, "mars-1", "umars"))
+
+	conf, err := ParseFile(path)
+	require.NoError(t, err)
+	require.Equal(t, "mars-1", conf.Genesis["chain_id"])
+	require.Equal(t, "umars", BondDenom(conf))
+	require.Equal(t, "1000", genesisValue(conf.Genesis, "app_state", "crisis", "constant_fee", "amount"))
+	require.Equal(t, []interface{}{
+		map[string]interface{}{"amount": "10000000", "denom": "umars"},
+	}, genesisValue(conf.Genesis, "app_state", "gov", "deposit_params", "min_deposit"))
+}
+
+func TestRenameInvalid(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yml")
+	require.Error(t, Rename(path, "", ""))
+	require.Error(t, Rename(path, "", "1nvalid"))
+}
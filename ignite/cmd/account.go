@@ -35,6 +35,8 @@ Ignite CLI uses accounts to interact with the Ignite blockchain, use an IBC rela
 	c.AddCommand(NewAccountList())
 	c.AddCommand(NewAccountImport())
 	c.AddCommand(NewAccountExport())
+	c.AddCommand(NewAccountFund())
+	c.AddCommand(NewAccountMigrate())
 
 	return c
 }
@@ -83,7 +85,7 @@ func flagSetAccountImportExport() *flag.FlagSet {
 
 func getIsNonInteractive(cmd *cobra.Command) bool {
 	is, _ := cmd.Flags().GetBool(flagNonInteractive)
-	return is
+	return is || cliquiz.IsNonInteractive()
 }
 
 func getPassphrase(cmd *cobra.Command) (string, error) {
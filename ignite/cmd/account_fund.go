@@ -0,0 +1,181 @@
+package ignitecmd
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/spf13/cobra"
+
+	"github.com/ignite-hq/cli/ignite/pkg/addressbook"
+	"github.com/ignite-hq/cli/ignite/pkg/chaincmd"
+	chaincmdrunner "github.com/ignite-hq/cli/ignite/pkg/chaincmd/runner"
+	"github.com/ignite-hq/cli/ignite/pkg/cosmosaccount"
+	"github.com/ignite-hq/cli/ignite/pkg/cosmosfaucet"
+	"github.com/ignite-hq/cli/ignite/services/chain"
+)
+
+const flagFaucet = "faucet"
+
+// NewAccountFund creates a new command to send coins to an account.
+func NewAccountFund() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "fund [name|address]",
+		Short: "Send coins to an account of the local chain or of a remote chain with a faucet",
+		Long: `Send coins to an account of the local chain or of a remote chain with a faucet.
+
+The account is an address, a label of the address book or the name of an account. Names
+are looked up in the keyring of the local chain first and then in the Ignite CLI keyring.
+The addresses of Ignite CLI accounts use the address prefix of the local chain, or the one
+set with --address-prefix.
+
+By default, the coins are sent by the faucet of the chain served from the app in the
+current directory, the faucet server doesn't need to be running. Use --from to send the
+coins from another account of the chain's keyring instead.
+
+To fund an account of a remote chain, pass the URL of its faucet with --faucet.
+
+Sample usages:
+	- ignite account fund alice --amount 10token
+	- ignite account fund alice --amount 10token,5stake --from bob
+	- ignite account fund cosmos1adn9gxjmrc3hrsdx5zpc9sj2ra7kgqkmphf8yw --amount 10uatom --faucet https://faucet.example.com`,
+		Args: cobra.ExactArgs(1),
+		RunE: accountFundHandler,
+	}
+
+	flagSetPath(c)
+	c.Flags().AddFlagSet(flagSetHome())
+	c.Flags().AddFlagSet(flagSetKeyringBackend())
+	c.Flags().AddFlagSet(flagSetAccountPrefixes())
+	c.Flags().String(flagAmount, "", "Coins to send, e.g. 10token,5stake")
+	c.Flags().String(flagFrom, chain.FundFromFaucet, "Faucet or name of the account of the chain's keyring that sends the coins")
+	c.Flags().String(flagFaucet, "", "URL of the faucet of a remote chain")
+
+	return c
+}
+
+func accountFundHandler(cmd *cobra.Command, args []string) error {
+	var (
+		amount, _    = cmd.Flags().GetString(flagAmount)
+		faucetURL, _ = cmd.Flags().GetString(flagFaucet)
+		from         = getFrom(cmd)
+	)
+
+	coins, err := sdk.ParseCoinsNormalized(amount)
+	if err != nil {
+		return err
+	}
+	if coins.Empty() {
+		return fmt.Errorf("--%s is required", flagAmount)
+	}
+
+	if faucetURL != "" {
+		if from != chain.FundFromFaucet {
+			return fmt.Errorf("--%s cannot be used with --%s", flagFrom, flagFaucet)
+		}
+		return fundFromRemoteFaucet(cmd, faucetURL, args[0], coins)
+	}
+
+	c, err := newChainWithHomeFlags(cmd,
+		chain.LogLevel(logLevel(cmd)),
+		chain.KeyringBackend(chaincmd.KeyringBackendTest),
+	)
+	if err != nil {
+		return err
+	}
+
+	chainID, err := c.ID()
+	if err != nil {
+		return err
+	}
+	nameOrAddress, err := addressbook.Resolve(chainID, args[0])
+	if err != nil {
+		return err
+	}
+
+	address, err := c.AccountAddress(cmd.Context(), nameOrAddress)
+	if errors.Is(err, chaincmdrunner.ErrAccountDoesNotExist) {
+		// the address of the account is derived with the prefix of the local chain
+		// unless the prefix is explicitly set.
+		prefix := getAddressPrefix(cmd)
+		if !cmd.Flags().Changed(flagAddressPrefix) {
+			if prefix, err = c.AddressPrefix(cmd.Context()); err != nil {
+				return err
+			}
+		}
+		address, err = igniteAccountAddress(cmd, nameOrAddress, prefix)
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := c.Fund(cmd.Context(), from, address, coins); err != nil {
+		return err
+	}
+
+	fmt.Printf("📨 Sent %s to %s\n", coins, address)
+	return nil
+}
+
+func fundFromRemoteFaucet(cmd *cobra.Command, faucetURL, nameOrAddress string, coins sdk.Coins) error {
+	faucet := cosmosfaucet.NewClient(faucetURL)
+
+	address := nameOrAddress
+	if _, _, err := bech32.DecodeAndConvert(nameOrAddress); err != nil {
+		// labels of the address book are resolved with the chain ID of the faucet.
+		info, err := faucet.FaucetInfo(cmd.Context())
+		if err != nil {
+			return fmt.Errorf("faucet request failed: %w", err)
+		}
+		if address, err = addressbook.Resolve(info.ChainID, nameOrAddress); err != nil {
+			return err
+		}
+		if address == nameOrAddress {
+			if address, err = igniteAccountAddress(cmd, nameOrAddress, getAddressPrefix(cmd)); err != nil {
+				return err
+			}
+		}
+	}
+
+	var coinsStr []string
+	for _, coin := range coins {
+		coinsStr = append(coinsStr, coin.String())
+	}
+
+	res, err := faucet.Transfer(
+		cmd.Context(),
+		cosmosfaucet.NewTransferRequest(address, coinsStr),
+	)
+	if err != nil {
+		return fmt.Errorf("faucet request failed: %w", err)
+	}
+	if res.Error != "" {
+		return fmt.Errorf("faucet request failed: %s", res.Error)
+	}
+
+	fmt.Printf("📨 Sent %s to %s\n", coins, address)
+	return nil
+}
+
+// igniteAccountAddress returns the address with prefix of the account named name from the
+// Ignite CLI keyring.
+func igniteAccountAddress(cmd *cobra.Command, name, prefix string) (string, error) {
+	ca, err := cosmosaccount.New(
+		cosmosaccount.WithKeyringBackend(getKeyringBackend(cmd)),
+	)
+	if err != nil {
+		return "", err
+	}
+
+	return accountAddress(ca, name, prefix)
+}
+
+// accountAddress returns the address with prefix of the account named name from the registry.
+func accountAddress(ca cosmosaccount.Registry, name, prefix string) (string, error) {
+	account, err := ca.GetByName(name)
+	if err != nil {
+		return "", err
+	}
+	return account.Address(prefix), nil
+}
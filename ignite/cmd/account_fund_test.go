@@ -0,0 +1,26 @@
+package ignitecmd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite-hq/cli/ignite/pkg/cosmosaccount"
+)
+
+func TestAccountAddress(t *testing.T) {
+	ca, err := cosmosaccount.NewInMemory()
+	require.NoError(t, err)
+
+	account, _, err := ca.Create("alice")
+	require.NoError(t, err)
+
+	address, err := accountAddress(ca, "alice", "mars")
+	require.NoError(t, err)
+	require.Equal(t, account.Address("mars"), address)
+	require.Regexp(t, "^mars1", address)
+
+	_, err = accountAddress(ca, "bob", "mars")
+	var accErr *cosmosaccount.AccountDoesNotExistError
+	require.ErrorAs(t, err, &accErr)
+}
@@ -38,6 +38,9 @@ func accountImportHandler(cmd *cobra.Command, args []string) error {
 	if secret == "" {
 		if err := cliquiz.Ask(
 			cliquiz.NewQuestion("Your mnemonic or path to your private key", &secret, cliquiz.Required())); err != nil {
+			if errors.Is(err, cliquiz.ErrNonInteractive) {
+				return fmt.Errorf("%w, set --%s", err, flagSecret)
+			}
 			return err
 		}
 	}
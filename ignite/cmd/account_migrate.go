@@ -0,0 +1,104 @@
+package ignitecmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite-hq/cli/ignite/pkg/cliui/entrywriter"
+	"github.com/ignite-hq/cli/ignite/pkg/cosmosaccount"
+)
+
+const (
+	flagTo     = "to"
+	flagDryRun = "dry-run"
+)
+
+func NewAccountMigrate() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "migrate [name]...",
+		Short: "Migrate accounts to another keyring backend",
+		Long: `Copy accounts from a keyring backend to another, e.g. to move the keys created with the
+test backend to the keyring of the operating system:
+
+  ignite account migrate --from test --to os
+
+All the accounts are migrated when no names are given. The accounts keep their names and
+addresses and are not deleted from the source keyring, accounts that already exist in the
+target keyring are skipped. Ledger accounts cannot be migrated.`,
+		RunE: accountMigrateHandler,
+	}
+
+	c.Flags().AddFlagSet(flagSetAccountPrefixes())
+	c.Flags().String(flagFrom, string(cosmosaccount.KeyringTest), "Keyring backend of the accounts")
+	c.Flags().String(flagTo, "", "Keyring backend the accounts are migrated to")
+	c.Flags().Bool(flagDryRun, false, "Show the accounts that would be migrated without migrating them")
+	c.MarkFlagRequired(flagTo)
+
+	return c
+}
+
+func accountMigrateHandler(cmd *cobra.Command, args []string) error {
+	var (
+		to, _     = cmd.Flags().GetString(flagTo)
+		dryRun, _ = cmd.Flags().GetBool(flagDryRun)
+		from      = getFrom(cmd)
+	)
+
+	if from == to {
+		return errors.New("the keyring backends must be different")
+	}
+
+	src, err := cosmosaccount.New(cosmosaccount.WithKeyringBackend(cosmosaccount.KeyringBackend(from)))
+	if err != nil {
+		return err
+	}
+	dst, err := cosmosaccount.New(cosmosaccount.WithKeyringBackend(cosmosaccount.KeyringBackend(to)))
+	if err != nil {
+		return err
+	}
+
+	var accounts []cosmosaccount.Account
+	if len(args) == 0 {
+		if accounts, err = src.List(); err != nil {
+			return err
+		}
+	}
+	for _, name := range args {
+		acc, err := src.GetByName(name)
+		if err != nil {
+			return err
+		}
+		accounts = append(accounts, acc)
+	}
+
+	var (
+		entries [][]string
+		failed  int
+	)
+	for _, acc := range accounts {
+		status := "migrated"
+		switch _, err := dst.GetByName(acc.Name); {
+		case err == nil:
+			status = "exists"
+		case dryRun:
+			status = "to migrate"
+		default:
+			if _, err := src.Migrate(dst, acc.Name); err != nil {
+				status = fmt.Sprintf("failed: %s", err)
+				failed++
+			}
+		}
+		entries = append(entries, []string{acc.Name, acc.Address(getAddressPrefix(cmd)), status})
+	}
+
+	if err := entrywriter.MustWrite(os.Stdout, []string{"name", "address", "status"}, entries...); err != nil {
+		return err
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d account(s) not migrated", failed)
+	}
+	return nil
+}
@@ -0,0 +1,108 @@
+package ignitecmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite-hq/cli/ignite/pkg/addressbook"
+	"github.com/ignite-hq/cli/ignite/pkg/cliui/entrywriter"
+)
+
+// NewAddressBook creates a new address book command that holds some other sub commands
+// related to labeling addresses.
+func NewAddressBook() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "addressbook [command]",
+		Short: "Label the addresses of accounts of chains",
+		Long: `Label the addresses of the accounts of chains to use the labels instead of the addresses.
+
+Labels are saved per chain ID and can be used by:
+  - ignite account fund
+  - ignite chain faucet, for the address argument and the addresses of the file
+  - the ${label} variables of the scenarios of ignite chain test`,
+		Args: cobra.ExactArgs(1),
+	}
+
+	c.AddCommand(NewAddressBookAdd())
+	c.AddCommand(NewAddressBookList())
+
+	return c
+}
+
+// NewAddressBookAdd creates a command to label an address.
+func NewAddressBookAdd() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "add [label] [address]",
+		Short: "Label an address of a chain",
+		Long: `Label an address of a chain, the address of an existing label is replaced.
+
+The chain ID is the chain ID of the app in the current directory unless --chain-id is used.`,
+		Args: cobra.ExactArgs(2),
+		RunE: addressBookAddHandler,
+	}
+
+	flagSetPath(c)
+	c.Flags().String(flagChainID, "", "Chain ID of the address")
+
+	return c
+}
+
+func addressBookAddHandler(cmd *cobra.Command, args []string) error {
+	label, address := args[0], args[1]
+
+	chainID, _ := cmd.Flags().GetString(flagChainID)
+	if chainID == "" {
+		c, err := newChainWithHomeFlags(cmd)
+		if err != nil {
+			return fmt.Errorf("--%s is required outside of a chain's directory: %w", flagChainID, err)
+		}
+		if chainID, err = c.ID(); err != nil {
+			return err
+		}
+	}
+
+	book, err := addressbook.LoadDefault()
+	if err != nil {
+		return err
+	}
+	if err := book.Add(chainID, label, address); err != nil {
+		return err
+	}
+	if err := book.Save(); err != nil {
+		return err
+	}
+
+	fmt.Printf("📒 Labeled %s as %q on %s\n", address, label, chainID)
+	return nil
+}
+
+// NewAddressBookList creates a command to list the labeled addresses.
+func NewAddressBookList() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "list",
+		Short: "List the labeled addresses",
+		Args:  cobra.NoArgs,
+		RunE:  addressBookListHandler,
+	}
+
+	c.Flags().String(flagChainID, "", "Only list the addresses of the chain")
+
+	return c
+}
+
+func addressBookListHandler(cmd *cobra.Command, _ []string) error {
+	chainID, _ := cmd.Flags().GetString(flagChainID)
+
+	book, err := addressbook.LoadDefault()
+	if err != nil {
+		return err
+	}
+
+	var entries [][]string
+	for _, entry := range book.Entries(chainID) {
+		entries = append(entries, []string{entry.ChainID, entry.Label, entry.Address})
+	}
+	return entrywriter.MustWrite(os.Stdout, []string{"chain id", "label", "address"}, entries...)
+}
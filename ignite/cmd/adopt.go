@@ -0,0 +1,84 @@
+package ignitecmd
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite-hq/cli/ignite/pkg/cliui/clispinner"
+	"github.com/ignite-hq/cli/ignite/services/scaffolder"
+)
+
+// NewAdopt creates a new adopt command to prepare a chain not scaffolded by Ignite CLI.
+func NewAdopt() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "adopt",
+		Short: "Prepare an existing Cosmos SDK chain for Ignite CLI",
+		Long: `Prepare a Cosmos SDK chain that was not scaffolded by Ignite CLI so the scaffold and
+the serve commands work on it.
+
+The app.go of the chain is analyzed and the placeholders used to scaffold modules are added
+where the chains scaffolded by Ignite CLI have them, e.g. at the end of the arguments of
+module.NewBasicManager. A config.yml with development accounts is created when the chain
+has none.
+
+Existing placeholders and configs are kept, so the command can be run again. Review the
+changes to app.go before committing them.`,
+		Args: cobra.NoArgs,
+		RunE: adoptHandler,
+	}
+
+	flagSetPath(c)
+
+	return c
+}
+
+func adoptHandler(cmd *cobra.Command, args []string) error {
+	s := clispinner.New().SetText("Analyzing the chain...")
+	defer s.Stop()
+
+	sc, err := scaffolder.App(flagGetPath(cmd))
+	if err != nil {
+		return err
+	}
+	adoption, err := sc.Adopt()
+	if err != nil {
+		return err
+	}
+
+	s.Stop()
+
+	appFile := adoption.AppFile
+	if rel, err := filepath.Rel(flagGetPath(cmd), appFile); err == nil {
+		appFile = rel
+	}
+
+	fmt.Printf("\n🎉 Chain adopted.\n\n")
+	if len(adoption.Modules) > 0 {
+		fmt.Println("Modules:")
+		for _, module := range adoption.Modules {
+			fmt.Printf("  %s\n", module)
+		}
+		fmt.Println()
+	}
+	if len(adoption.Placeholders) > 0 {
+		fmt.Printf("Placeholders added to %s:\n", appFile)
+		for _, placeholder := range adoption.Placeholders {
+			fmt.Printf("  %s\n", placeholder)
+		}
+		fmt.Println()
+	}
+	if len(adoption.MissingPlaceholders) > 0 {
+		fmt.Printf("Placeholders without a place in %s, add the scaffolded code there by hand:\n", appFile)
+		for _, placeholder := range adoption.MissingPlaceholders {
+			fmt.Printf("  %s\n", placeholder)
+		}
+		fmt.Println()
+	}
+	if adoption.ConfigFile != "" {
+		fmt.Printf("Config created: %s\n\n", adoption.ConfigFile)
+	}
+
+	return nil
+}
@@ -19,6 +19,10 @@ func NewChain() *cobra.Command {
 		NewChainInit(),
 		NewChainFaucet(),
 		NewChainSimulate(),
+		NewChainRename(),
+		NewChainReverseProxy(),
+		NewChainTest(),
+		NewChainEnv(),
 	)
 
 	return c
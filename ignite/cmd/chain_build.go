@@ -16,6 +16,7 @@ const (
 	flagRelease        = "release"
 	flagReleaseTargets = "release.targets"
 	flagReleasePrefix  = "release.prefix"
+	flagWasmOptimizer  = "wasm-optimizer"
 )
 
 // NewChainBuild returns a new build command to build a blockchain app.
@@ -31,9 +32,14 @@ for one or more specified release targets are built in a release/ dir under the
 source. Specify the release targets with GOOS:GOARCH build tags.
 If the optional --release.targets is not specified, a binary is created for your current environment.
 
+To build the CosmWasm contracts located in the contracts/ dir with the rust-optimizer,
+use the --wasm-optimizer flag. Docker is required, the optimized contracts are copied
+in the artifacts/ dir of the app.
+
 Sample usages:
 	- ignite chain build
-	- ignite chain build --release -t linux:amd64 -t darwin:amd64 -t darwin:arm64`,
+	- ignite chain build --release -t linux:amd64 -t darwin:amd64 -t darwin:arm64
+	- ignite chain build --wasm-optimizer`,
 		Args: cobra.NoArgs,
 		RunE: chainBuildHandler,
 	}
@@ -46,6 +52,7 @@ Sample usages:
 	c.Flags().StringSliceP(flagReleaseTargets, "t", []string{}, "release targets. Available only with --release flag")
 	c.Flags().String(flagReleasePrefix, "", "tarball prefix for each release target. Available only with --release flag")
 	c.Flags().StringP(flagOutput, "o", "", "binary output path")
+	c.Flags().Bool(flagWasmOptimizer, false, "build the contracts with the rust-optimizer")
 	c.Flags().BoolP("verbose", "v", false, "Verbose output")
 
 	return c
@@ -57,6 +64,7 @@ func chainBuildHandler(cmd *cobra.Command, _ []string) error {
 		releaseTargets, _ = cmd.Flags().GetStringSlice(flagReleaseTargets)
 		releasePrefix, _  = cmd.Flags().GetString(flagReleasePrefix)
 		output, _         = cmd.Flags().GetString(flagOutput)
+		wasmOptimizer, _  = cmd.Flags().GetBool(flagWasmOptimizer)
 	)
 
 	chainOption := []chain.Option{
@@ -78,6 +86,16 @@ func chainBuildHandler(cmd *cobra.Command, _ []string) error {
 		return err
 	}
 
+	if wasmOptimizer {
+		artifacts, err := c.BuildContracts(cmd.Context())
+		if err != nil {
+			return err
+		}
+		for _, artifact := range artifacts {
+			fmt.Printf("📜 Contract built at the path: %s\n", colors.Info(artifact))
+		}
+	}
+
 	if isRelease {
 		releasePath, err := c.BuildRelease(cmd.Context(), cacheStorage, output, releasePrefix, releaseTargets...)
 		if err != nil {
@@ -0,0 +1,471 @@
+package ignitecmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/ignite-hq/cli/ignite/chainconfig"
+	"github.com/ignite-hq/cli/ignite/pkg/addressbook"
+	"github.com/ignite-hq/cli/ignite/pkg/availableport"
+	"github.com/ignite-hq/cli/ignite/pkg/cache"
+	"github.com/ignite-hq/cli/ignite/pkg/chaincmd"
+	"github.com/ignite-hq/cli/ignite/pkg/cmdrunner/exec"
+	"github.com/ignite-hq/cli/ignite/pkg/cmdrunner/step"
+	"github.com/ignite-hq/cli/ignite/pkg/cosmosaccount"
+	"github.com/ignite-hq/cli/ignite/pkg/cosmosclient"
+	"github.com/ignite-hq/cli/ignite/pkg/cosmosutil"
+	"github.com/ignite-hq/cli/ignite/pkg/gocmd"
+	"github.com/ignite-hq/cli/ignite/pkg/relayer"
+	relayerconf "github.com/ignite-hq/cli/ignite/pkg/relayer/config"
+	"github.com/ignite-hq/cli/ignite/pkg/scenario"
+	"github.com/ignite-hq/cli/ignite/services/chain"
+)
+
+const (
+	flagE2E          = "e2e"
+	flagCounterparty = "counterparty"
+	flagRelayer      = "relayer"
+	flagNodes        = "nodes"
+	flagRun          = "run"
+	flagStartTimeout = "start-timeout"
+	flagScenario     = "scenario"
+
+	// e2eBuildTag is the build tag of the end-to-end tests.
+	e2eBuildTag = "e2e"
+
+	// e2eEnvPrefix prefixes the env vars of the endpoints of the chain.
+	e2eEnvPrefix = "IGNITE_E2E_"
+
+	// e2eCounterpartyEnvPrefix prefixes the env vars of the endpoints of the counterparty chain.
+	e2eCounterpartyEnvPrefix = "IGNITE_E2E_COUNTERPARTY_"
+
+	// e2eRelayerPathEnv is the env var of the id of the relayer path between the chains.
+	e2eRelayerPathEnv = "IGNITE_E2E_RELAYER_PATH"
+
+	// e2eNodeEnvPrefix prefixes the env vars of the endpoints of the additional nodes of the
+	// chain, followed by the number of the node.
+	e2eNodeEnvPrefix = "IGNITE_E2E_NODE_"
+
+	// e2ePorts is the number of ports of a served chain: the hosts and the faucet.
+	e2ePorts = 7
+
+	// e2eNodePorts is the number of ports of an additional node: the hosts.
+	e2eNodePorts = 6
+)
+
+// NewChainTest creates a new test command to run the tests of the blockchain.
+func NewChainTest() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "test [packages]...",
+		Short: "Run the tests of the blockchain",
+		Long: `Run the Go tests of the packages of the blockchain, all the packages by default.
+
+Use --e2e to run the end-to-end tests, the tests built with the e2e build tag. The chain is
+served from a new state in a temporary home on free ports, the tests are run once the node
+is up and the chain is stopped and removed after the tests. The endpoints and the accounts
+of the chain are passed to the tests in env vars:
+
+  IGNITE_E2E_CHAIN_ID, IGNITE_E2E_HOME, IGNITE_E2E_KEYRING_BACKEND
+  IGNITE_E2E_RPC, IGNITE_E2E_API, IGNITE_E2E_GRPC, IGNITE_E2E_FAUCET
+  IGNITE_E2E_ACCOUNTS           names of the accounts of the config
+  IGNITE_E2E_ACCOUNT_<NAME>     address of an account
+
+Use --nodes to serve more than one node of the chain. The additional nodes are full nodes that
+sync the blocks of the validator, their endpoints are passed in the env vars prefixed by
+IGNITE_E2E_NODE_<N>_, from IGNITE_E2E_NODE_1_.
+
+Use --counterparty to serve a second chain from its source, its env vars are prefixed by
+IGNITE_E2E_COUNTERPARTY_. Use --relayer to connect both chains with the relayer, the id of
+the path is passed in IGNITE_E2E_RELAYER_PATH. The relayer requires the faucet on both chains.
+
+Use --scenario to run a YAML scenario against the served chain instead of Go tests. A scenario
+is a list of steps that fund accounts, broadcast messages, wait for blocks and query the API,
+with assertions on the events and the responses.`,
+		RunE: chainTestHandler,
+	}
+
+	flagSetPath(c)
+	c.Flags().BoolP("verbose", "v", false, "Verbose output")
+	c.Flags().Bool(flagE2E, false, "Run the end-to-end tests against the served chain")
+	c.Flags().Int(flagNodes, 1, "Number of nodes of the chain, the additional nodes are full nodes")
+	c.Flags().String(flagCounterparty, "", "Path of the source of a counterparty chain served with the chain")
+	c.Flags().Bool(flagRelayer, false, "Connect the chain and the counterparty chain with the relayer")
+	c.Flags().String(flagRun, "", "Run only the tests matching the regular expression")
+	c.Flags().Duration(flagStartTimeout, 10*time.Minute, "Time to build and start the chains")
+	c.Flags().String(flagScenario, "", "Path of a YAML scenario run against the served chain")
+
+	return c
+}
+
+func chainTestHandler(cmd *cobra.Command, args []string) error {
+	var (
+		e2e, _          = cmd.Flags().GetBool(flagE2E)
+		counterparty, _ = cmd.Flags().GetString(flagCounterparty)
+		relay, _        = cmd.Flags().GetBool(flagRelayer)
+		nodes, _        = cmd.Flags().GetInt(flagNodes)
+		run, _          = cmd.Flags().GetString(flagRun)
+		startTimeout, _ = cmd.Flags().GetDuration(flagStartTimeout)
+		scenarioPath, _ = cmd.Flags().GetString(flagScenario)
+	)
+	if scenarioPath != "" && (e2e || len(args) > 0) {
+		return fmt.Errorf("--%s cannot be used with --%s or packages", flagScenario, flagE2E)
+	}
+	if !e2e && scenarioPath == "" && (counterparty != "" || relay || nodes != 1) {
+		return fmt.Errorf("--%s, --%s and --%s require --%s or --%s", flagCounterparty, flagRelayer, flagNodes, flagE2E, flagScenario)
+	}
+	if nodes < 1 {
+		return fmt.Errorf("--%s must be at least 1", flagNodes)
+	}
+	if relay && counterparty == "" {
+		return fmt.Errorf("--%s requires --%s", flagRelayer, flagCounterparty)
+	}
+
+	appPath, err := filepath.Abs(flagGetPath(cmd))
+	if err != nil {
+		return err
+	}
+
+	packages := args
+	if len(packages) == 0 {
+		packages = []string{"./..."}
+	}
+	testFlags := []string{"-count=1"}
+	if run != "" {
+		testFlags = append(testFlags, "-run", run)
+	}
+	if !e2e && scenarioPath == "" {
+		return goTest(cmd.Context(), appPath, testFlags, packages, nil)
+	}
+	testFlags = append(testFlags, gocmd.FlagTags, e2eBuildTag)
+
+	var s scenario.Scenario
+	if scenarioPath != "" {
+		if s, err = scenario.ParseFile(scenarioPath); err != nil {
+			return fmt.Errorf("%s: %w", scenarioPath, err)
+		}
+	}
+
+	tmp, err := os.MkdirTemp("", "ignite-e2e")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmp)
+
+	// the cache and the exported state of the served chains of the user are left untouched.
+	cacheStorage, err := cache.NewStorage(filepath.Join(tmp, "cache.db"))
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(cmd.Context())
+	defer cancel()
+	g, gctx := errgroup.WithContext(ctx)
+
+	// serve the chains in the background.
+	sources := map[string]string{e2eEnvPrefix: appPath}
+	if counterparty != "" {
+		if sources[e2eCounterpartyEnvPrefix], err = filepath.Abs(counterparty); err != nil {
+			return err
+		}
+	}
+	served := make(map[string]*chain.Chain)
+	for prefix, source := range sources {
+		home := filepath.Join(tmp, "chain")
+		if prefix == e2eCounterpartyEnvPrefix {
+			home = filepath.Join(tmp, "counterparty")
+		}
+		c, lease, err := newE2EChain(cmd, source, home)
+		if err != nil {
+			return err
+		}
+		defer lease.Release()
+
+		g.Go(func() error {
+			return c.Serve(gctx, cacheStorage,
+				chain.ServeForceReset(),
+				chain.ServeExitOnBuildError(),
+				chain.ServeSkipStateExport(),
+			)
+		})
+		served[prefix] = c
+	}
+
+	env, err := waitE2EChains(gctx, served, startTimeout)
+	if err != nil {
+		return stopE2E(cancel, g, err)
+	}
+
+	// serve the additional nodes of the chain.
+	for i := 1; i < nodes; i++ {
+		lease, err := availableport.Reserve(e2eNodePorts)
+		if err != nil {
+			return stopE2E(cancel, g, err)
+		}
+		defer lease.Release()
+
+		var (
+			c    = served[e2eEnvPrefix]
+			home = filepath.Join(tmp, fmt.Sprintf("node%d", i))
+			host = e2eHost(lease.Ports())
+		)
+		g.Go(func() error {
+			return c.ServePeer(gctx, home, host)
+		})
+
+		prefix := fmt.Sprintf("%s%d_", e2eNodeEnvPrefix, i)
+		if env[prefix], err = env[e2eEnvPrefix].PeerEndpoints(host); err != nil {
+			return stopE2E(cancel, g, err)
+		}
+	}
+	if err := waitE2ENodes(gctx, env, startTimeout); err != nil {
+		return stopE2E(cancel, g, err)
+	}
+
+	var testEnv []string
+	for prefix, endpoints := range env {
+		testEnv = append(testEnv, endpoints.Env(prefix)...)
+	}
+
+	if relay {
+		pathID, err := relayE2E(gctx, g, filepath.Join(tmp, "relayer"), env[e2eEnvPrefix], env[e2eCounterpartyEnvPrefix])
+		if err != nil {
+			return stopE2E(cancel, g, err)
+		}
+		fmt.Printf("🔗 Relaying packets of path %s\n", pathID)
+		testEnv = append(testEnv, e2eRelayerPathEnv+"="+pathID)
+	}
+
+	var testErr error
+	if scenarioPath != "" {
+		testErr = runScenario(gctx, s, served[e2eEnvPrefix], env[e2eEnvPrefix])
+	} else {
+		testErr = goTest(gctx, appPath, testFlags, packages, testEnv)
+	}
+
+	return stopE2E(cancel, g, testErr)
+}
+
+// stopE2E stops the served chains and the relayer and waits for them. When one of them stopped
+// by itself, its error is returned instead of err since it is what made the run fail.
+func stopE2E(cancel context.CancelFunc, g *errgroup.Group, err error) error {
+	cancel()
+	if serveErr := g.Wait(); serveErr != nil && !errors.Is(serveErr, context.Canceled) {
+		return serveErr
+	}
+	return err
+}
+
+// newE2EChain creates a chain from source with its home in home and listening on free ports,
+// the ports are reserved until the lease is released.
+func newE2EChain(cmd *cobra.Command, source, home string) (*chain.Chain, *availableport.Lease, error) {
+	lease, err := availableport.Reserve(e2ePorts)
+	if err != nil {
+		return nil, nil, err
+	}
+	ports := lease.Ports()
+	host := e2eHost(ports)
+
+	c, err := chain.New(source,
+		chain.LogLevel(logLevel(cmd)),
+		chain.HomePath(home),
+		chain.KeyringBackend(chaincmd.KeyringBackendTest),
+		chain.Host(host),
+		chain.FaucetHost(localhost(ports[6])),
+	)
+	if err != nil {
+		lease.Release()
+		return nil, nil, err
+	}
+	return c, lease, nil
+}
+
+// e2eHost returns the hosts of a node listening on the first ports.
+func e2eHost(ports []int) chainconfig.Host {
+	return chainconfig.Host{
+		RPC:     localhost(ports[0]),
+		P2P:     localhost(ports[1]),
+		Prof:    localhost(ports[2]),
+		GRPC:    localhost(ports[3]),
+		GRPCWeb: localhost(ports[4]),
+		API:     localhost(ports[5]),
+	}
+}
+
+// waitE2EChains waits until the served chains are up and returns their endpoints by env prefix.
+func waitE2EChains(ctx context.Context, served map[string]*chain.Chain, timeout time.Duration) (map[string]chain.Endpoints, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	env := make(map[string]chain.Endpoints)
+	for prefix, c := range served {
+		if err := c.WaitServed(ctx); err != nil {
+			if errors.Is(err, context.DeadlineExceeded) {
+				return nil, fmt.Errorf("chain %s not started after %s", c.Name(), timeout)
+			}
+			return nil, err
+		}
+		endpoints, err := c.Endpoints(ctx)
+		if err != nil {
+			return nil, err
+		}
+		env[prefix] = endpoints
+	}
+	return env, nil
+}
+
+// waitE2ENodes waits until the additional nodes of the chain are up, env are the endpoints
+// of the chains and the nodes by env prefix.
+func waitE2ENodes(ctx context.Context, env map[string]chain.Endpoints, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	for prefix, e := range env {
+		if !strings.HasPrefix(prefix, e2eNodeEnvPrefix) {
+			continue
+		}
+		if err := chain.WaitPeer(ctx, e); err != nil {
+			if errors.Is(err, context.DeadlineExceeded) {
+				return fmt.Errorf("node %s not started after %s", e.RPC, timeout)
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+// relayE2E connects the chains with a relayer using the keys and the config in home, the
+// relayer account is funded by the faucets. Packets are relayed until ctx is canceled.
+func relayE2E(ctx context.Context, g *errgroup.Group, home string, src, dst chain.Endpoints) (pathID string, err error) {
+	if src.Faucet == "" || dst.Faucet == "" {
+		return "", errors.New("the relayer requires the faucet on both chains")
+	}
+	if src.ChainID == dst.ChainID {
+		return "", fmt.Errorf("the chains have the same id %s", src.ChainID)
+	}
+
+	// the relayer config of the user is left untouched.
+	relayerconf.SetPath(filepath.Join(home, "config.yml"))
+
+	ca, err := cosmosaccount.New(
+		cosmosaccount.WithHome(filepath.Join(home, "keys")),
+		cosmosaccount.WithKeyringBackend(cosmosaccount.KeyringTest),
+	)
+	if err != nil {
+		return "", err
+	}
+	if err := ca.EnsureDefaultAccount(); err != nil {
+		return "", err
+	}
+
+	r := relayer.New(ca, relayer.WithOutput(os.Stdout))
+	var chains []*relayer.Chain
+	for _, e := range []chain.Endpoints{src, dst} {
+		prefix := defautSourceAddressPrefix
+		for _, address := range e.Accounts {
+			if prefix, err = cosmosutil.GetAddressPrefix(address); err != nil {
+				return "", err
+			}
+			break
+		}
+
+		c, _, err := r.NewChain(ctx, cosmosaccount.DefaultAccount, e.RPC,
+			relayer.WithFaucet(e.Faucet),
+			relayer.WithGasPrice(defautSourceGasPrice),
+			relayer.WithGasLimit(defautSourceGasLimit),
+			relayer.WithAddressPrefix(prefix),
+		)
+		if err != nil {
+			return "", err
+		}
+		if _, err := c.TryRetrieve(ctx); err != nil {
+			return "", fmt.Errorf("cannot fund the relayer account on %s: %w", e.ChainID, err)
+		}
+		chains = append(chains, c)
+	}
+
+	if pathID, err = chains[0].Connect(chains[1]); err != nil {
+		return "", err
+	}
+	if err := r.Link(ctx, pathID); err != nil {
+		return "", err
+	}
+	g.Go(func() error { return r.Start(ctx, pathID) })
+	return pathID, nil
+}
+
+// runScenario runs the scenario against the served chain with its accounts, the messages of
+// the accounts are broadcasted with their gas settings. The messages of the modules of the
+// chain are signed and broadcasted by its binary.
+func runScenario(ctx context.Context, s scenario.Scenario, c *chain.Chain, e chain.Endpoints) error {
+	conf, err := c.Config()
+	if err != nil {
+		return err
+	}
+	commands, err := c.Commands(ctx)
+	if err != nil {
+		return err
+	}
+
+	newClient := func(gas chainconfig.Gas) (cosmosclient.Client, error) {
+		options := []cosmosclient.Option{
+			cosmosclient.WithNodeAddress(e.RPC),
+			cosmosclient.WithHome(e.Home),
+			cosmosclient.WithKeyringBackend(cosmosaccount.KeyringBackend(e.KeyringBackend)),
+			cosmosclient.WithGasPrices(gas.GasPrice),
+			cosmosclient.WithMaxGas(gas.MaxGas),
+		}
+		if gas.GasAdjustment > 0 {
+			options = append(options, cosmosclient.WithGasAdjustment(gas.GasAdjustment))
+		}
+		return cosmosclient.New(ctx, options...)
+	}
+
+	client, err := newClient(chainconfig.Gas{})
+	if err != nil {
+		return err
+	}
+	clients := make(map[string]cosmosclient.Client)
+	for _, account := range conf.Accounts {
+		if account.Gas == (chainconfig.Gas{}) {
+			continue
+		}
+		if clients[account.Name], err = newClient(account.Gas); err != nil {
+			return err
+		}
+	}
+
+	book, err := addressbook.LoadDefault()
+	if err != nil {
+		return err
+	}
+
+	return scenario.New(client,
+		scenario.WithAPI(e.API),
+		scenario.WithAddresses(book.Addresses(e.ChainID)),
+		scenario.WithAccountClients(clients),
+		scenario.WithTxBroadcaster(commands.SignAndBroadcastTx),
+		scenario.WithFaucet(e.Faucet),
+		scenario.WithOutput(os.Stdout),
+	).Run(ctx, s)
+}
+
+// goTest runs the tests of the packages of the app with the env vars.
+func goTest(ctx context.Context, appPath string, flags, packages, env []string) error {
+	return gocmd.Test(ctx, appPath, flags, packages,
+		exec.StepOption(step.Env(env...)),
+		exec.StepOption(step.Stdout(os.Stdout)),
+		exec.StepOption(step.Stderr(os.Stderr)),
+	)
+}
+
+func localhost(port int) string {
+	return fmt.Sprintf("127.0.0.1:%d", port)
+}
@@ -0,0 +1,61 @@
+package ignitecmd
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sync/errgroup"
+)
+
+func TestStopE2E(t *testing.T) {
+	errWait := errors.New("chain not started")
+
+	// the chain failed to build, waiting for it is canceled.
+	ctx, cancel := context.WithCancel(context.Background())
+	g, _ := errgroup.WithContext(ctx)
+	g.Go(func() error { return errors.New("cannot build app") })
+	require.EqualError(t, stopE2E(cancel, g, errWait), "cannot build app")
+
+	// the chain is stopped by the cancellation.
+	ctx, cancel = context.WithCancel(context.Background())
+	g, gctx := errgroup.WithContext(ctx)
+	g.Go(func() error {
+		<-gctx.Done()
+		return gctx.Err()
+	})
+	require.Equal(t, errWait, stopE2E(cancel, g, errWait))
+
+	ctx, cancel = context.WithCancel(context.Background())
+	g, gctx = errgroup.WithContext(ctx)
+	g.Go(func() error {
+		<-gctx.Done()
+		return gctx.Err()
+	})
+	require.NoError(t, stopE2E(cancel, g, nil))
+}
+
+func TestE2EHost(t *testing.T) {
+	host := e2eHost([]int{1, 2, 3, 4, 5, 6, 7})
+	require.Equal(t, "127.0.0.1:1", host.RPC)
+	require.Equal(t, "127.0.0.1:2", host.P2P)
+	require.Equal(t, "127.0.0.1:3", host.Prof)
+	require.Equal(t, "127.0.0.1:4", host.GRPC)
+	require.Equal(t, "127.0.0.1:5", host.GRPCWeb)
+	require.Equal(t, "127.0.0.1:6", host.API)
+}
+
+func TestChainTestNodesFlag(t *testing.T) {
+	cmd := NewChainTest()
+	cmd.SetArgs([]string{"--nodes", "2"})
+	cmd.SilenceUsage = true
+	cmd.SilenceErrors = true
+	require.EqualError(t, cmd.Execute(), "--counterparty, --relayer and --nodes require --e2e or --scenario")
+
+	cmd = NewChainTest()
+	cmd.SetArgs([]string{"--e2e", "--nodes", "0"})
+	cmd.SilenceUsage = true
+	cmd.SilenceErrors = true
+	require.EqualError(t, cmd.Execute(), "--nodes must be at least 1")
+}
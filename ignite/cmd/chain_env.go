@@ -0,0 +1,84 @@
+package ignitecmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagPrefix = "prefix"
+	flagDotenv = "dotenv"
+)
+
+// NewChainEnv creates a new command to print the environment variables of the chain.
+func NewChainEnv() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "env",
+		Short: "Print the environment variables of the chain for shells and CI",
+		Long: `Print the endpoints and the accounts of the chain as environment variables to use
+them in scripts instead of hardcoding them:
+
+  CHAIN_ID, CHAIN_HOME, KEYRING_BACKEND
+  NODE, API, GRPC, FAUCET          FAUCET is only set when the faucet is enabled
+  ACCOUNTS                         names of the accounts of the config
+  ACCOUNT_<NAME>                   address of an account
+  ACCOUNT_<NAME>_MNEMONIC          mnemonic of an account, set in config.yml or generated
+
+While the chain is served, the variables are the ones of the served chain. Otherwise the
+endpoints are read from config.yml and the accounts from the home of the chain, they are
+printed once the chain is initialized, e.g. by serving it.
+
+Variables are printed as shell exports, or as KEY=value lines with --dotenv, e.g. to append
+them to the env file of a CI job.`,
+		Example: `  eval "$(ignite chain env)"
+  ignite chain env --prefix MARS_ --dotenv >> "$GITHUB_ENV"`,
+		Args: cobra.NoArgs,
+		RunE: chainEnvHandler,
+	}
+
+	flagSetPath(c)
+	c.Flags().AddFlagSet(flagSetHome())
+	c.Flags().String(flagPrefix, "", "Prefix of the names of the variables")
+	c.Flags().Bool(flagDotenv, false, "Print KEY=value lines instead of shell exports")
+
+	return c
+}
+
+func chainEnvHandler(cmd *cobra.Command, args []string) error {
+	var (
+		prefix, _ = cmd.Flags().GetString(flagPrefix)
+		dotenv, _ = cmd.Flags().GetBool(flagDotenv)
+	)
+
+	c, err := newChainWithHomeFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	cacheStorage, err := newCache(cmd)
+	if err != nil {
+		return err
+	}
+
+	env, err := c.ShellEnv(cmd.Context(), cacheStorage)
+	if err != nil {
+		return err
+	}
+
+	for _, v := range env {
+		key, value, _ := strings.Cut(v, "=")
+		if dotenv {
+			fmt.Printf("%s%s=%s\n", prefix, key, value)
+			continue
+		}
+		fmt.Printf("export %s%s=%s\n", prefix, key, shellQuote(value))
+	}
+	return nil
+}
+
+// shellQuote quotes s to be read as a single word by POSIX shells.
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
@@ -0,0 +1,23 @@
+package ignitecmd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestShellQuote(t *testing.T) {
+	cases := []struct {
+		value    string
+		expected string
+	}{
+		{"", `''`},
+		{"http://127.0.0.1:26657", `'http://127.0.0.1:26657'`},
+		{"alice mnemonic words", `'alice mnemonic words'`},
+		{"$HOME `id`", "'$HOME `id`'"},
+		{"it's", `'it'\''s'`},
+	}
+	for _, tt := range cases {
+		require.Equal(t, tt.expected, shellQuote(tt.value))
+	}
+}
@@ -1,37 +1,61 @@
 package ignitecmd
 
 import (
+	"errors"
 	"fmt"
+	"os"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/spf13/cobra"
 
+	"github.com/ignite-hq/cli/ignite/pkg/addressbook"
 	"github.com/ignite-hq/cli/ignite/pkg/chaincmd"
+	"github.com/ignite-hq/cli/ignite/pkg/cosmosfaucet"
 	"github.com/ignite-hq/cli/ignite/services/chain"
 )
 
+const flagFile = "file"
+
 // NewChainFaucet creates a new faucet command to send coins to accounts.
 func NewChainFaucet() *cobra.Command {
 	c := &cobra.Command{
 		Use:   "faucet [address] [coin<,...>]",
 		Short: "Send coins to an account",
-		Args:  cobra.ExactArgs(2),
-		RunE:  chainFaucetHandler,
+		Long: `Send coins to an account using the faucet account configured in config.yml.
+
+The faucet server doesn't need to be running. The address can be a label of the address
+book of the chain. To fund many accounts at once, provide a file with an address or a label
+and its coins on each line:
+
+	# address coins
+	cosmos1adn9gxjmrc3hrsdx5zpc9sj2ra7kgqkmphf8yw 10token,5stake
+	cosmos1wpj4fmm8r2wzx7cmnfj4l4jh2qfl3lzlxpg05e 20token
+
+Sample usages:
+	- ignite chain faucet cosmos1adn9gxjmrc3hrsdx5zpc9sj2ra7kgqkmphf8yw 10token,5stake
+	- ignite chain faucet --file accounts.txt`,
+		Args: func(cmd *cobra.Command, args []string) error {
+			if file, _ := cmd.Flags().GetString(flagFile); file != "" {
+				return cobra.NoArgs(cmd, args)
+			}
+			return cobra.ExactArgs(2)(cmd, args)
+		},
+		RunE: chainFaucetHandler,
 	}
 
 	flagSetPath(c)
 	c.Flags().AddFlagSet(flagSetHome())
 	c.Flags().BoolP("verbose", "v", false, "Verbose output")
+	c.Flags().StringP(flagFile, "f", "", "File containing the addresses and coins to send, one transfer per line")
+
+	c.AddCommand(NewChainFaucetRotateKey())
+	c.AddCommand(NewChainFaucetAPIKey())
 
 	return c
 }
 
 func chainFaucetHandler(cmd *cobra.Command, args []string) error {
-	var (
-		toAddress = args[0]
-		coins     = args[1]
-	)
-
 	chainOption := []chain.Option{
 		chain.LogLevel(logLevel(cmd)),
 		chain.KeyringBackend(chaincmd.KeyringBackendTest),
@@ -42,22 +66,75 @@ func chainFaucetHandler(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	faucet, err := c.Faucet(cmd.Context())
+	chainID, err := c.ID()
 	if err != nil {
 		return err
 	}
-
-	// parse provided coins
-	parsedCoins, err := sdk.ParseCoinsNormalized(coins)
+	requests, err := faucetTransferRequests(cmd, args, chainID)
 	if err != nil {
 		return err
 	}
 
-	// perform transfer from faucet
-	if err := faucet.Transfer(cmd.Context(), toAddress, parsedCoins); err != nil {
+	// parse all coins before sending anything to not stop in the middle of a batch.
+	coins := make([]sdk.Coins, len(requests))
+	for i, req := range requests {
+		if coins[i], err = sdk.ParseCoinsNormalized(strings.Join(req.Coins, ",")); err != nil {
+			return fmt.Errorf("%s: %w", req.AccountAddress, err)
+		}
+	}
+
+	faucet, err := c.Faucet(cmd.Context())
+	if err != nil {
 		return err
 	}
 
+	// perform transfers from faucet
+	for i, req := range requests {
+		if err := faucet.Transfer(cmd.Context(), req.AccountAddress, coins[i]); err != nil {
+			return fmt.Errorf("%s: %w", req.AccountAddress, err)
+		}
+
+		if len(requests) > 1 {
+			fmt.Printf("📨 Sent %s to %s\n", coins[i], req.AccountAddress)
+		}
+	}
+
 	fmt.Println("📨 Coins sent.")
 	return nil
 }
+
+// faucetTransferRequests returns the transfers of the args or of the file of the command, the
+// labels of the address book of the chain are resolved to their addresses.
+func faucetTransferRequests(cmd *cobra.Command, args []string, chainID string) ([]cosmosfaucet.TransferRequest, error) {
+	file, _ := cmd.Flags().GetString(flagFile)
+	if file == "" {
+		address, err := addressbook.Resolve(chainID, args[0])
+		if err != nil {
+			return nil, err
+		}
+		return []cosmosfaucet.TransferRequest{
+			cosmosfaucet.NewTransferRequest(address, []string{args[1]}),
+		}, nil
+	}
+
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	requests, err := cosmosfaucet.ParseTransferRequests(f)
+	if err != nil {
+		return nil, err
+	}
+	if len(requests) == 0 {
+		return nil, errors.New("no transfers found in the file")
+	}
+	for i, req := range requests {
+		if requests[i].AccountAddress, err = addressbook.Resolve(chainID, req.AccountAddress); err != nil {
+			return nil, err
+		}
+	}
+
+	return requests, nil
+}
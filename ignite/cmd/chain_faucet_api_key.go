@@ -0,0 +1,161 @@
+package ignitecmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite-hq/cli/ignite/pkg/cliui/entrywriter"
+	"github.com/ignite-hq/cli/ignite/services/chain"
+)
+
+const (
+	flagDenoms   = "denoms"
+	flagCoinsMax = "coins-max"
+	flagDomains  = "domains"
+)
+
+var faucetAPIKeysHeader = []string{"name", "denoms", "coins max", "domains"}
+
+// NewChainFaucetAPIKey creates a new command to manage the API keys of the faucet.
+func NewChainFaucetAPIKey() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "api-key [command]",
+		Short: "Manage the API keys of the faucet server",
+		Long: `Manage the API keys of the faucet server.
+
+Requests made to the faucet server with an API key in the X-API-Key header get the limits
+of the key: the denoms that can be requested, the max amounts that can be transferred to an
+account and the domains of the web pages allowed to use the key. Set
+faucet.require_api_key in config.yml to only serve the requests made with an API key.
+
+The hashes of the keys are kept in faucet_api_keys.yml, the faucet server of a served chain
+reloads them when they change.`,
+		Args: cobra.ExactArgs(1),
+	}
+
+	c.AddCommand(
+		newChainFaucetAPIKeyAdd(),
+		newChainFaucetAPIKeyList(),
+		newChainFaucetAPIKeyRevoke(),
+	)
+
+	return c
+}
+
+func newChainFaucetAPIKeyAdd() *cobra.Command {
+	c := &cobra.Command{
+		Use:     "add [name]",
+		Short:   "Create an API key for the faucet",
+		Example: "  ignite chain faucet api-key add partner --denoms token --coins-max 1000token --domains app.partner.com",
+		Args:    cobra.ExactArgs(1),
+		RunE:    chainFaucetAPIKeyAddHandler,
+	}
+
+	flagSetPath(c)
+	c.Flags().StringSlice(flagDenoms, nil, "Denoms that can be requested with the key, all of them by default")
+	c.Flags().StringSlice(flagCoinsMax, nil, "Max amounts that can be transferred to an account with the key, faucet.coins_max by default")
+	c.Flags().StringSlice(flagDomains, nil, "Domains of the web pages allowed to use the key, e.g. *.example.com, all by default")
+
+	return c
+}
+
+func chainFaucetAPIKeyAddHandler(cmd *cobra.Command, args []string) error {
+	var (
+		denoms, _   = cmd.Flags().GetStringSlice(flagDenoms)
+		coinsMax, _ = cmd.Flags().GetStringSlice(flagCoinsMax)
+		domains, _  = cmd.Flags().GetStringSlice(flagDomains)
+	)
+
+	c, err := newChainWithHomeFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	key, err := c.AddFaucetAPIKey(chain.FaucetAPIKey{
+		Name:     args[0],
+		Denoms:   denoms,
+		CoinsMax: coinsMax,
+		Domains:  domains,
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("🔑 API key %q created, it cannot be shown again:\n\n%s\n\nThe faucet server of a served chain accepts it within a second.\n", args[0], key)
+	return nil
+}
+
+func newChainFaucetAPIKeyList() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "list",
+		Short: "List the API keys of the faucet",
+		Args:  cobra.NoArgs,
+		RunE:  chainFaucetAPIKeyListHandler,
+	}
+
+	flagSetPath(c)
+
+	return c
+}
+
+func chainFaucetAPIKeyListHandler(cmd *cobra.Command, args []string) error {
+	c, err := newChainWithHomeFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	keys, err := c.FaucetAPIKeys()
+	if err != nil {
+		return err
+	}
+	if len(keys) == 0 {
+		fmt.Println("The faucet has no API key.")
+		return nil
+	}
+
+	all := func(values []string) string {
+		if len(values) == 0 {
+			return "all"
+		}
+		return strings.Join(values, ",")
+	}
+
+	var entries [][]string
+	for _, k := range keys {
+		coinsMax := strings.Join(k.CoinsMax, ",")
+		if coinsMax == "" {
+			coinsMax = "-"
+		}
+		entries = append(entries, []string{k.Name, all(k.Denoms), coinsMax, all(k.Domains)})
+	}
+	return entrywriter.MustWrite(cmd.OutOrStdout(), faucetAPIKeysHeader, entries...)
+}
+
+func newChainFaucetAPIKeyRevoke() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "revoke [name]",
+		Short: "Revoke an API key of the faucet",
+		Args:  cobra.ExactArgs(1),
+		RunE:  chainFaucetAPIKeyRevokeHandler,
+	}
+
+	flagSetPath(c)
+
+	return c
+}
+
+func chainFaucetAPIKeyRevokeHandler(cmd *cobra.Command, args []string) error {
+	c, err := newChainWithHomeFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	if err := c.RevokeFaucetAPIKey(args[0]); err != nil {
+		return err
+	}
+
+	fmt.Printf("API key %q revoked, the faucet server of a served chain rejects it within a second.\n", args[0])
+	return nil
+}
@@ -0,0 +1,56 @@
+package ignitecmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite-hq/cli/ignite/pkg/chaincmd"
+	"github.com/ignite-hq/cli/ignite/services/chain"
+)
+
+// NewChainFaucetRotateKey creates a new command to replace the faucet account with a new one.
+func NewChainFaucetRotateKey() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "rotate-key [name]",
+		Short: "Replace the faucet account with a new one",
+		Long: `Replace the faucet account with a new one.
+
+A new account is created in the chain's keyring and all the balances of the current faucet
+account, but the fee of the transfer, are transferred to it. Once the funds are moved,
+config.yml is updated to use the new account as the faucet. When the transfer fails, the new
+account is deleted. The chain must be running. When it is served by "ignite chain serve",
+its state is kept and its faucet restarts with the new account.`,
+		Args: cobra.ExactArgs(1),
+		RunE: chainFaucetRotateKeyHandler,
+	}
+
+	flagSetPath(c)
+	c.Flags().AddFlagSet(flagSetHome())
+
+	return c
+}
+
+func chainFaucetRotateKeyHandler(cmd *cobra.Command, args []string) error {
+	chainOption := []chain.Option{
+		chain.LogLevel(logLevel(cmd)),
+		chain.KeyringBackend(chaincmd.KeyringBackendTest),
+	}
+
+	c, err := newChainWithHomeFlags(cmd, chainOption...)
+	if err != nil {
+		return err
+	}
+
+	account, err := c.RotateFaucetKey(cmd.Context(), args[0])
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("🔑 Faucet account rotated to %q (%s), keep your mnemonic in a secret place:\n\n%s\n",
+		account.Name,
+		account.Address,
+		account.Mnemonic,
+	)
+	return nil
+}
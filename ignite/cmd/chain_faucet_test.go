@@ -0,0 +1,53 @@
+package ignitecmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite-hq/cli/ignite/pkg/addressbook"
+	"github.com/ignite-hq/cli/ignite/pkg/cosmosfaucet"
+)
+
+func TestFaucetTransferRequests(t *testing.T) {
+	const (
+		alice = "cosmos1adn9gxjmrc3hrsdx5zpc9sj2ra7kgqkmphf8yw"
+		bob   = "cosmos1wpj4fmm8r2wzx7cmnfj4l4jh2qfl3lzlxpg05e"
+	)
+	var (
+		dir      = t.TempDir()
+		bookPath = filepath.Join(dir, "addressbook.yml")
+		file     = filepath.Join(dir, "accounts.txt")
+	)
+	defaultPath := addressbook.DefaultPath
+	addressbook.DefaultPath = func() (string, error) { return bookPath, nil }
+	t.Cleanup(func() { addressbook.DefaultPath = defaultPath })
+
+	book, err := addressbook.Load(bookPath)
+	require.NoError(t, err)
+	require.NoError(t, book.Add("mars", "alice", alice))
+	require.NoError(t, book.Save())
+
+	cmd := NewChainFaucet()
+	requests, err := faucetTransferRequests(cmd, []string{"alice", "10token"}, "mars")
+	require.NoError(t, err)
+	require.Equal(t, []cosmosfaucet.TransferRequest{
+		cosmosfaucet.NewTransferRequest(alice, []string{"10token"}),
+	}, requests)
+
+	require.NoError(t, os.WriteFile(file, []byte("alice 10token\n"+bob+" 20token\n"), 0644))
+	require.NoError(t, cmd.Flags().Set(flagFile, file))
+	requests, err = faucetTransferRequests(cmd, nil, "mars")
+	require.NoError(t, err)
+	require.Equal(t, []cosmosfaucet.TransferRequest{
+		cosmosfaucet.NewTransferRequest(alice, []string{"10token"}),
+		cosmosfaucet.NewTransferRequest(bob, []string{"20token"}),
+	}, requests)
+
+	// labels are resolved for the chain ID of the chain only.
+	requests, err = faucetTransferRequests(cmd, nil, "venus")
+	require.NoError(t, err)
+	require.Equal(t, "alice", requests[0].AccountAddress)
+}
@@ -14,13 +14,19 @@ func NewChainInit() *cobra.Command {
 	c := &cobra.Command{
 		Use:   "init",
 		Short: "Initialize your chain",
-		Args:  cobra.NoArgs,
-		RunE:  chainInitHandler,
+		Long: `Initialize your chain.
+
+By default, the app's binary is compiled from source before initializing the chain.
+Use --skip-build to initialize the chain with a binary that is already in your $PATH,
+or --binary to use a prebuilt binary from a release.`,
+		Args: cobra.NoArgs,
+		RunE: chainInitHandler,
 	}
 
 	flagSetPath(c)
 	flagSetClearCache(c)
 	c.Flags().AddFlagSet(flagSetHome())
+	c.Flags().AddFlagSet(flagSetSkipBuild())
 
 	return c
 }
@@ -31,18 +37,27 @@ func chainInitHandler(cmd *cobra.Command, _ []string) error {
 		chain.KeyringBackend(chaincmd.KeyringBackendTest),
 	}
 
+	skipBuild, skipBuildOptions := flagGetSkipBuild(cmd)
+	chainOption = append(chainOption, skipBuildOptions...)
+
 	c, err := newChainWithHomeFlags(cmd, chainOption...)
 	if err != nil {
 		return err
 	}
 
-	cacheStorage, err := newCache(cmd)
-	if err != nil {
-		return err
-	}
+	if skipBuild {
+		if err := c.CheckBinary(); err != nil {
+			return err
+		}
+	} else {
+		cacheStorage, err := newCache(cmd)
+		if err != nil {
+			return err
+		}
 
-	if _, err := c.Build(cmd.Context(), cacheStorage, ""); err != nil {
-		return err
+		if _, err := c.Build(cmd.Context(), cacheStorage, ""); err != nil {
+			return err
+		}
 	}
 
 	if err := c.Init(cmd.Context(), true); err != nil {
@@ -0,0 +1,65 @@
+package ignitecmd
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite-hq/cli/ignite/chainconfig"
+)
+
+const flagDenom = "denom"
+
+// NewChainRename creates a new command to change the chain id and the staking denom of a chain
+// in its config.yml.
+func NewChainRename() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "rename",
+		Short: "Change the chain id and the staking denom of the blockchain",
+		Long: `Change the chain id and the staking denom of the blockchain.
+
+config.yml is updated so the chain stays consistent: the staking denom is replaced in the
+coins of the accounts, the validator and the faucet, and it is set as the denom of the
+staking, mint, crisis and gov modules in the genesis. The chain id is set in the genesis.
+
+Only config.yml is changed. The Go code of the project, like the networks of the test
+utilities that use the default staking denom of the Cosmos SDK, and the frontend keep their
+values. The data directory of the chain is not changed either, reset it on the next serve to
+apply the new values.`,
+		Example: "  ignite chain rename --chain-id mars-1 --denom umars",
+		Args:    cobra.NoArgs,
+		RunE:    chainRenameHandler,
+	}
+
+	flagSetPath(c)
+	c.Flags().String(flagChainID, "", "New chain id")
+	c.Flags().String(flagDenom, "", "New staking denom")
+
+	return c
+}
+
+func chainRenameHandler(cmd *cobra.Command, _ []string) error {
+	chainID, _ := cmd.Flags().GetString(flagChainID)
+	denom, _ := cmd.Flags().GetString(flagDenom)
+	if chainID == "" && denom == "" {
+		return fmt.Errorf("at least one of --%s or --%s is required", flagChainID, flagDenom)
+	}
+
+	c, err := newChainWithHomeFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	configPath := c.ConfigPath()
+	if configPath == "" {
+		return errors.New("config.yml not found")
+	}
+
+	if err := chainconfig.Rename(configPath, chainID, denom); err != nil {
+		return err
+	}
+
+	fmt.Printf("🎉 %s updated, run `ignite chain serve --reset-once` to apply the changes.\n", configPath)
+	return nil
+}
@@ -0,0 +1,55 @@
+package ignitecmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite-hq/cli/ignite/pkg/reverseproxy"
+)
+
+const flagDomain = "domain"
+
+// NewChainReverseProxy creates a new command to print the config of a reverse proxy for the chain.
+func NewChainReverseProxy() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "reverse-proxy [nginx|caddy]",
+		Short: "Print a reverse proxy config to expose the blockchain publicly with TLS",
+		Long: `Print a reverse proxy config to expose the blockchain publicly with TLS.
+
+The RPC, the API, gRPC-web and the faucet, when it is enabled, are exposed on subdomains
+of the domain, e.g. rpc.mars.example.com. The proxy runs on the host of the chain.
+
+The API and gRPC-web of the node either allow all origins or none, when the cors section
+of config.yml lists origins, the proxy adds the CORS headers of these origins.`,
+		Example: "  ignite chain reverse-proxy caddy --domain mars.example.com > Caddyfile",
+		Args:    cobra.ExactArgs(1),
+		RunE:    chainReverseProxyHandler,
+	}
+
+	flagSetPath(c)
+	c.Flags().AddFlagSet(flagSetHome())
+	c.Flags().String(flagDomain, "", "Domain of the services of the chain")
+
+	return c
+}
+
+func chainReverseProxyHandler(cmd *cobra.Command, args []string) error {
+	domain, _ := cmd.Flags().GetString(flagDomain)
+	if domain == "" {
+		return fmt.Errorf("--%s is required", flagDomain)
+	}
+
+	c, err := newChainWithHomeFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	conf, err := c.ReverseProxyConfig(reverseproxy.Server(args[0]), domain)
+	if err != nil {
+		return err
+	}
+
+	fmt.Print(conf)
+	return nil
+}
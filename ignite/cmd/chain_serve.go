@@ -1,15 +1,28 @@
 package ignitecmd
 
 import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+
 	"github.com/spf13/cobra"
+	flag "github.com/spf13/pflag"
 
 	"github.com/ignite-hq/cli/ignite/services/chain"
+	"github.com/ignite-hq/cli/ignite/version"
 )
 
 const (
-	flagForceReset = "force-reset"
-	flagResetOnce  = "reset-once"
-	flagConfig     = "config"
+	flagForceReset     = "force-reset"
+	flagResetOnce      = "reset-once"
+	flagResetStateOnly = "reset-state-only"
+	flagResetGenesis   = "reset-genesis"
+	flagResetOnBreak   = "reset-on-breaking"
+	flagConfig         = "config"
+	flagSandbox        = "sandbox"
+	flagSandboxImage   = "sandbox-image"
+	flagAPICORS        = "api-cors"
 )
 
 // NewChainServe creates a new serve command to serve a blockchain.
@@ -17,9 +30,29 @@ func NewChainServe() *cobra.Command {
 	c := &cobra.Command{
 		Use:   "serve",
 		Short: "Start a blockchain node in development",
-		Long:  "Start a blockchain node with automatic reloading",
-		Args:  cobra.NoArgs,
-		RunE:  chainServeHandler,
+		Long: `Start a blockchain node with automatic reloading.
+
+The app state is kept between serves. Use one of the reset flags to start again from a clean state:
+
+  --reset-once        removes the home of the chain, including the keys of the accounts
+  --reset-state-only  removes the blockchain data but keeps the genesis, the keys and the node ids
+  --reset-genesis     regenerates the genesis from config.yml but keeps the keys and the node ids
+
+Resets that keep the keys don't break the wallets configured with the accounts of the chain.
+
+When a change breaks the determinism of the app, the app hash doesn't match the saved state
+anymore and the node cannot start. The files changed since the build of the app that produced
+the saved state are listed, and with --reset-on-breaking the blockchain data is removed as with
+--reset-state-only.
+
+Use --sandbox docker to build and run the chain in a Docker container from the Ignite CLI image.
+The source of the app is mounted in the container and the ports of the node, the API and the
+faucet are published on the host. The data of the chain is kept in a Docker volume.
+
+Use --api-cors to set the origins allowed to query the node from browsers, the origins are
+applied when the chain is initialized.`,
+		Args: cobra.NoArgs,
+		RunE: chainServeHandler,
 	}
 
 	flagSetPath(c)
@@ -29,12 +62,30 @@ func NewChainServe() *cobra.Command {
 	c.Flags().BoolP("verbose", "v", false, "Verbose output")
 	c.Flags().BoolP(flagForceReset, "f", false, "Force reset of the app state on start and every source change")
 	c.Flags().BoolP(flagResetOnce, "r", false, "Reset of the app state on first start")
+	c.Flags().Bool(flagResetStateOnly, false, "Remove the blockchain data on first start, keeping the genesis, keys and node ids")
+	c.Flags().Bool(flagResetGenesis, false, "Regenerate the genesis from the config on first start, keeping keys and node ids")
+	c.Flags().Bool(flagResetOnBreak, false, "Remove the blockchain data when the app hash doesn't match the saved state, keeping the genesis, keys and node ids")
 	c.Flags().StringP(flagConfig, "c", "", "Ignite config file (default: ./config.yml)")
+	c.Flags().AddFlagSet(flagSetSkipBuild())
+	c.Flags().StringSlice(flagAPICORS, nil, "Origins allowed to query the RPC, the API and gRPC-web, overwrites the cors section of the config")
+	c.Flags().String(flagSandbox, "", "Serve the chain in a sandbox (docker)")
+	c.Flags().String(flagSandboxImage, "", "Docker image of the sandbox (default: the image of the current Ignite CLI version)")
 
 	return c
 }
 
 func chainServeHandler(cmd *cobra.Command, args []string) error {
+	var (
+		forceReset, _     = cmd.Flags().GetBool(flagForceReset)
+		resetOnce, _      = cmd.Flags().GetBool(flagResetOnce)
+		resetStateOnly, _ = cmd.Flags().GetBool(flagResetStateOnly)
+		resetGenesis, _   = cmd.Flags().GetBool(flagResetGenesis)
+		resetOnBreak, _   = cmd.Flags().GetBool(flagResetOnBreak)
+	)
+	if resetStateOnly && resetGenesis || (resetStateOnly || resetGenesis) && (forceReset || resetOnce) {
+		return errors.New("--reset-state-only and --reset-genesis cannot be used with other reset flags")
+	}
+
 	chainOption := []chain.Option{
 		chain.LogLevel(logLevel(cmd)),
 	}
@@ -52,6 +103,17 @@ func chainServeHandler(cmd *cobra.Command, args []string) error {
 		chainOption = append(chainOption, chain.ConfigFile(config))
 	}
 
+	if origins, _ := cmd.Flags().GetStringSlice(flagAPICORS); len(origins) > 0 {
+		chainOption = append(chainOption, chain.CORSAllowedOrigins(origins...))
+	}
+
+	if sandbox, _ := cmd.Flags().GetString(flagSandbox); sandbox != "" {
+		return chainServeInSandbox(cmd, sandbox, chainOption)
+	}
+
+	skipBuild, skipBuildOptions := flagGetSkipBuild(cmd)
+	chainOption = append(chainOption, skipBuildOptions...)
+
 	// create the chain
 	c, err := newChainWithHomeFlags(cmd, chainOption...)
 	if err != nil {
@@ -65,20 +127,96 @@ func chainServeHandler(cmd *cobra.Command, args []string) error {
 
 	// serve the chain
 	var serveOptions []chain.ServeOption
-	forceUpdate, err := cmd.Flags().GetBool(flagForceReset)
-	if err != nil {
-		return err
-	}
-	if forceUpdate {
+	if forceReset {
 		serveOptions = append(serveOptions, chain.ServeForceReset())
 	}
-	resetOnce, err := cmd.Flags().GetBool(flagResetOnce)
-	if err != nil {
-		return err
-	}
 	if resetOnce {
 		serveOptions = append(serveOptions, chain.ServeResetOnce())
 	}
+	if resetStateOnly {
+		serveOptions = append(serveOptions, chain.ServeResetStateOnly())
+	}
+	if resetGenesis {
+		serveOptions = append(serveOptions, chain.ServeResetGenesis())
+	}
+	if resetOnBreak {
+		serveOptions = append(serveOptions, chain.ServeResetOnBreaking())
+	}
+	if skipBuild {
+		serveOptions = append(serveOptions, chain.ServeSkipBuild())
+	}
 
 	return c.Serve(cmd.Context(), cacheStorage, serveOptions...)
 }
+
+// chainServeInSandbox serves the chain in a sandbox with the flags of the command.
+func chainServeInSandbox(cmd *cobra.Command, sandbox string, chainOption []chain.Option) error {
+	if skipBuild, _ := flagGetSkipBuild(cmd); skipBuild {
+		return fmt.Errorf("--%s cannot be used with --%s or --%s", flagSandbox, flagSkipBuild, flagBinary)
+	}
+
+	c, err := newChainWithHomeFlags(cmd, chainOption...)
+	if err != nil {
+		return err
+	}
+
+	// the flags are forwarded to the serve command of the sandbox, paths are relative
+	// to the app which is the working directory of the sandbox.
+	var (
+		args    []string
+		flagErr error
+	)
+	cmd.Flags().Visit(func(f *flag.Flag) {
+		switch f.Name {
+		case flagPath, flagChain, flagHome, flagSandbox, flagSandboxImage:
+		case flagConfig:
+			path, err := relativeToApp(flagGetPath(cmd), f.Value.String())
+			if err != nil {
+				flagErr = err
+				return
+			}
+			args = append(args, fmt.Sprintf("--%s=%s", f.Name, path))
+		case flagAPICORS:
+			origins, _ := cmd.Flags().GetStringSlice(f.Name)
+			args = append(args, fmt.Sprintf("--%s=%s", f.Name, strings.Join(origins, ",")))
+		default:
+			args = append(args, fmt.Sprintf("--%s=%s", f.Name, f.Value))
+		}
+	})
+	if flagErr != nil {
+		return flagErr
+	}
+
+	image, _ := cmd.Flags().GetString(flagSandboxImage)
+	if image == "" {
+		image = sandboxImage()
+	}
+
+	return c.ServeInSandbox(cmd.Context(), sandbox, image, args)
+}
+
+// sandboxImage returns the Ignite CLI Docker image of the current version.
+func sandboxImage() string {
+	const image = "ignitehq/cli"
+	if !strings.HasPrefix(version.Version, "v") {
+		return image + ":develop"
+	}
+	return image + ":" + strings.TrimPrefix(version.Version, "v")
+}
+
+// relativeToApp returns path relative to the app at appPath, path must be inside the app.
+func relativeToApp(appPath, path string) (string, error) {
+	absApp, err := filepath.Abs(appPath)
+	if err != nil {
+		return "", err
+	}
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return "", err
+	}
+	rel, err := filepath.Rel(absApp, absPath)
+	if err != nil || strings.HasPrefix(rel, "..") {
+		return "", fmt.Errorf("%s must be inside the app directory to be used in a sandbox", path)
+	}
+	return filepath.ToSlash(rel), nil
+}
@@ -2,6 +2,7 @@ package ignitecmd
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -16,10 +17,12 @@ import (
 	"github.com/ignite-hq/cli/ignite/chainconfig"
 	"github.com/ignite-hq/cli/ignite/pkg/cache"
 	"github.com/ignite-hq/cli/ignite/pkg/cliui"
+	"github.com/ignite-hq/cli/ignite/pkg/cliui/cliquiz"
 	"github.com/ignite-hq/cli/ignite/pkg/cosmosaccount"
 	"github.com/ignite-hq/cli/ignite/pkg/cosmosver"
 	"github.com/ignite-hq/cli/ignite/pkg/gitpod"
 	"github.com/ignite-hq/cli/ignite/pkg/goenv"
+	"github.com/ignite-hq/cli/ignite/pkg/i18n"
 	"github.com/ignite-hq/cli/ignite/pkg/xgenny"
 	"github.com/ignite-hq/cli/ignite/services/chain"
 	"github.com/ignite-hq/cli/ignite/services/scaffolder"
@@ -28,10 +31,13 @@ import (
 
 const (
 	flagPath          = "path"
+	flagChain         = "chain"
 	flagHome          = "home"
 	flagProto3rdParty = "proto-all-modules"
 	flagYes           = "yes"
 	flagClearCache    = "clear-cache"
+	flagSkipBuild     = "skip-build"
+	flagBinary        = "binary"
 
 	checkVersionTimeout = time.Millisecond * 600
 	cacheFileName       = "ignite_cache.db"
@@ -60,24 +66,50 @@ ignite scaffold chain github.com/username/mars`,
 				checkNewVersion(cmd.Context())
 			}
 
+			if err := selectChain(cmd); err != nil {
+				return err
+			}
+
 			return goenv.ConfigurePath()
 		},
 	}
 
 	c.AddCommand(NewScaffold())
+	c.AddCommand(NewAdopt())
 	c.AddCommand(NewChain())
 	c.AddCommand(NewGenerate())
 	c.AddCommand(NewNetwork())
 	c.AddCommand(NewAccount())
+	c.AddCommand(NewAddressBook())
 	c.AddCommand(NewRelayer())
 	c.AddCommand(NewTools())
 	c.AddCommand(NewDocs())
 	c.AddCommand(NewVersion())
 	c.AddCommand(deprecated()...)
 
+	translateCommands(c)
+
 	return c
 }
 
+// translateCommands translates the descriptions, the examples and the usages of the flags of
+// cmd and its sub commands to the language set in IGNITE_LANG.
+func translateCommands(cmd *cobra.Command) {
+	cmd.Short = i18n.T(cmd.Short)
+	cmd.Long = i18n.T(cmd.Long)
+	cmd.Example = i18n.T(cmd.Example)
+
+	// flag sets added to several commands share their flags, translations are not translated
+	// again since they are not messages of the catalogs.
+	translateFlag := func(f *flag.Flag) { f.Usage = i18n.T(f.Usage) }
+	cmd.Flags().VisitAll(translateFlag)
+	cmd.PersistentFlags().VisitAll(translateFlag)
+
+	for _, c := range cmd.Commands() {
+		translateCommands(c)
+	}
+}
+
 func logLevel(cmd *cobra.Command) chain.LogLvl {
 	verbose, _ := cmd.Flags().GetBool("verbose")
 	if verbose {
@@ -88,6 +120,7 @@ func logLevel(cmd *cobra.Command) chain.LogLvl {
 
 func flagSetPath(cmd *cobra.Command) {
 	cmd.PersistentFlags().StringP(flagPath, "p", ".", "path of the app")
+	cmd.PersistentFlags().String(flagChain, "", "Chain of a repository with several chains, by the name or the path of its directory")
 }
 
 func flagGetPath(cmd *cobra.Command) (path string) {
@@ -95,6 +128,20 @@ func flagGetPath(cmd *cobra.Command) (path string) {
 	return
 }
 
+// selectChain sets the path of the app to the directory of the chain selected with --chain
+// in the repository at the path of the app.
+func selectChain(cmd *cobra.Command) error {
+	name, _ := cmd.Flags().GetString(flagChain)
+	if name == "" {
+		return nil
+	}
+	dir, err := chainconfig.LocateChain(flagGetPath(cmd), name)
+	if err != nil {
+		return err
+	}
+	return cmd.Flags().Set(flagPath, dir)
+}
+
 func flagSetHome() *flag.FlagSet {
 	fs := flag.NewFlagSet("", flag.ContinueOnError)
 	fs.String(flagHome, "", "Home directory used for blockchains")
@@ -123,6 +170,21 @@ func getYes(cmd *cobra.Command) (ok bool) {
 	return
 }
 
+// confirm asks question to the user unless --yes is set and reports if the user said yes.
+// In non-interactive mode, it fails unless --yes is set.
+func confirm(cmd *cobra.Command, session cliui.Session, question string) (bool, error) {
+	if getYes(cmd) {
+		return true, nil
+	}
+	if err := session.AskConfirm(question); err != nil {
+		if errors.Is(err, cliquiz.ErrNonInteractive) {
+			return false, fmt.Errorf("%w, set --%s to confirm", err, flagYes)
+		}
+		return false, nil
+	}
+	return true, nil
+}
+
 func flagSetProto3rdParty(additionalInfo string) *flag.FlagSet {
 	fs := flag.NewFlagSet("", flag.ContinueOnError)
 
@@ -149,6 +211,23 @@ func flagGetClearCache(cmd *cobra.Command) bool {
 	return clearCache
 }
 
+func flagSetSkipBuild() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.Bool(flagSkipBuild, false, "Use the existing binary of the app instead of compiling it from source")
+	fs.String(flagBinary, "", "Name or path of a prebuilt binary to use, implies --skip-build")
+	return fs
+}
+
+// flagGetSkipBuild returns true when the app's binary must not be compiled, together with
+// the chain options needed to use the prebuilt binary.
+func flagGetSkipBuild(cmd *cobra.Command) (skipBuild bool, options []chain.Option) {
+	skipBuild, _ = cmd.Flags().GetBool(flagSkipBuild)
+	if binary, _ := cmd.Flags().GetString(flagBinary); binary != "" {
+		return true, []chain.Option{chain.PrebuiltBinary(binary)}
+	}
+	return skipBuild, nil
+}
+
 func newChainWithHomeFlags(cmd *cobra.Command, chainOption ...chain.Option) (*chain.Chain, error) {
 	// Check if custom home is provided
 	if home := getHome(cmd); home != "" {
@@ -165,22 +244,35 @@ func newChainWithHomeFlags(cmd *cobra.Command, chainOption ...chain.Option) (*ch
 }
 
 var (
-	modifyPrefix = color.New(color.FgMagenta).SprintFunc()("modify ")
-	createPrefix = color.New(color.FgGreen).SprintFunc()("create ")
-	removePrefix = func(s string) string {
-		return strings.TrimPrefix(strings.TrimPrefix(s, modifyPrefix), createPrefix)
+	modifyPrefix   = color.New(color.FgMagenta).SprintFunc()("modify ")
+	createPrefix   = color.New(color.FgGreen).SprintFunc()("create ")
+	conflictPrefix = color.New(color.FgRed).SprintFunc()("conflict ")
+	removePrefix   = func(s string) string {
+		for _, prefix := range []string{modifyPrefix, createPrefix, conflictPrefix} {
+			s = strings.TrimPrefix(s, prefix)
+		}
+		return s
 	}
 )
 
 func sourceModificationToString(sm xgenny.SourceModification) (string, error) {
 	// get file names and add prefix
 	var files []string
+	conflicted := make(map[string]bool)
+	for _, file := range sm.ConflictedFiles() {
+		conflicted[file] = true
+	}
 	for _, modified := range sm.ModifiedFiles() {
 		// get the relative app path from the current directory
 		relativePath, err := relativePath(modified)
 		if err != nil {
 			return "", err
 		}
+		// conflicts of the merge of the templates with local changes are marked in the file
+		if conflicted[modified] {
+			files = append(files, conflictPrefix+relativePath)
+			continue
+		}
 		files = append(files, modifyPrefix+relativePath)
 	}
 	for _, created := range sm.CreatedFiles() {
@@ -0,0 +1,38 @@
+package ignitecmd
+
+import (
+	"testing"
+
+	"github.com/spf13/cobra"
+	flag "github.com/spf13/pflag"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite-hq/cli/ignite/pkg/i18n"
+)
+
+func TestTranslateCommands(t *testing.T) {
+	// the language is loaded by the first translated message of the tests.
+	t.Setenv(i18n.EnvLang, "es")
+
+	var (
+		root  = &cobra.Command{Use: "ignite", Short: "Tools for advanced users"}
+		build = &cobra.Command{Use: "build", Short: "Build a node binary", Long: "Untranslated"}
+		serve = &cobra.Command{Use: "serve", Short: "Start a blockchain node in development"}
+	)
+	root.AddCommand(build, serve)
+	flagSetPath(root)
+
+	// flags shared by commands are translated once.
+	verbose := flag.NewFlagSet("", flag.ContinueOnError)
+	verbose.BoolP("verbose", "v", false, "Verbose output")
+	build.Flags().AddFlagSet(verbose)
+	serve.Flags().AddFlagSet(verbose)
+
+	translateCommands(root)
+
+	require.Equal(t, "Herramientas para usuarios avanzados", root.Short)
+	require.Equal(t, "Compila el binario del nodo", build.Short)
+	require.Equal(t, "Untranslated", build.Long)
+	require.Equal(t, "ruta de la aplicación", root.PersistentFlags().Lookup(flagPath).Usage)
+	require.Equal(t, "Salida detallada", serve.Flags().Lookup("verbose").Usage)
+}
@@ -11,17 +11,23 @@ func NewGenerate() *cobra.Command {
 
 Such as compiling protocol buffer files into Go or implement particular functionality, for example, generating an OpenAPI spec.
 
-Produced source code can be regenerated by running a command again and is not meant to be edited by hand.`,
+Produced source code can be regenerated by running a command again and is not meant to be edited by hand.
+
+Use --check-breaking to fail when your protos contain changes that break the wire or JSON
+compatibility with a previous version of your chain (by default, the latest git tag).`,
 		Aliases: []string{"g"},
-		Args:    cobra.ExactArgs(1),
+		Args:    cobra.NoArgs,
+		RunE:    generateHandler,
 	}
 
 	flagSetPath(c)
 	flagSetClearCache(c)
-	c.AddCommand(addGitChangesVerifier(NewGenerateGo()))
-	c.AddCommand(addGitChangesVerifier(NewGenerateVuex()))
-	c.AddCommand(addGitChangesVerifier(NewGenerateDart()))
-	c.AddCommand(addGitChangesVerifier(NewGenerateOpenAPI()))
+	c.PersistentFlags().AddFlagSet(flagSetCheckBreaking())
+	c.AddCommand(addBreakingChangesChecker(addGitChangesVerifier(NewGenerateGo())))
+	c.AddCommand(addBreakingChangesChecker(addGitChangesVerifier(NewGenerateVuex())))
+	c.AddCommand(addBreakingChangesChecker(addGitChangesVerifier(NewGenerateDart())))
+	c.AddCommand(addBreakingChangesChecker(addGitChangesVerifier(NewGenerateOpenAPI())))
+	c.AddCommand(addGitChangesVerifier(NewGenerateMocks()))
 
 	return c
 }
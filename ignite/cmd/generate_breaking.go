@@ -0,0 +1,86 @@
+package ignitecmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	flag "github.com/spf13/pflag"
+
+	"github.com/ignite-hq/cli/ignite/pkg/cliui/clispinner"
+)
+
+const (
+	flagCheckBreaking        = "check-breaking"
+	flagCheckBreakingAgainst = "check-breaking.against"
+)
+
+func flagSetCheckBreaking() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.Bool(flagCheckBreaking, false, "Fail when protos contain wire or JSON breaking changes compared to a previous version")
+	fs.String(flagCheckBreakingAgainst, "", "Git tag, branch or commit to compare protos with (default: latest tag)")
+	return fs
+}
+
+func flagGetCheckBreaking(cmd *cobra.Command) (enabled bool, against string) {
+	enabled, _ = cmd.Flags().GetBool(flagCheckBreaking)
+	against, _ = cmd.Flags().GetString(flagCheckBreakingAgainst)
+	return
+}
+
+// addBreakingChangesChecker makes the command check for proto breaking changes
+// before running when --check-breaking is set.
+func addBreakingChangesChecker(cmd *cobra.Command) *cobra.Command {
+	preRunFun := cmd.PreRunE
+	cmd.PreRunE = func(cmd *cobra.Command, args []string) error {
+		if preRunFun != nil {
+			if err := preRunFun(cmd, args); err != nil {
+				return err
+			}
+		}
+
+		if enabled, _ := flagGetCheckBreaking(cmd); !enabled {
+			return nil
+		}
+		return checkBreakingChanges(cmd)
+	}
+	return cmd
+}
+
+func generateHandler(cmd *cobra.Command, _ []string) error {
+	if enabled, _ := flagGetCheckBreaking(cmd); !enabled {
+		return cmd.Help()
+	}
+	return checkBreakingChanges(cmd)
+}
+
+func checkBreakingChanges(cmd *cobra.Command) error {
+	s := clispinner.New().SetText("Checking for breaking changes...")
+	defer s.Stop()
+
+	_, against := flagGetCheckBreaking(cmd)
+
+	c, err := newChainWithHomeFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	changes, err := c.BreakingChanges(cmd.Context(), against)
+	if err != nil {
+		return err
+	}
+
+	s.Stop()
+
+	if len(changes) == 0 {
+		fmt.Println("✅ No breaking changes found in protos.")
+		return nil
+	}
+
+	fmt.Print("❌ Breaking changes found in protos:\n\n")
+	for _, change := range changes {
+		fmt.Printf("  - %s\n", change)
+	}
+	fmt.Println()
+
+	return fmt.Errorf("%d breaking change(s) found", len(changes))
+}
@@ -0,0 +1,48 @@
+package ignitecmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite-hq/cli/ignite/pkg/cliui/clispinner"
+	"github.com/ignite-hq/cli/ignite/services/chain"
+)
+
+func NewGenerateMocks() *cobra.Command {
+	return &cobra.Command{
+		Use:   "mocks",
+		Short: "Generate mocks for the expected keepers of your modules",
+		Long: `Generate gomock mocks for the interfaces declared in the expected_keepers.go file of
+every module into the testutil/mocks package, so keeper unit tests don't require wiring the
+real keepers the module depends on.
+
+Mock names are prefixed with the module name, for example the BankKeeper interface of the
+"mars" module is mocked by MockMarsBankKeeper.`,
+		RunE: generateMocksHandler,
+	}
+}
+
+func generateMocksHandler(cmd *cobra.Command, args []string) error {
+	s := clispinner.New().SetText("Generating...")
+	defer s.Stop()
+
+	c, err := newChainWithHomeFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	cacheStorage, err := newCache(cmd)
+	if err != nil {
+		return err
+	}
+
+	if err := c.Generate(cmd.Context(), cacheStorage, chain.GenerateMocks()); err != nil {
+		return err
+	}
+
+	s.Stop()
+	fmt.Println("⛏️  Generated mocks.")
+
+	return nil
+}
@@ -59,6 +59,7 @@ func NewNetwork() *cobra.Command {
 		NewNetworkRequest(),
 		NewNetworkReward(),
 		NewNetworkClient(),
+		NewNetworkValidator(),
 	)
 
 	return c
@@ -22,6 +22,7 @@ func NewNetworkChain() *cobra.Command {
 		NewNetworkChainShow(),
 		NewNetworkChainLaunch(),
 		NewNetworkChainRevertLaunch(),
+		NewNetworkChainUpgrade(),
 	)
 
 	return c
@@ -24,6 +24,11 @@ const (
 	flagValidatorIdentity        = "validator-identity"
 	flagValidatorSelfDelegation  = "validator-self-delegation"
 	flagValidatorGasPrice        = "validator-gas-price"
+
+	flagValidatorStakingAmount           = "validator-staking-amount"
+	flagValidatorCommissionRate          = "validator-commission-rate"
+	flagValidatorCommissionMaxRate       = "validator-commission-max-rate"
+	flagValidatorCommissionMaxChangeRate = "validator-commission-max-change-rate"
 )
 
 // NewNetworkChainInit returns a new command to initialize a chain from a published chain ID
@@ -44,6 +49,10 @@ func NewNetworkChainInit() *cobra.Command {
 	c.Flags().String(flagValidatorIdentity, "", "Validator identity signature (ex. UPort or Keybase)")
 	c.Flags().String(flagValidatorSelfDelegation, "", "Validator minimum self delegation")
 	c.Flags().String(flagValidatorGasPrice, "", "Validator gas price")
+	c.Flags().String(flagValidatorStakingAmount, "", "Validator staking amount, asked when not set")
+	c.Flags().String(flagValidatorCommissionRate, "", "Validator commission rate, asked when not set")
+	c.Flags().String(flagValidatorCommissionMaxRate, "", "Validator commission max rate, asked when not set")
+	c.Flags().String(flagValidatorCommissionMaxChangeRate, "", "Validator commission max change rate, asked when not set")
 	c.Flags().AddFlagSet(flagNetworkFrom())
 	c.Flags().AddFlagSet(flagSetHome())
 	c.Flags().AddFlagSet(flagSetKeyringBackend())
@@ -79,12 +88,16 @@ func networkChainInitHandler(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	if !getYes(cmd) && exist {
+	if exist {
 		question := fmt.Sprintf(
 			"The chain has already been initialized under: %s. Would you like to overwrite the home directory",
 			chainHome,
 		)
-		if err := session.AskConfirm(question); err != nil {
+		confirmed, err := confirm(cmd, session, question)
+		if err != nil {
+			return err
+		}
+		if !confirmed {
 			return session.PrintSaidNo()
 		}
 	}
@@ -151,6 +164,11 @@ func askValidatorInfo(cmd *cobra.Command, session cliui.Session, stakeDenom stri
 		identity, _        = cmd.Flags().GetString(flagValidatorIdentity)
 		selfDelegation, _  = cmd.Flags().GetString(flagValidatorSelfDelegation)
 		gasPrice, _        = cmd.Flags().GetString(flagValidatorGasPrice)
+
+		stakingAmount, _           = cmd.Flags().GetString(flagValidatorStakingAmount)
+		commissionRate, _          = cmd.Flags().GetString(flagValidatorCommissionRate)
+		commissionMaxRate, _       = cmd.Flags().GetString(flagValidatorCommissionMaxRate)
+		commissionMaxChangeRate, _ = cmd.Flags().GetString(flagValidatorCommissionMaxChangeRate)
 	)
 	if gasPrice == "" {
 		gasPrice = "0" + stakeDenom
@@ -164,29 +182,42 @@ func askValidatorInfo(cmd *cobra.Command, session cliui.Session, stakeDenom stri
 		SecurityContact:   securityContact,
 		MinSelfDelegation: selfDelegation,
 		GasPrices:         gasPrice,
+
+		StakingAmount:           stakingAmount,
+		CommissionRate:          commissionRate,
+		CommissionMaxRate:       commissionMaxRate,
+		CommissionMaxChangeRate: commissionMaxChangeRate,
 	}
 
-	questions := append([]cliquiz.Question{},
-		cliquiz.NewQuestion("Staking amount",
+	// only the values that are not set with their flags are asked.
+	var questions []cliquiz.Question
+	if v.StakingAmount == "" {
+		questions = append(questions, cliquiz.NewQuestion("Staking amount",
 			&v.StakingAmount,
 			cliquiz.DefaultAnswer("95000000stake"),
 			cliquiz.Required(),
-		),
-		cliquiz.NewQuestion("Commission rate",
+		))
+	}
+	if v.CommissionRate == "" {
+		questions = append(questions, cliquiz.NewQuestion("Commission rate",
 			&v.CommissionRate,
 			cliquiz.DefaultAnswer("0.10"),
 			cliquiz.Required(),
-		),
-		cliquiz.NewQuestion("Commission max rate",
+		))
+	}
+	if v.CommissionMaxRate == "" {
+		questions = append(questions, cliquiz.NewQuestion("Commission max rate",
 			&v.CommissionMaxRate,
 			cliquiz.DefaultAnswer("0.20"),
 			cliquiz.Required(),
-		),
-		cliquiz.NewQuestion("Commission max change rate",
+		))
+	}
+	if v.CommissionMaxChangeRate == "" {
+		questions = append(questions, cliquiz.NewQuestion("Commission max change rate",
 			&v.CommissionMaxChangeRate,
 			cliquiz.DefaultAnswer("0.01"),
 			cliquiz.Required(),
-		),
-	)
+		))
+	}
 	return v, session.Ask(questions...)
 }
@@ -19,8 +19,9 @@ import (
 )
 
 const (
-	flagGentx  = "gentx"
-	flagAmount = "amount"
+	flagGentx       = "gentx"
+	flagAmount      = "amount"
+	flagPeerAddress = "peer-address"
 )
 
 // NewNetworkChainJoin creates a new chain join command to join
@@ -34,6 +35,7 @@ func NewNetworkChainJoin() *cobra.Command {
 	}
 	c.Flags().String(flagGentx, "", "Path to a gentx json file")
 	c.Flags().String(flagAmount, "", "Amount of coins for account request")
+	c.Flags().String(flagPeerAddress, "", "Public address of the peer of the validator, asked when not set")
 	c.Flags().AddFlagSet(flagNetworkFrom())
 	c.Flags().AddFlagSet(flagSetHome())
 	c.Flags().AddFlagSet(flagSetKeyringBackend())
@@ -46,8 +48,9 @@ func networkChainJoinHandler(cmd *cobra.Command, args []string) error {
 	defer session.Cleanup()
 
 	var (
-		gentxPath, _ = cmd.Flags().GetString(flagGentx)
-		amount, _    = cmd.Flags().GetString(flagAmount)
+		gentxPath, _   = cmd.Flags().GetString(flagGentx)
+		amount, _      = cmd.Flags().GetString(flagAmount)
+		peerAddress, _ = cmd.Flags().GetString(flagPeerAddress)
 	)
 
 	nb, err := newNetworkBuilder(cmd, CollectEvents(session.EventBus()))
@@ -68,9 +71,11 @@ func networkChainJoinHandler(cmd *cobra.Command, args []string) error {
 	// if there is no custom gentx, we need to detect the public address.
 	if gentxPath == "" {
 		// get the peer public address for the validator.
-		publicAddr, err := askPublicAddress(cmd.Context(), session)
-		if err != nil {
-			return err
+		publicAddr := peerAddress
+		if publicAddr == "" {
+			if publicAddr, err = askPublicAddress(cmd.Context(), session); err != nil {
+				return err
+			}
 		}
 
 		joinOptions = append(joinOptions, network.WithPublicAddress(publicAddr))
@@ -99,14 +104,16 @@ func networkChainJoinHandler(cmd *cobra.Command, args []string) error {
 		}
 		joinOptions = append(joinOptions, network.WithAccountRequest(amountCoins))
 	} else {
-		if !getYes(cmd) {
-			question := fmt.Sprintf(
-				"You haven't set the --%s flag and therefore an account request won't be submitted. Do you confirm",
-				flagAmount,
-			)
-			if err := session.AskConfirm(question); err != nil {
-				return session.PrintSaidNo()
-			}
+		question := fmt.Sprintf(
+			"You haven't set the --%s flag and therefore an account request won't be submitted. Do you confirm",
+			flagAmount,
+		)
+		confirmed, err := confirm(cmd, session, question)
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			return session.PrintSaidNo()
 		}
 
 		session.Printf("%s %s\n", icons.Info, "Account request won't be submitted")
@@ -11,7 +11,6 @@ import (
 
 	"github.com/ignite-hq/cli/ignite/pkg/cliui"
 	"github.com/ignite-hq/cli/ignite/pkg/cliui/icons"
-	"github.com/ignite-hq/cli/ignite/services/network"
 )
 
 func newNetworkChainShowPeers() *cobra.Command {
@@ -33,28 +32,10 @@ func networkChainShowPeersHandler(cmd *cobra.Command, args []string) error {
 
 	out, _ := cmd.Flags().GetString(flagOut)
 
-	nb, launchID, err := networkChainLaunch(cmd, args, session)
+	peers, err := networkLaunchPeers(cmd, args, session)
 	if err != nil {
 		return err
 	}
-	n, err := nb.Network()
-	if err != nil {
-		return err
-	}
-
-	genVals, err := n.GenesisValidators(cmd.Context(), launchID)
-	if err != nil {
-		return err
-	}
-
-	peers := make([]string, 0)
-	for _, acc := range genVals {
-		peer, err := network.PeerAddress(acc.Peer)
-		if err != nil {
-			return err
-		}
-		peers = append(peers, peer)
-	}
 
 	if len(peers) == 0 {
 		session.Printf("%s %s\n", icons.Info, "no peers found")
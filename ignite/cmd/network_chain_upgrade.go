@@ -0,0 +1,198 @@
+package ignitecmd
+
+import (
+	"errors"
+	"os"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite-hq/cli/ignite/pkg/cliui"
+	"github.com/ignite-hq/cli/ignite/pkg/cliui/icons"
+	"github.com/ignite-hq/cli/ignite/pkg/cosmovisor"
+	"github.com/ignite-hq/cli/ignite/services/network"
+	"github.com/ignite-hq/cli/ignite/services/network/networkchain"
+	"github.com/ignite-hq/cli/ignite/services/network/networktypes"
+)
+
+const (
+	flagChecksum   = "checksum"
+	flagDaemonHome = "daemon-home"
+	flagDaemonName = "daemon-name"
+)
+
+// NewNetworkChainUpgrade creates a new upgrade command that holds the sub commands to
+// coordinate the upgrades of a launched chain.
+func NewNetworkChainUpgrade() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "upgrade",
+		Short: "Coordinate the upgrades of a launched chain",
+	}
+	c.AddCommand(
+		NewNetworkChainUpgradePublish(),
+		NewNetworkChainUpgradeShow(),
+		NewNetworkChainUpgradeStage(),
+	)
+	return c
+}
+
+// NewNetworkChainUpgradePublish creates a new command to publish the upgrade plan of a chain
+// as its coordinator.
+func NewNetworkChainUpgradePublish() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "publish [launch-id] [name] [height] [binary-url]",
+		Short: "Publish the upgrade plan of a chain",
+		Long: `Publish the upgrade plan of a chain as its coordinator.
+
+The name and the height are the ones of the software upgrade proposal of the chain, the binary
+is the new binary of the chain. Its sha256 checksum is computed by downloading the binary when
+--checksum is not set. Validators stage the binary into cosmovisor with
+"ignite network chain upgrade stage".`,
+		Args: cobra.ExactArgs(4),
+		RunE: networkChainUpgradePublishHandler,
+	}
+	c.Flags().String(flagChecksum, "", "Hex encoded sha256 checksum of the binary")
+	c.Flags().AddFlagSet(flagSetKeyringBackend())
+	c.Flags().AddFlagSet(flagNetworkFrom())
+	c.Flags().AddFlagSet(flagSetHome())
+	return c
+}
+
+func networkChainUpgradePublishHandler(cmd *cobra.Command, args []string) error {
+	session := cliui.New()
+	defer session.Cleanup()
+
+	nb, err := newNetworkBuilder(cmd, CollectEvents(session.EventBus()))
+	if err != nil {
+		return err
+	}
+
+	launchID, err := network.ParseID(args[0])
+	if err != nil {
+		return err
+	}
+	height, err := strconv.ParseInt(args[2], 10, 64)
+	if err != nil {
+		return err
+	}
+
+	plan := networktypes.UpgradePlan{
+		Name:      args[1],
+		Height:    height,
+		BinaryURL: args[3],
+	}
+	plan.Checksum, _ = cmd.Flags().GetString(flagChecksum)
+	if plan.Checksum == "" {
+		session.StartSpinner("Computing the checksum of the binary...")
+		if plan.Checksum, err = cosmovisor.Checksum(cmd.Context(), plan.BinaryURL); err != nil {
+			return err
+		}
+	}
+
+	n, err := nb.Network()
+	if err != nil {
+		return err
+	}
+
+	return n.PublishUpgrade(cmd.Context(), launchID, plan)
+}
+
+// NewNetworkChainUpgradeShow creates a new command to show the upgrade plan of a chain.
+func NewNetworkChainUpgradeShow() *cobra.Command {
+	return &cobra.Command{
+		Use:   "show [launch-id]",
+		Short: "Show the upgrade plan of a chain",
+		Args:  cobra.ExactArgs(1),
+		RunE:  networkChainUpgradeShowHandler,
+	}
+}
+
+func networkChainUpgradeShowHandler(cmd *cobra.Command, args []string) error {
+	session := cliui.New()
+	defer session.Cleanup()
+
+	plan, err := networkChainUpgradePlan(cmd, args, session)
+	if err != nil {
+		return err
+	}
+	session.StopSpinner()
+
+	return session.PrintTable(
+		[]string{"Name", "Height", "Binary URL", "Checksum"},
+		[]string{plan.Name, strconv.FormatInt(plan.Height, 10), plan.BinaryURL, plan.Checksum},
+	)
+}
+
+// NewNetworkChainUpgradeStage creates a new command to stage the binary of the upgrade of a
+// chain into cosmovisor.
+func NewNetworkChainUpgradeStage() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "stage [launch-id]",
+		Short: "Stage the binary of the upgrade of a chain into cosmovisor",
+		Long: `Download the binary of the upgrade plan of a chain, verify its checksum and install it in
+the upgrades of cosmovisor, so the node switches to the new binary at the upgrade height.
+
+The home and the binary name of cosmovisor default to the DAEMON_HOME and DAEMON_NAME env vars,
+the home defaults to the home of the chain initialized with "ignite network chain init".`,
+		Args: cobra.ExactArgs(1),
+		RunE: networkChainUpgradeStageHandler,
+	}
+	c.Flags().String(flagDaemonHome, "", "Home of the node run by cosmovisor")
+	c.Flags().String(flagDaemonName, "", "Name of the binary of the chain")
+	return c
+}
+
+func networkChainUpgradeStageHandler(cmd *cobra.Command, args []string) error {
+	session := cliui.New()
+	defer session.Cleanup()
+
+	var (
+		home, _ = cmd.Flags().GetString(flagDaemonHome)
+		name, _ = cmd.Flags().GetString(flagDaemonName)
+	)
+	if name == "" {
+		name = os.Getenv(cosmovisor.EnvDaemonName)
+	}
+	if name == "" {
+		return errors.New("the name of the binary of the chain is required, set --" + flagDaemonName)
+	}
+
+	launchID, err := network.ParseID(args[0])
+	if err != nil {
+		return err
+	}
+	if home == "" {
+		home = os.Getenv(cosmovisor.EnvDaemonHome)
+	}
+	if home == "" {
+		home = networkchain.ChainHome(launchID)
+	}
+
+	plan, err := networkChainUpgradePlan(cmd, args, session)
+	if err != nil {
+		return err
+	}
+
+	session.StartSpinner("Downloading the binary...")
+	path, err := cosmovisor.Stage(cmd.Context(), home, name, plan.Name, plan.BinaryURL, plan.Checksum)
+	if err != nil {
+		return err
+	}
+	session.StopSpinner()
+
+	return session.Printf("%s Upgrade %s staged in %s, the node switches to it at height %d\n",
+		icons.OK, plan.Name, path, plan.Height)
+}
+
+// networkChainUpgradePlan fetches the upgrade plan of the chain of the launch id in args.
+func networkChainUpgradePlan(cmd *cobra.Command, args []string, session cliui.Session) (networktypes.UpgradePlan, error) {
+	nb, launchID, err := networkChainLaunch(cmd, args, session)
+	if err != nil {
+		return networktypes.UpgradePlan{}, err
+	}
+	n, err := nb.Network()
+	if err != nil {
+		return networktypes.UpgradePlan{}, err
+	}
+	return n.UpgradePlan(cmd.Context(), launchID)
+}
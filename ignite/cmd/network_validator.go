@@ -0,0 +1,18 @@
+package ignitecmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// NewNetworkValidator creates a new validator command that holds some other sub commands
+// related to running a validator node.
+func NewNetworkValidator() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "validator",
+		Short: "Prepare a validator node",
+	}
+	c.AddCommand(
+		NewNetworkValidatorCheck(),
+	)
+	return c
+}
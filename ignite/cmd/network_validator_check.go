@@ -0,0 +1,117 @@
+package ignitecmd
+
+import (
+	"errors"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite-hq/cli/ignite/pkg/cliui"
+	"github.com/ignite-hq/cli/ignite/pkg/cliui/icons"
+	"github.com/ignite-hq/cli/ignite/pkg/nodecheck"
+	"github.com/ignite-hq/cli/ignite/services/network"
+)
+
+const flagDataDir = "data-dir"
+
+// NewNetworkValidatorCheck creates a new command to check the machine of a validator.
+func NewNetworkValidatorCheck() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "check [launch-id]",
+		Short: "Check that the machine keeps up with running a validator node",
+		Long: `Check that the machine keeps up with running a validator node before joining a launch.
+
+The available RAM, the open files limit and the IOPS of the disk of the node data are measured
+and compared to the requirements of a testnet validator. When a launch ID is given, the peers
+published for the launch are dialed to check the connectivity of the machine.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: networkValidatorCheckHandler,
+	}
+
+	c.Flags().String(flagDataDir, "", "Directory of the node data, its disk is measured (default: the home directory)")
+
+	return c
+}
+
+func networkValidatorCheckHandler(cmd *cobra.Command, args []string) error {
+	session := cliui.New()
+	defer session.Cleanup()
+
+	dir, _ := cmd.Flags().GetString(flagDataDir)
+	if dir == "" {
+		var err error
+		if dir, err = os.UserHomeDir(); err != nil {
+			return err
+		}
+	}
+
+	req := nodecheck.DefaultRequirements
+
+	session.StartSpinner("Checking the machine...")
+	results := []nodecheck.Result{
+		nodecheck.CheckRAM(req),
+		nodecheck.CheckOpenFiles(req),
+		nodecheck.CheckDiskIOPS(req, dir),
+	}
+
+	if len(args) == 1 {
+		peers, err := networkLaunchPeers(cmd, args, session)
+		if err != nil {
+			return err
+		}
+		session.StartSpinner("Dialing the peers...")
+		for _, peer := range peers {
+			results = append(results, nodecheck.CheckPeer(cmd.Context(), peer))
+		}
+	}
+	session.StopSpinner()
+
+	failed := false
+	for _, r := range results {
+		switch {
+		case r.OK():
+			session.Printf("%s %s: %s\n", icons.OK, r.Name, r.Value)
+		case errors.Is(r.Err, nodecheck.ErrNotSupported):
+			session.Printf("%s %s: not checked, %s\n", icons.Info, r.Name, r.Err)
+		default:
+			failed = true
+			if r.Value != "" {
+				session.Printf("%s %s: %s, %s\n", icons.NotOK, r.Name, r.Value, r.Err)
+			} else {
+				session.Printf("%s %s: %s\n", icons.NotOK, r.Name, r.Err)
+			}
+		}
+	}
+
+	if failed {
+		return session.Printf("\n%s The machine may not keep up with the chain, fix the failed checks before joining a launch\n", icons.Bullet)
+	}
+	return session.Printf("\n%s The machine is ready to run a validator node\n", icons.Bullet)
+}
+
+// networkLaunchPeers returns the addresses of the peers of the genesis validators of the launch.
+func networkLaunchPeers(cmd *cobra.Command, args []string, session cliui.Session) ([]string, error) {
+	nb, launchID, err := networkChainLaunch(cmd, args, session)
+	if err != nil {
+		return nil, err
+	}
+	n, err := nb.Network()
+	if err != nil {
+		return nil, err
+	}
+
+	genVals, err := n.GenesisValidators(cmd.Context(), launchID)
+	if err != nil {
+		return nil, err
+	}
+
+	peers := make([]string, 0, len(genVals))
+	for _, acc := range genVals {
+		peer, err := network.PeerAddress(acc.Peer)
+		if err != nil {
+			return nil, err
+		}
+		peers = append(peers, peer)
+	}
+	return peers, nil
+}
@@ -18,6 +18,9 @@ func NewRelayer() *cobra.Command {
 	c.AddCommand(
 		NewRelayerConfigure(),
 		NewRelayerConnect(),
+		NewRelayerKeepAlive(),
+		NewRelayerRotateKey(),
+		NewRelayerStatus(),
 	)
 
 	return c
@@ -3,15 +3,19 @@ package ignitecmd
 import (
 	"bytes"
 	"fmt"
+	"os"
 	"text/tabwriter"
 
 	"github.com/spf13/cobra"
+	"golang.org/x/sync/errgroup"
 
 	"github.com/ignite-hq/cli/ignite/pkg/cliui"
 	"github.com/ignite-hq/cli/ignite/pkg/cosmosaccount"
 	"github.com/ignite-hq/cli/ignite/pkg/relayer"
 )
 
+const flagMetricsAddress = "metrics-address"
+
 // NewRelayerConnect returns a new relayer connect command to link all or some relayer paths and start
 // relaying txs in between.
 // if not paths are specified, all paths are linked.
@@ -23,6 +27,7 @@ func NewRelayerConnect() *cobra.Command {
 	}
 
 	c.Flags().AddFlagSet(flagSetKeyringBackend())
+	c.Flags().String(flagMetricsAddress, "", "Serve Prometheus metrics of the relayed paths on this address (e.g. localhost:9100)")
 
 	return c
 }
@@ -49,7 +54,7 @@ func relayerConnectHandler(cmd *cobra.Command, args []string) (err error) {
 	var (
 		use []string
 		ids = args
-		r   = relayer.New(ca)
+		r   = relayer.New(ca, relayer.WithOutput(os.Stdout))
 	)
 
 	all, err := r.ListPaths(cmd.Context())
@@ -118,5 +123,22 @@ func relayerConnectHandler(cmd *cobra.Command, args []string) (err error) {
 		return err
 	}
 
-	return r.Start(cmd.Context(), use...)
+	metricsAddress, _ := cmd.Flags().GetString(flagMetricsAddress)
+	if metricsAddress == "" {
+		return r.Start(cmd.Context(), use...)
+	}
+
+	if err := session.Printf("📈 Serving metrics at http://%s/metrics\n", metricsAddress); err != nil {
+		return err
+	}
+
+	g, ctx := errgroup.WithContext(cmd.Context())
+	g.Go(func() error {
+		return relayer.ServeMetrics(ctx, metricsAddress)
+	})
+	g.Go(func() error {
+		return r.Start(ctx, use...)
+	})
+
+	return g.Wait()
 }
@@ -0,0 +1,166 @@
+package ignitecmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite-hq/cli/ignite/pkg/cliui"
+	"github.com/ignite-hq/cli/ignite/pkg/cosmosaccount"
+	"github.com/ignite-hq/cli/ignite/pkg/ctxticker"
+	"github.com/ignite-hq/cli/ignite/pkg/relayer"
+)
+
+const (
+	flagThreshold = "threshold"
+	flagInterval  = "interval"
+	flagWebhook   = "webhook"
+	flagOnce      = "once"
+)
+
+// NewRelayerKeepAlive returns a new relayer keepalive command to refresh the IBC clients of
+// paths before they expire.
+func NewRelayerKeepAlive() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "keepalive [<path>,...]",
+		Short: "Update the IBC clients of paths before they expire",
+		Long: `Update the IBC clients of paths before they expire.
+
+A client expires when it is not updated during its trusting period, which happens when no
+packets are relayed for a long time. Channels that use an expired client stop working.
+
+The clients of all linked paths, or only the given ones, are checked every interval and the
+ones expiring in less than the threshold are updated with a recent header of their
+counterparty chain. When --webhook is set, a JSON payload is posted to the URL each time a
+client is updated or cannot be refreshed.`,
+		RunE: relayerKeepAliveHandler,
+	}
+
+	c.Flags().AddFlagSet(flagSetKeyringBackend())
+	c.Flags().Duration(flagThreshold, time.Hour*72, "Update the clients expiring in less than this duration")
+	c.Flags().Duration(flagInterval, time.Hour, "Duration between two checks of the clients")
+	c.Flags().String(flagWebhook, "", "URL to post alerts to when a client is updated or cannot be refreshed")
+	c.Flags().Bool(flagOnce, false, "Check the clients once and exit")
+
+	return c
+}
+
+func relayerKeepAliveHandler(cmd *cobra.Command, args []string) (err error) {
+	defer func() {
+		err = handleRelayerAccountErr(err)
+	}()
+
+	session := cliui.New()
+	defer session.Cleanup()
+
+	var (
+		threshold, _ = cmd.Flags().GetDuration(flagThreshold)
+		interval, _  = cmd.Flags().GetDuration(flagInterval)
+		webhook, _   = cmd.Flags().GetString(flagWebhook)
+		once, _      = cmd.Flags().GetBool(flagOnce)
+	)
+
+	ca, err := cosmosaccount.New(
+		cosmosaccount.WithKeyringBackend(getKeyringBackend(cmd)),
+	)
+	if err != nil {
+		return err
+	}
+
+	r := relayer.New(ca)
+
+	refresh := func() error {
+		session.StartSpinner("Checking clients...")
+
+		refreshes, err := r.RefreshClients(cmd.Context(), threshold, args...)
+		session.StopSpinner()
+		if err != nil {
+			if once {
+				return err
+			}
+
+			// the clients are checked again on the next interval.
+			session.Printf("❌ cannot check clients: %s\n", err)
+			return nil
+		}
+
+		for _, refresh := range refreshes {
+			switch {
+			case refresh.Error != nil:
+				session.Printf("❌ %s: client %q on %q cannot be refreshed: %s\n",
+					refresh.PathID, refresh.ClientID, refresh.ChainID, refresh.Error)
+			case refresh.Updated:
+				session.Printf("🔄 %s: client %q on %q updated, expires at %s\n",
+					refresh.PathID, refresh.ClientID, refresh.ChainID, refresh.ExpiresAt.Format(time.RFC3339))
+			default:
+				continue
+			}
+
+			if webhook == "" {
+				continue
+			}
+			if err := postKeepAliveAlert(cmd.Context(), webhook, refresh); err != nil {
+				session.Printf("❌ cannot post alert to the webhook: %s\n", err)
+			}
+		}
+
+		return nil
+	}
+
+	if once {
+		return refresh()
+	}
+
+	return ctxticker.DoNow(cmd.Context(), interval, refresh)
+}
+
+// keepAliveAlert is the payload posted to the webhook.
+type keepAliveAlert struct {
+	Path      string    `json:"path"`
+	ChainID   string    `json:"chain_id"`
+	ClientID  string    `json:"client_id"`
+	ExpiresAt time.Time `json:"expires_at"`
+	Updated   bool      `json:"updated"`
+	Error     string    `json:"error,omitempty"`
+}
+
+func postKeepAliveAlert(ctx context.Context, url string, refresh relayer.ClientRefresh) error {
+	alert := keepAliveAlert{
+		Path:      refresh.PathID,
+		ChainID:   refresh.ChainID,
+		ClientID:  refresh.ClientID,
+		ExpiresAt: refresh.ExpiresAt,
+		Updated:   refresh.Updated,
+	}
+	if refresh.Error != nil {
+		alert.Error = refresh.Error.Error()
+	}
+
+	body, err := json.Marshal(alert)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("webhook responded with %s", res.Status)
+	}
+
+	return nil
+}
@@ -0,0 +1,67 @@
+package ignitecmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ignite-hq/cli/ignite/pkg/cliui"
+	"github.com/ignite-hq/cli/ignite/pkg/cosmosaccount"
+	"github.com/ignite-hq/cli/ignite/pkg/relayer"
+)
+
+// NewRelayerRotateKey returns a new relayer rotate-key command to replace the account used
+// by the relayer for a chain.
+func NewRelayerRotateKey() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "rotate-key [chain-id] [account]",
+		Short: "Replace the relayer account of a chain with a new one",
+		Long: `Replace the relayer account of a chain with a new one.
+
+A new account is created, the relayer config is updated to use it and all the balances of
+the current relayer account on the chain, but the fee of the transfer, are transferred to it.
+When the transfer fails, the config is restored and the new account is deleted. The previous
+account is not deleted, remove it with "ignite account delete" when it is not used by other
+chains.`,
+		Args: cobra.ExactArgs(2),
+		RunE: relayerRotateKeyHandler,
+	}
+
+	c.Flags().AddFlagSet(flagSetKeyringBackend())
+
+	return c
+}
+
+func relayerRotateKeyHandler(cmd *cobra.Command, args []string) (err error) {
+	defer func() {
+		err = handleRelayerAccountErr(err)
+	}()
+
+	session := cliui.New()
+	defer session.Cleanup()
+
+	var (
+		chainID = args[0]
+		name    = args[1]
+	)
+
+	ca, err := cosmosaccount.New(
+		cosmosaccount.WithKeyringBackend(getKeyringBackend(cmd)),
+	)
+	if err != nil {
+		return err
+	}
+
+	session.StartSpinner("Rotating relayer account...")
+
+	account, mnemonic, err := relayer.New(ca).RotateKey(cmd.Context(), chainID, name)
+	if err != nil {
+		return err
+	}
+
+	session.StopSpinner()
+
+	return session.Printf("🔑 Relayer account for %q rotated to %q, keep your mnemonic in a secret place:\n\n%s\n",
+		chainID,
+		account.Name,
+		mnemonic,
+	)
+}
@@ -0,0 +1,119 @@
+package ignitecmd
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite-hq/cli/ignite/pkg/cliui"
+	"github.com/ignite-hq/cli/ignite/pkg/cosmosaccount"
+	"github.com/ignite-hq/cli/ignite/pkg/relayer"
+)
+
+var relayerStatusHeader = []string{
+	"Path",
+	"Chain",
+	"Client",
+	"Connection",
+	"Channel",
+	"Packet Height",
+	"Ack Height",
+	"Pending Packets",
+	"Balances",
+}
+
+// NewRelayerStatus returns a new relayer status command to show the health of the paths.
+// if no paths are specified, the status of all paths is shown.
+func NewRelayerStatus() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "status [<path>,...]",
+		Short: "Show the status of the paths and the balances of the relayer accounts",
+		RunE:  relayerStatusHandler,
+	}
+
+	c.Flags().AddFlagSet(flagSetKeyringBackend())
+
+	return c
+}
+
+func relayerStatusHandler(cmd *cobra.Command, args []string) (err error) {
+	defer func() {
+		err = handleRelayerAccountErr(err)
+	}()
+
+	session := cliui.New()
+	defer session.Cleanup()
+
+	ca, err := cosmosaccount.New(
+		cosmosaccount.WithKeyringBackend(getKeyringBackend(cmd)),
+	)
+	if err != nil {
+		return err
+	}
+
+	session.StartSpinner("Querying chains...")
+
+	statuses, err := relayer.New(ca).Status(cmd.Context(), args...)
+	if err != nil {
+		return err
+	}
+
+	session.StopSpinner()
+
+	if len(statuses) == 0 {
+		return session.Println("No paths found.")
+	}
+
+	var (
+		entries [][]string
+		errs    []string
+	)
+	for _, status := range statuses {
+		for _, end := range []relayer.PathEndStatus{status.Src, status.Dst} {
+			entries = append(entries, []string{
+				status.ID,
+				end.ChainID,
+				formatRelayerState(end.ClientID, end.ClientStatus),
+				formatRelayerState(end.ConnectionID, end.ConnectionState),
+				formatRelayerState(end.ChannelID, end.ChannelState),
+				strconv.FormatInt(end.PacketHeight, 10),
+				strconv.FormatInt(end.AckHeight, 10),
+				strconv.FormatUint(end.PendingPackets, 10),
+				end.Balances.String(),
+			})
+
+			if end.Error != nil {
+				errs = append(errs, fmt.Sprintf("%s (%s): %s", status.ID, end.ChainID, end.Error))
+			}
+		}
+	}
+
+	if err := session.PrintTable(relayerStatusHeader, entries...); err != nil {
+		return err
+	}
+
+	if len(errs) > 0 {
+		if err := printSection(session, "Errors"); err != nil {
+			return err
+		}
+		for _, e := range errs {
+			if err := session.Println(e); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func formatRelayerState(id, state string) string {
+	switch {
+	case id == "":
+		return "-"
+	case state == "":
+		return id
+	default:
+		return fmt.Sprintf("%s (%s)", id, state)
+	}
+}
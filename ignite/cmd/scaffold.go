@@ -8,6 +8,7 @@ import (
 	"github.com/spf13/cobra"
 	flag "github.com/spf13/pflag"
 
+	"github.com/ignite-hq/cli/ignite/pkg/cliui/cliquiz"
 	"github.com/ignite-hq/cli/ignite/pkg/cliui/clispinner"
 	"github.com/ignite-hq/cli/ignite/pkg/placeholder"
 	"github.com/ignite-hq/cli/ignite/pkg/xgit"
@@ -21,6 +22,8 @@ const (
 	flagNoSimulation = "no-simulation"
 	flagResponse     = "response"
 	flagDescription  = "desc"
+	flagOwned        = "owned"
+	flagAdmin        = "admin"
 )
 
 // NewScaffold returns a command that groups scaffolding related sub commands.
@@ -47,6 +50,7 @@ CRUD stands for "create, read, update, delete".`,
 	c.AddCommand(addGitChangesVerifier(NewScaffoldBandchain()))
 	c.AddCommand(addGitChangesVerifier(NewScaffoldVue()))
 	c.AddCommand(addGitChangesVerifier(NewScaffoldFlutter()))
+	c.AddCommand(addGitChangesVerifier(NewScaffoldDevcontainer()))
 	// c.AddCommand(NewScaffoldWasm())
 
 	return c
@@ -56,6 +60,7 @@ func scaffoldType(
 	cmd *cobra.Command,
 	args []string,
 	kind scaffolder.AddTypeKind,
+	options ...scaffolder.AddTypeOption,
 ) error {
 	var (
 		typeName          = args[0]
@@ -64,11 +69,11 @@ func scaffoldType(
 		withoutMessage    = flagGetNoMessage(cmd)
 		withoutSimulation = flagGetNoSimulation(cmd)
 		signer            = flagGetSigner(cmd)
+		owned             = flagGetOwned(cmd)
+		admin             = flagGetAdmin(cmd)
 		appPath           = flagGetPath(cmd)
 	)
 
-	var options []scaffolder.AddTypeOption
-
 	if len(fields) > 0 {
 		options = append(options, scaffolder.TypeWithFields(fields...))
 	}
@@ -84,6 +89,12 @@ func scaffoldType(
 		if withoutSimulation {
 			options = append(options, scaffolder.TypeWithoutSimulation())
 		}
+		if !owned {
+			options = append(options, scaffolder.TypeWithoutOwnership())
+		}
+		if admin != "" {
+			options = append(options, scaffolder.TypeWithAdmin(admin))
+		}
 	}
 
 	s := clispinner.New().SetText("Scaffolding...")
@@ -136,6 +147,10 @@ func addGitChangesVerifier(cmd *cobra.Command) *cobra.Command {
 		}
 
 		if !getYes(cmd) && !changesCommitted {
+			if cliquiz.IsNonInteractive() {
+				return fmt.Errorf("%w: your saved project changes have not been committed, set --%s to proceed", cliquiz.ErrNonInteractive, flagYes)
+			}
+
 			var confirmed bool
 			prompt := &survey.Confirm{
 				Message: "Your saved project changes have not been committed. To enable reverting to your current state, commit your saved changes. Do you want to proceed with scaffolding without committing your saved changes",
@@ -155,6 +170,8 @@ func flagSetScaffoldType() *flag.FlagSet {
 	f.Bool(flagNoMessage, false, "Disable CRUD interaction messages scaffolding")
 	f.Bool(flagNoSimulation, false, "Disable CRUD simulation scaffolding")
 	f.String(flagSigner, "", "Label for the message signer (default: creator)")
+	f.Bool(flagOwned, true, "Only allow the owner of a value to update and delete it")
+	f.String(flagAdmin, "", "Address allowed to update and delete any value besides its owner")
 	return f
 }
 
@@ -173,6 +190,16 @@ func flagGetNoMessage(cmd *cobra.Command) bool {
 	return noMessage
 }
 
+func flagGetOwned(cmd *cobra.Command) bool {
+	owned, _ := cmd.Flags().GetBool(flagOwned)
+	return owned
+}
+
+func flagGetAdmin(cmd *cobra.Command) string {
+	admin, _ := cmd.Flags().GetString(flagAdmin)
+	return admin
+}
+
 func flagGetSigner(cmd *cobra.Command) string {
 	signer, _ := cmd.Flags().GetString(flagSigner)
 	return signer
@@ -1,17 +1,25 @@
 package ignitecmd
 
 import (
+	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/spf13/cobra"
 
-	"github.com/ignite-hq/cli/ignite/pkg/cliui/clispinner"
+	"github.com/ignite-hq/cli/ignite/pkg/cache"
+	"github.com/ignite-hq/cli/ignite/pkg/chaintemplate"
+	"github.com/ignite-hq/cli/ignite/pkg/cliui"
+	"github.com/ignite-hq/cli/ignite/pkg/cliui/cliquiz"
 	"github.com/ignite-hq/cli/ignite/pkg/placeholder"
 	"github.com/ignite-hq/cli/ignite/services/scaffolder"
+	"github.com/ignite-hq/cli/ignite/version"
 )
 
 const (
 	flagNoDefaultModule = "no-module"
+	flagTemplate        = "template"
+	flagVar             = "var"
 )
 
 // NewScaffoldChain creates new command to scaffold a Comos-SDK based blockchain.
@@ -19,41 +27,72 @@ func NewScaffoldChain() *cobra.Command {
 	c := &cobra.Command{
 		Use:   "chain [github.com/org/repo]",
 		Short: "Fully-featured Cosmos SDK blockchain",
-		Long:  "Scaffold a new Cosmos SDK blockchain with a default directory structure",
-		Args:  cobra.ExactArgs(1),
-		RunE:  scaffoldChainHandler,
+		Long: `Scaffold a new Cosmos SDK blockchain with a default directory structure.
+
+Use --template to start from a chain template instead of a blank chain. Templates are
+working, domain-specific examples fetched from their git repositories. The template can be
+the URL of a git repository, a local directory or the name of a template: defi,
+nft-marketplace, rollup, minimal or a template registered in $HOME/.ignite/templates.yml:
+
+	ignite scaffold chain github.com/username/mars --template github.com/username/template
+	ignite scaffold chain github.com/username/mars --template defi
+
+The variables declared by the template are asked interactively unless they are set with --var.
+Commands run by templates fetched from git repositories must be confirmed unless --yes is set.
+The address prefix of the template is kept unless --address-prefix is set.`,
+		Args: cobra.ExactArgs(1),
+		RunE: scaffoldChainHandler,
 	}
 
 	flagSetClearCache(c)
+	c.Flags().AddFlagSet(flagSetYes())
 	c.Flags().StringP(flagPath, "p", ".", "path to scaffold the chain")
 	c.Flags().String(flagAddressPrefix, "cosmos", "Address prefix")
 	c.Flags().Bool(flagNoDefaultModule, false, "Prevent scaffolding a default module in the app")
+	c.Flags().String(flagTemplate, "", "Chain template to start from: git repository, local directory or registered name")
+	c.Flags().StringToString(flagVar, nil, "Value of a template variable (name=value)")
 
 	return c
 }
 
 func scaffoldChainHandler(cmd *cobra.Command, args []string) error {
-	s := clispinner.New().SetText("Scaffolding...")
-	defer s.Stop()
+	session := cliui.New()
+	defer session.Cleanup()
 
 	var (
 		name               = args[0]
 		addressPrefix, _   = cmd.Flags().GetString(flagAddressPrefix)
 		noDefaultModule, _ = cmd.Flags().GetBool(flagNoDefaultModule)
+		template, _        = cmd.Flags().GetString(flagTemplate)
 		appPath            = flagGetPath(cmd)
 	)
 
+	if template != "" && noDefaultModule {
+		return errors.New("--no-module cannot be used with --template")
+	}
+
 	cacheStorage, err := newCache(cmd)
 	if err != nil {
 		return err
 	}
 
-	appdir, err := scaffolder.Init(cacheStorage, placeholder.New(), appPath, name, addressPrefix, noDefaultModule)
-	if err != nil {
+	var appdir string
+	if template != "" {
+		// templates keep their own address prefix unless it is explicitly set.
+		if !cmd.Flags().Changed(flagAddressPrefix) {
+			addressPrefix = ""
+		}
+		appdir, err = scaffoldChainFromTemplate(cmd, session, cacheStorage, template, appPath, name, addressPrefix)
+	} else {
+		session.StartSpinner("Scaffolding...")
+		appdir, err = scaffolder.Init(cacheStorage, placeholder.New(), appPath, name, addressPrefix, noDefaultModule)
+	}
+	// the app dir is empty when the user refuses to scaffold the chain.
+	if err != nil || appdir == "" {
 		return err
 	}
 
-	s.Stop()
+	session.StopSpinner()
 
 	path, err := relativePath(appdir)
 	if err != nil {
@@ -69,7 +108,75 @@ func scaffoldChainHandler(cmd *cobra.Command, args []string) error {
 
 Documentation: https://docs.ignite.com
 `
-	fmt.Printf(message, path)
+	return session.Printf(message, path)
+}
+
+// scaffoldChainFromTemplate scaffolds a chain from the template at source and returns its path.
+// The path is empty when the user refuses to run the commands of the template.
+func scaffoldChainFromTemplate(
+	cmd *cobra.Command,
+	session cliui.Session,
+	cacheStorage cache.Storage,
+	source,
+	appPath,
+	name,
+	addressPrefix string,
+) (string, error) {
+	session.StartSpinner("Loading template...")
+
+	registry, err := chaintemplate.LoadRegistry()
+	if err != nil {
+		return "", err
+	}
+
+	tpl, err := chaintemplate.Load(cmd.Context(), registry, source)
+	if err != nil {
+		return "", err
+	}
+	if err := tpl.Manifest.CheckVersion(version.Version); err != nil {
+		return "", err
+	}
+
+	session.StopSpinner()
+
+	variables, _ := cmd.Flags().GetStringToString(flagVar)
+	if variables == nil {
+		variables = make(map[string]string)
+	}
+	for _, v := range tpl.Manifest.Variables {
+		answer, ok := variables[v.Name]
+		if !ok {
+			if err := session.Ask(cliquiz.NewQuestion(
+				v.Question(),
+				&answer,
+				cliquiz.DefaultAnswer(v.DefaultAnswer()),
+				cliquiz.Required(),
+			)); err != nil {
+				return "", err
+			}
+			variables[v.Name] = answer
+		}
+		if err := v.Validate(answer); err != nil {
+			return "", err
+		}
+	}
+
+	// commands from remote templates are only run with the user's consent.
+	if hooks := tpl.Manifest.Hooks.PostScaffold; len(hooks) > 0 && tpl.Remote {
+		question := fmt.Sprintf(
+			"The template runs the following commands once the chain is scaffolded:\n\n  %s\n\nDo you want to continue",
+			strings.Join(hooks, "\n  "),
+		)
+		confirmed, err := confirm(cmd, session, question)
+		if err != nil {
+			return "", err
+		}
+		if !confirmed {
+			return "", session.PrintSaidNo()
+		}
+	}
+
+	session.StartSpinner("Scaffolding...")
 
-	return nil
+	return scaffolder.InitFromTemplate(cmd.Context(), cacheStorage, tpl, appPath, name, addressPrefix, variables)
 }
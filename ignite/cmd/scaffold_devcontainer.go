@@ -0,0 +1,55 @@
+package ignitecmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite-hq/cli/ignite/pkg/cliui/clispinner"
+	"github.com/ignite-hq/cli/ignite/services/scaffolder"
+	"github.com/ignite-hq/cli/ignite/version"
+)
+
+// NewScaffoldDevcontainer generates the development container configs of a chain.
+func NewScaffoldDevcontainer() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "devcontainer",
+		Short: "Dev Container and Gitpod configs for your chain",
+		Long: `Generate .devcontainer/devcontainer.json and .gitpod.yml to develop the chain in
+VS Code Dev Containers, GitHub Codespaces or Gitpod.
+
+The containers install the Go version of go.mod, Node.js and the running version of
+Ignite CLI, and expose the ports of the hosts and of the faucet of config.yml.
+Existing configs are replaced, run the command again after changing the ports in config.yml.`,
+		Args: cobra.NoArgs,
+		RunE: scaffoldDevcontainerHandler,
+	}
+
+	flagSetPath(c)
+
+	return c
+}
+
+func scaffoldDevcontainerHandler(cmd *cobra.Command, args []string) error {
+	s := clispinner.New().SetText("Scaffolding...")
+	defer s.Stop()
+
+	var igniteVersion string
+	if version.IsRelease() {
+		igniteVersion = version.Version
+	}
+
+	paths, err := scaffolder.Devcontainer(flagGetPath(cmd), igniteVersion)
+	if err != nil {
+		return err
+	}
+
+	s.Stop()
+	fmt.Printf("\n🎉 Scaffold development container configs:\n\n")
+	for _, path := range paths {
+		fmt.Printf("  %s\n", path)
+	}
+	fmt.Println()
+
+	return nil
+}
@@ -8,6 +8,8 @@ import (
 
 const (
 	FlagIndexes = "index"
+
+	flagIndexBy = "index-by"
 )
 
 // NewScaffoldMap returns a new command to scaffold a map.
@@ -23,6 +25,7 @@ func NewScaffoldMap() *cobra.Command {
 	flagSetClearCache(c)
 	c.Flags().AddFlagSet(flagSetScaffoldType())
 	c.Flags().StringSlice(FlagIndexes, []string{"index"}, "fields that index the value")
+	c.Flags().StringSlice(flagIndexBy, []string{}, "fields of the value to look up entries by, with a secondary index")
 
 	return c
 }
@@ -33,5 +36,15 @@ func scaffoldMapHandler(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	return scaffoldType(cmd, args, scaffolder.MapType(indexes...))
+	indexBy, err := cmd.Flags().GetStringSlice(flagIndexBy)
+	if err != nil {
+		return err
+	}
+
+	var options []scaffolder.AddTypeOption
+	if len(indexBy) > 0 {
+		options = append(options, scaffolder.TypeWithSecondaryIndexes(indexBy...))
+	}
+
+	return scaffoldType(cmd, args, scaffolder.MapType(indexes...), options...)
 }
@@ -10,7 +10,10 @@ import (
 	"github.com/ignite-hq/cli/ignite/services/scaffolder"
 )
 
-const flagSigner = "signer"
+const (
+	flagSigner = "signer"
+	flagFee    = "fee"
+)
 
 // NewScaffoldMessage returns the command to scaffold messages
 func NewScaffoldMessage() *cobra.Command {
@@ -28,6 +31,7 @@ func NewScaffoldMessage() *cobra.Command {
 	c.Flags().Bool(flagNoSimulation, false, "Disable CRUD simulation scaffolding")
 	c.Flags().StringP(flagDescription, "d", "", "Description of the command")
 	c.Flags().String(flagSigner, "", "Label for the message signer (default: creator)")
+	c.Flags().String(flagFee, "", "Protocol fee charged to the signer, sent to the module account (e.g. 10token)")
 
 	return c
 }
@@ -37,6 +41,7 @@ func messageHandler(cmd *cobra.Command, args []string) error {
 		module, _         = cmd.Flags().GetString(flagModule)
 		resFields, _      = cmd.Flags().GetStringSlice(flagResponse)
 		desc, _           = cmd.Flags().GetString(flagDescription)
+		fee, _            = cmd.Flags().GetString(flagFee)
 		signer            = flagGetSigner(cmd)
 		appPath           = flagGetPath(cmd)
 		withoutSimulation = flagGetNoSimulation(cmd)
@@ -62,6 +67,11 @@ func messageHandler(cmd *cobra.Command, args []string) error {
 		options = append(options, scaffolder.WithSigner(signer))
 	}
 
+	// Charge a fee
+	if fee != "" {
+		options = append(options, scaffolder.WithFee(fee))
+	}
+
 	// Skip scaffold simulation
 	if withoutSimulation {
 		options = append(options, scaffolder.WithoutSimulation())
@@ -12,7 +12,8 @@ import (
 )
 
 const (
-	flagAck = "ack"
+	flagAck      = "ack"
+	flagAckError = "ack-error"
 )
 
 // NewScaffoldPacket creates a new packet in the module
@@ -28,6 +29,7 @@ func NewScaffoldPacket() *cobra.Command {
 	flagSetPath(c)
 	flagSetClearCache(c)
 	c.Flags().StringSlice(flagAck, []string{}, "Custom acknowledgment type (field1,field2,...)")
+	c.Flags().StringSlice(flagAckError, []string{}, "Custom acknowledgment error type (field1,field2,...)")
 	c.Flags().String(flagModule, "", "IBC Module to add the packet into")
 	c.Flags().String(flagSigner, "", "Label for the message signer (default: creator)")
 	c.Flags().Bool(flagNoMessage, false, "Disable send message scaffolding")
@@ -59,6 +61,11 @@ func createPacketHandler(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	ackErrorFields, err := cmd.Flags().GetStringSlice(flagAckError)
+	if err != nil {
+		return err
+	}
+
 	noMessage, err := cmd.Flags().GetBool(flagNoMessage)
 	if err != nil {
 		return err
@@ -75,6 +82,9 @@ func createPacketHandler(cmd *cobra.Command, args []string) error {
 	} else if signer != "" {
 		options = append(options, scaffolder.PacketWithSigner(signer))
 	}
+	if len(ackErrorFields) > 0 {
+		options = append(options, scaffolder.PacketWithAckErrorFields(ackErrorFields...))
+	}
 
 	sc, err := newApp(appPath)
 	if err != nil {
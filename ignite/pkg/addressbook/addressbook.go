@@ -0,0 +1,145 @@
+// Package addressbook maps labels to the addresses of accounts, per chain ID, so the labels
+// can be used instead of the addresses by the commands.
+package addressbook
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+
+	"github.com/ignite-hq/cli/ignite/pkg/confile"
+	"github.com/ignite-hq/cli/ignite/pkg/xfilepath"
+)
+
+// DefaultPath is the path of the address book of Ignite CLI.
+var DefaultPath = xfilepath.JoinFromHome(xfilepath.Path(".ignite"), xfilepath.Path("addressbook.yml"))
+
+// Entry is a labeled address of a chain.
+type Entry struct {
+	ChainID string
+	Label   string
+	Address string
+}
+
+// Book is an address book saved in a file.
+type Book struct {
+	path string
+
+	// chains are the addresses by label by chain ID.
+	chains map[string]map[string]string
+}
+
+// Load loads the address book of the file at path, the book is empty when the file does not
+// exist.
+func Load(path string) (*Book, error) {
+	b := &Book{
+		path:   path,
+		chains: make(map[string]map[string]string),
+	}
+	if err := confile.New(confile.DefaultYAMLEncodingCreator, path).Load(&b.chains); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// LoadDefault loads the address book at DefaultPath.
+func LoadDefault() (*Book, error) {
+	path, err := DefaultPath()
+	if err != nil {
+		return nil, err
+	}
+	return Load(path)
+}
+
+// Add labels the address of a chain, the address of an existing label is replaced.
+// The address must be a bech32 address with the prefix of the other addresses of the chain.
+func (b *Book) Add(chainID, label, address string) error {
+	if chainID == "" {
+		return errors.New("chain ID is required")
+	}
+	if label == "" || strings.ContainsAny(label, " \t\n${}") {
+		return fmt.Errorf("invalid label %q", label)
+	}
+	if _, _, err := bech32.DecodeAndConvert(label); err == nil {
+		return fmt.Errorf("label %q cannot be an address", label)
+	}
+
+	prefix, _, err := bech32.DecodeAndConvert(address)
+	if err != nil {
+		return fmt.Errorf("invalid address %q: %w", address, err)
+	}
+	for other, otherAddress := range b.chains[chainID] {
+		if other == label {
+			continue
+		}
+		if otherPrefix, _, _ := bech32.DecodeAndConvert(otherAddress); otherPrefix != prefix {
+			return fmt.Errorf("address %q of chain %s must have the prefix %q", address, chainID, otherPrefix)
+		}
+	}
+
+	if b.chains[chainID] == nil {
+		b.chains[chainID] = make(map[string]string)
+	}
+	b.chains[chainID][label] = address
+	return nil
+}
+
+// Address returns the address labeled label of a chain.
+func (b *Book) Address(chainID, label string) (address string, found bool) {
+	address, found = b.chains[chainID][label]
+	return
+}
+
+// Addresses returns the addresses of a chain by label.
+func (b *Book) Addresses(chainID string) map[string]string {
+	addresses := make(map[string]string, len(b.chains[chainID]))
+	for label, address := range b.chains[chainID] {
+		addresses[label] = address
+	}
+	return addresses
+}
+
+// Entries returns the entries of a chain, or of all the chains when chainID is empty,
+// sorted by chain ID and label.
+func (b *Book) Entries(chainID string) []Entry {
+	var entries []Entry
+	for id, addresses := range b.chains {
+		if chainID != "" && id != chainID {
+			continue
+		}
+		for label, address := range addresses {
+			entries = append(entries, Entry{ChainID: id, Label: label, Address: address})
+		}
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].ChainID != entries[j].ChainID {
+			return entries[i].ChainID < entries[j].ChainID
+		}
+		return entries[i].Label < entries[j].Label
+	})
+	return entries
+}
+
+// Save saves the address book to its file.
+func (b *Book) Save() error {
+	return confile.New(confile.DefaultYAMLEncodingCreator, b.path).Save(b.chains)
+}
+
+// Resolve returns the address labeled nameOrAddress of a chain from the default address book,
+// nameOrAddress is returned as is when it is already an address or when it is not a label.
+func Resolve(chainID, nameOrAddress string) (string, error) {
+	if _, _, err := bech32.DecodeAndConvert(nameOrAddress); err == nil {
+		return nameOrAddress, nil
+	}
+	b, err := LoadDefault()
+	if err != nil {
+		return "", err
+	}
+	if address, found := b.Address(chainID, nameOrAddress); found {
+		return address, nil
+	}
+	return nameOrAddress, nil
+}
@@ -0,0 +1,64 @@
+package addressbook_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite-hq/cli/ignite/pkg/addressbook"
+)
+
+func address(t *testing.T, prefix string, b byte) string {
+	address, err := bech32.ConvertAndEncode(prefix, []byte{b, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19})
+	require.NoError(t, err)
+	return address
+}
+
+func TestBook(t *testing.T) {
+	var (
+		path  = filepath.Join(t.TempDir(), "addressbook.yml")
+		alice = address(t, "mars", 1)
+		bob   = address(t, "mars", 2)
+		carol = address(t, "cosmos", 3)
+	)
+
+	book, err := addressbook.Load(path)
+	require.NoError(t, err)
+	require.Empty(t, book.Entries(""))
+
+	require.NoError(t, book.Add("mars-1", "bob", bob))
+	require.NoError(t, book.Add("mars-1", "alice", alice))
+	require.NoError(t, book.Add("cosmoshub-4", "carol", carol))
+
+	// the addresses of a chain share their prefix.
+	require.Error(t, book.Add("mars-1", "carol", carol))
+	require.Error(t, book.Add("mars-1", "dave", "mars1invalid"))
+	require.Error(t, book.Add("mars-1", "${dave}", alice))
+	require.Error(t, book.Add("mars-1", bob, alice))
+	require.Error(t, book.Add("", "alice", alice))
+
+	require.NoError(t, book.Save())
+
+	book, err = addressbook.Load(path)
+	require.NoError(t, err)
+
+	got, found := book.Address("mars-1", "alice")
+	require.True(t, found)
+	require.Equal(t, alice, got)
+	_, found = book.Address("cosmoshub-4", "alice")
+	require.False(t, found)
+
+	require.Equal(t, []addressbook.Entry{
+		{ChainID: "mars-1", Label: "alice", Address: alice},
+		{ChainID: "mars-1", Label: "bob", Address: bob},
+	}, book.Entries("mars-1"))
+	require.Len(t, book.Entries(""), 3)
+	require.Equal(t, map[string]string{"carol": carol}, book.Addresses("cosmoshub-4"))
+
+	// the address of a label is replaced.
+	require.NoError(t, book.Add("mars-1", "alice", bob))
+	got, _ = book.Address("mars-1", "alice")
+	require.Equal(t, bob, got)
+}
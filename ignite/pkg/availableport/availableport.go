@@ -1,34 +1,211 @@
 package availableport
 
 import (
+	"errors"
 	"fmt"
 	"math/rand"
 	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
 	"time"
 )
 
+const (
+	defaultMinPort = 44000
+	defaultMaxPort = 55000
+
+	// maxAttempts is the number of ports tried for each requested port before giving up.
+	maxAttempts = 1000
+)
+
+// ErrNoAvailablePort is returned when not enough ports are available in the range.
+var ErrNoAvailablePort = errors.New("no available port")
+
+type options struct {
+	min, max int
+	excluded map[int]bool
+	lockDir  string
+}
+
+// Option configures the port picking.
+type Option func(*options)
+
+// WithRange picks ports between min and max, both included.
+func WithRange(min, max int) Option {
+	return func(o *options) {
+		o.min = min
+		o.max = max
+	}
+}
+
+// WithExclusions never picks the given ports.
+func WithExclusions(ports ...int) Option {
+	return func(o *options) {
+		for _, port := range ports {
+			o.excluded[port] = true
+		}
+	}
+}
+
+// WithLockDir sets the directory of the lock files of the reserved ports.
+// Processes must use the same directory to not reserve the same ports.
+func WithLockDir(dir string) Option {
+	return func(o *options) {
+		o.lockDir = dir
+	}
+}
+
+func newOptions(opts []Option) (options, error) {
+	o := options{
+		min:      defaultMinPort,
+		max:      defaultMaxPort,
+		excluded: make(map[int]bool),
+		lockDir:  filepath.Join(os.TempDir(), "ignite-ports"),
+	}
+	for _, apply := range opts {
+		apply(&o)
+	}
+	if o.min <= 0 || o.max > 65535 || o.min > o.max {
+		return o, fmt.Errorf("invalid port range %d-%d", o.min, o.max)
+	}
+	return o, nil
+}
+
 // Find finds n number of unused ports.
 // it is not guaranteed that these ports will not be allocated to
-// another program in the time of calling Find().
-func Find(n int) (ports []int, err error) {
-	min := 44000
-	max := 55000
+// another program in the time of calling Find(), use Reserve to keep
+// other processes using this package from picking the same ports.
+func Find(n int, opts ...Option) (ports []int, err error) {
+	o, err := newOptions(opts)
+	if err != nil {
+		return nil, err
+	}
+	return find(n, o, func(int) (bool, error) { return true, nil })
+}
+
+// Lease holds reserved ports until it is released.
+type Lease struct {
+	ports []int
+	locks []*os.File
+}
+
+// Ports returns the reserved ports.
+func (l *Lease) Ports() []int {
+	return l.ports
+}
 
+// Release releases the ports so they can be reserved again.
+func (l *Lease) Release() error {
+	var errs []string
+	for _, lock := range l.locks {
+		if err := lock.Close(); err != nil {
+			errs = append(errs, err.Error())
+		}
+	}
+	l.locks = nil
+	if len(errs) > 0 {
+		return fmt.Errorf("cannot release ports: %s", strings.Join(errs, ", "))
+	}
+	return nil
+}
+
+// Reserve finds n number of unused ports and reserves them until the lease is released.
+// A port is reserved by locking its lock file so processes using the same lock directory
+// never pick the same ports, even before the ports are listened on. Locks are held by the
+// open lock files, so ports of processes that exited without releasing them are free again.
+func Reserve(n int, opts ...Option) (*Lease, error) {
+	o, err := newOptions(opts)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(o.lockDir, 0755); err != nil {
+		return nil, err
+	}
+
+	lease := &Lease{}
+	ports, err := find(n, o, func(port int) (bool, error) {
+		lock, err := lockPort(filepath.Join(o.lockDir, strconv.Itoa(port)+".lock"))
+		if lock != nil {
+			lease.locks = append(lease.locks, lock)
+		}
+		return lock != nil, err
+	})
+	if err != nil {
+		_ = lease.Release()
+		return nil, err
+	}
+	lease.ports = ports
+	return lease, nil
+}
+
+// find picks n number of unused ports accepted by take.
+func find(n int, o options, take func(port int) (bool, error)) ([]int, error) {
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+	picked := make(map[int]bool)
+
+	var ports []int
 	for i := 0; i < n; i++ {
-		for {
-			rand.Seed(time.Now().UnixNano())
-			port := rand.Intn(max-min+1) + min
-
-			conn, err := net.Dial("tcp", fmt.Sprintf(":%d", port))
-			// if there is an error, this might mean that no one is listening from this port
-			// which is what we need.
-			if err == nil {
-				conn.Close()
+		found := false
+		for attempt := 0; attempt < maxAttempts; attempt++ {
+			port := r.Intn(o.max-o.min+1) + o.min
+			if picked[port] || o.excluded[port] || !isAvailable(port) {
+				continue
+			}
+			ok, err := take(port)
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
 				continue
 			}
+			picked[port] = true
 			ports = append(ports, port)
+			found = true
 			break
 		}
+		if !found {
+			return nil, fmt.Errorf("%w between %d and %d", ErrNoAvailablePort, o.min, o.max)
+		}
 	}
 	return ports, nil
 }
+
+// isAvailable checks that no one listens on port.
+func isAvailable(port int) bool {
+	l, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
+	if err != nil {
+		return false
+	}
+	l.Close()
+	return true
+}
+
+// lockPort locks the lock file of a port, it returns a nil file when the port is locked
+// by another lease. The lock is held until the returned file is closed.
+func lockPort(path string) (*os.File, error) {
+	f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0644)
+	if err != nil {
+		return nil, err
+	}
+
+	// lock files are never removed, the lock is taken on the file itself so there is no
+	// window between checking and taking the lock.
+	ok, err := lockFile(f)
+	if err != nil || !ok {
+		f.Close()
+		return nil, err
+	}
+
+	// the pid of the lease is only written for debugging purposes.
+	if err := f.Truncate(0); err != nil {
+		f.Close()
+		return nil, err
+	}
+	if _, err := f.WriteAt([]byte(strconv.Itoa(os.Getpid())), 0); err != nil {
+		f.Close()
+		return nil, err
+	}
+	return f, nil
+}
@@ -0,0 +1,109 @@
+package availableport_test
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite-hq/cli/ignite/pkg/availableport"
+)
+
+func TestFind(t *testing.T) {
+	ports, err := availableport.Find(10, availableport.WithRange(50000, 50010), availableport.WithExclusions(50000))
+	require.NoError(t, err)
+	require.Len(t, ports, 10)
+
+	seen := make(map[int]bool)
+	for _, port := range ports {
+		require.False(t, seen[port], "port %d picked twice", port)
+		seen[port] = true
+		require.True(t, port > 50000 && port <= 50010)
+	}
+
+	_, err = availableport.Find(11, availableport.WithRange(50000, 50010), availableport.WithExclusions(50000))
+	require.ErrorIs(t, err, availableport.ErrNoAvailablePort)
+
+	_, err = availableport.Find(1, availableport.WithRange(10, 5))
+	require.Error(t, err)
+}
+
+func TestReserve(t *testing.T) {
+	var (
+		dir  = t.TempDir()
+		opts = []availableport.Option{availableport.WithRange(51000, 51003), availableport.WithLockDir(dir)}
+	)
+
+	lease, err := availableport.Reserve(2, opts...)
+	require.NoError(t, err)
+	require.Len(t, lease.Ports(), 2)
+
+	// the reserved ports are not picked again.
+	other, err := availableport.Reserve(2, opts...)
+	require.NoError(t, err)
+	require.NotContains(t, other.Ports(), lease.Ports()[0])
+	require.NotContains(t, other.Ports(), lease.Ports()[1])
+
+	_, err = availableport.Reserve(1, opts...)
+	require.ErrorIs(t, err, availableport.ErrNoAvailablePort)
+
+	// released ports can be reserved again.
+	require.NoError(t, lease.Release())
+	lease, err = availableport.Reserve(2, opts...)
+	require.NoError(t, err)
+	require.Len(t, lease.Ports(), 2)
+	require.NoError(t, lease.Release())
+	require.NoError(t, other.Release())
+}
+
+func TestReserveStaleLock(t *testing.T) {
+	dir := t.TempDir()
+
+	// lock of a process that is not running.
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "52000.lock"), []byte(strconv.Itoa(1<<22)), 0644))
+
+	lease, err := availableport.Reserve(1, availableport.WithRange(52000, 52000), availableport.WithLockDir(dir))
+	require.NoError(t, err)
+	require.Equal(t, []int{52000}, lease.Ports())
+
+	data, err := os.ReadFile(filepath.Join(dir, "52000.lock"))
+	require.NoError(t, err)
+	require.Equal(t, strconv.Itoa(os.Getpid()), string(data))
+	require.NoError(t, lease.Release())
+}
+
+func TestReserveConcurrently(t *testing.T) {
+	var (
+		dir    = t.TempDir()
+		opts   = []availableport.Option{availableport.WithRange(53000, 53000), availableport.WithLockDir(dir)}
+		wg     sync.WaitGroup
+		mu     sync.Mutex
+		leases []*availableport.Lease
+	)
+
+	// lock of a process that is not running.
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "53000.lock"), []byte(strconv.Itoa(1<<22)), 0644))
+
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			lease, err := availableport.Reserve(1, opts...)
+			if err != nil {
+				return
+			}
+			mu.Lock()
+			leases = append(leases, lease)
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+
+	// the port is only reserved once.
+	require.Len(t, leases, 1)
+	require.NoError(t, leases[0].Release())
+}
@@ -2,6 +2,7 @@ package chaincmd
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/ignite-hq/cli/ignite/pkg/cmdrunner/step"
 	"github.com/ignite-hq/cli/ignite/pkg/cosmosver"
@@ -74,6 +75,9 @@ type ChainCmd struct {
 	cliHome         string
 	nodeAddress     string
 	legacySend      bool
+	gasPrices       string
+	gasAdjustment   float64
+	maxGas          uint64
 
 	isAutoChainIDDetectionEnabled bool
 
@@ -183,6 +187,28 @@ func WithLegacySendCommand() Option {
 	}
 }
 
+// WithGasPrices sets the gas prices of the txs, e.g. 0.025stake.
+func WithGasPrices(gasPrices string) Option {
+	return func(c *ChainCmd) {
+		c.gasPrices = gasPrices
+	}
+}
+
+// WithGasAdjustment makes the txs estimate their gas and multiply it by adjustment, it is not
+// used when a gas limit is set.
+func WithGasAdjustment(adjustment float64) Option {
+	return func(c *ChainCmd) {
+		c.gasAdjustment = adjustment
+	}
+}
+
+// WithMaxGas sets the gas limit of the txs, their gas is not estimated.
+func WithMaxGas(gas uint64) Option {
+	return func(c *ChainCmd) {
+		c.maxGas = gas
+	}
+}
+
 // StartCommand returns the command to start the daemon of the chain
 func (c ChainCmd) StartCommand(options ...string) step.Option {
 	command := append([]string{
@@ -247,6 +273,19 @@ func (c ChainCmd) ImportKeyCommand(accountName, keyFile string) step.Option {
 	return c.cliCommand(command)
 }
 
+// DeleteKeyCommand returns the command to delete a key from the chain keyring
+func (c ChainCmd) DeleteKeyCommand(accountName string) step.Option {
+	command := []string{
+		commandKeys,
+		"delete",
+		accountName,
+		optionYes,
+	}
+	command = c.attachKeyringBackend(command)
+
+	return c.cliCommand(command)
+}
+
 // ShowKeyAddressCommand returns the command to print the address of a key in the chain keyring
 func (c ChainCmd) ShowKeyAddressCommand(accountName string) step.Option {
 	command := []string{
@@ -529,6 +568,7 @@ func (c ChainCmd) BankSendCommand(fromAddress, toAddress, amount string) step.Op
 		optionYes,
 	)
 
+	command = c.attachGas(command)
 	command = c.attachChainID(command)
 	command = c.attachKeyringBackend(command)
 	command = c.attachNode(command)
@@ -540,6 +580,21 @@ func (c ChainCmd) BankSendCommand(fromAddress, toAddress, amount string) step.Op
 	return c.cliCommand(command)
 }
 
+// BankBalancesCommand returns the command to query the balances of an address.
+func (c ChainCmd) BankBalancesCommand(address string) step.Option {
+	command := []string{
+		commandQuery,
+		"bank",
+		"balances",
+		address,
+		optionOutput,
+		constJSON,
+	}
+
+	command = c.attachNode(command)
+	return c.cliCommand(command)
+}
+
 // QueryTxCommand returns the command to query tx
 func (c ChainCmd) QueryTxCommand(txHash string) step.Option {
 	command := []string{
@@ -619,6 +674,21 @@ func (c ChainCmd) attachChainID(command []string) []string {
 	return command
 }
 
+// attachGas appends the gas flags of the txs to the provided command
+func (c ChainCmd) attachGas(command []string) []string {
+	if c.gasPrices != "" {
+		command = append(command, []string{optionGasPrices, c.gasPrices}...)
+	}
+	switch {
+	case c.maxGas > 0:
+		command = append(command, []string{optionGas, strconv.FormatUint(c.maxGas, 10)}...)
+	case c.gasAdjustment > 0:
+		adjustment := strconv.FormatFloat(c.gasAdjustment, 'f', -1, 64)
+		command = append(command, []string{optionGas, constGasAuto, optionGasAdjustment, adjustment}...)
+	}
+	return command
+}
+
 // attachKeyringBackend appends the keyring backend flag to the provided command
 func (c ChainCmd) attachKeyringBackend(command []string) []string {
 	if c.keyringBackend != "" {
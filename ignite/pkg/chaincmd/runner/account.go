@@ -165,6 +165,21 @@ func (r Runner) ShowAccount(ctx context.Context, name string) (Account, error) {
 	}, nil
 }
 
+// DeleteAccount deletes an account from the chain keyring.
+func (r Runner) DeleteAccount(ctx context.Context, name string) error {
+	opt := []step.Option{
+		r.chainCmd.DeleteKeyCommand(name),
+	}
+
+	if r.chainCmd.KeyringPassword() != "" {
+		input := &bytes.Buffer{}
+		fmt.Fprintln(input, r.chainCmd.KeyringPassword())
+		opt = append(opt, step.Write(input.Bytes()))
+	}
+
+	return r.run(ctx, runOptions{}, opt...)
+}
+
 // AddGenesisAccount adds account to genesis by its address.
 func (r Runner) AddGenesisAccount(ctx context.Context, address, coins string) error {
 	return r.run(ctx, runOptions{}, r.chainCmd.AddGenesisAccountCommand(address, coins))
@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/cenkalti/backoff"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/pkg/errors"
 
 	"github.com/ignite-hq/cli/ignite/pkg/chaincmd"
@@ -202,6 +203,28 @@ func (r Runner) BankSend(ctx context.Context, fromAccount, toAccount, amount str
 	return txResult.TxHash, nil
 }
 
+// BankBalances returns the balances of the address.
+func (r Runner) BankBalances(ctx context.Context, address string) (sdk.Coins, error) {
+	b := newBuffer()
+	if err := r.run(ctx, runOptions{stdout: b}, r.chainCmd.BankBalancesCommand(address)); err != nil {
+		return nil, err
+	}
+
+	data, err := b.JSONEnsuredBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	var out struct {
+		Balances sdk.Coins `json:"balances"`
+	}
+	if err := json.Unmarshal(data, &out); err != nil {
+		return nil, err
+	}
+
+	return out.Balances, nil
+}
+
 // WaitTx waits until a tx is successfully added to a block and can be queried
 func (r Runner) WaitTx(ctx context.Context, txHash string, retryDelay time.Duration, maxRetry int) error {
 	retry := 0
@@ -0,0 +1,70 @@
+package chaincmdrunner
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ignite-hq/cli/ignite/pkg/cmdrunner/step"
+)
+
+// SignAndBroadcastTx signs the unsigned tx encoded in JSON with the key of from, broadcasts it
+// and returns its result once it is included in a block. The tx is decoded by the binary of
+// the chain, so it can have the messages of any module of the chain.
+func (r Runner) SignAndBroadcastTx(ctx context.Context, from string, unsignedTx []byte) (sdk.TxResponse, error) {
+	dir, err := os.MkdirTemp("", "tx")
+	if err != nil {
+		return sdk.TxResponse{}, err
+	}
+	defer os.RemoveAll(dir)
+
+	unsignedPath := filepath.Join(dir, "unsigned.json")
+	if err := os.WriteFile(unsignedPath, unsignedTx, 0600); err != nil {
+		return sdk.TxResponse{}, err
+	}
+
+	signed := newBuffer()
+	opt := []step.Option{r.chainCmd.TxSignCommand(from, unsignedPath)}
+	if r.chainCmd.KeyringPassword() != "" {
+		input := &bytes.Buffer{}
+		fmt.Fprintln(input, r.chainCmd.KeyringPassword())
+		opt = append(opt, step.Write(input.Bytes()))
+	}
+	if err := r.run(ctx, runOptions{stdout: signed}, opt...); err != nil {
+		return sdk.TxResponse{}, err
+	}
+	signedPath := filepath.Join(dir, "signed.json")
+	if err := os.WriteFile(signedPath, signed.Bytes(), 0600); err != nil {
+		return sdk.TxResponse{}, err
+	}
+
+	b := newBuffer()
+	if err := r.run(ctx, runOptions{stdout: b}, r.chainCmd.TxBroadcastCommand(signedPath)); err != nil {
+		return sdk.TxResponse{}, err
+	}
+	data, err := b.JSONEnsuredBytes()
+	if err != nil {
+		return sdk.TxResponse{}, err
+	}
+
+	var res struct {
+		txResult
+		Logs sdk.ABCIMessageLogs `json:"logs"`
+	}
+	if err := json.Unmarshal(data, &res); err != nil {
+		return sdk.TxResponse{}, err
+	}
+	if res.Code > 0 {
+		return sdk.TxResponse{}, fmt.Errorf("tx %s failed (SDK code %d): %s", res.TxHash, res.Code, res.RawLog)
+	}
+	return sdk.TxResponse{
+		TxHash: res.TxHash,
+		RawLog: res.RawLog,
+		Logs:   res.Logs,
+	}, nil
+}
@@ -0,0 +1,109 @@
+package chaincmdrunner
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/ignite-hq/cli/ignite/pkg/cmdrunner/step"
+)
+
+const attributeCodeID = "code_id"
+
+// attributesContractAddress are the event attribute keys of the address of an instantiated
+// contract, the key is prefixed by an underscore since wasmd v0.18.
+var attributesContractAddress = []string{"_contract_address", "contract_address"}
+
+// WasmStore stores the wasm code of a contract signed by the from account and returns its code id.
+func (r Runner) WasmStore(ctx context.Context, from, wasmPath string) (codeID uint64, err error) {
+	res, err := r.wasmTx(ctx, r.chainCmd.WasmStoreCommand(from, wasmPath))
+	if err != nil {
+		return 0, err
+	}
+
+	value, ok := res.attribute(attributeCodeID)
+	if !ok {
+		return 0, fmt.Errorf("code id of %s not found in tx %s", wasmPath, res.TxHash)
+	}
+	return strconv.ParseUint(value, 10, 64)
+}
+
+// WasmInstantiate instantiates a contract from its code id signed by the from account and
+// returns the address of the contract.
+func (r Runner) WasmInstantiate(ctx context.Context, from string, codeID uint64, msg, label, admin, funds string) (address string, err error) {
+	res, err := r.wasmTx(ctx, r.chainCmd.WasmInstantiateCommand(from, codeID, msg, label, admin, funds))
+	if err != nil {
+		return "", err
+	}
+
+	for _, key := range attributesContractAddress {
+		if value, ok := res.attribute(key); ok {
+			return value, nil
+		}
+	}
+	return "", fmt.Errorf("address of contract %s not found in tx %s", label, res.TxHash)
+}
+
+// WasmMigrate migrates a contract to a new code id, from must be the admin of the contract.
+func (r Runner) WasmMigrate(ctx context.Context, from, contract string, codeID uint64, msg string) error {
+	_, err := r.wasmTx(ctx, r.chainCmd.WasmMigrateCommand(from, contract, codeID, msg))
+	return err
+}
+
+// wasmTxResult is the result of a tx broadcasted in block mode.
+type wasmTxResult struct {
+	txResult
+	Logs []struct {
+		Events []struct {
+			Type       string `json:"type"`
+			Attributes []struct {
+				Key   string `json:"key"`
+				Value string `json:"value"`
+			} `json:"attributes"`
+		} `json:"events"`
+	} `json:"logs"`
+}
+
+// attribute returns the value of the first event attribute with key.
+func (r wasmTxResult) attribute(key string) (string, bool) {
+	for _, log := range r.Logs {
+		for _, event := range log.Events {
+			for _, attr := range event.Attributes {
+				if attr.Key == key {
+					return attr.Value, true
+				}
+			}
+		}
+	}
+	return "", false
+}
+
+func (r Runner) wasmTx(ctx context.Context, command step.Option) (wasmTxResult, error) {
+	b := newBuffer()
+	opt := []step.Option{command}
+
+	if r.chainCmd.KeyringPassword() != "" {
+		input := &bytes.Buffer{}
+		fmt.Fprintln(input, r.chainCmd.KeyringPassword())
+		opt = append(opt, step.Write(input.Bytes()))
+	}
+
+	var res wasmTxResult
+	if err := r.run(ctx, runOptions{stdout: b}, opt...); err != nil {
+		return res, err
+	}
+
+	data, err := b.JSONEnsuredBytes()
+	if err != nil {
+		return res, err
+	}
+	if err := json.Unmarshal(data, &res); err != nil {
+		return res, err
+	}
+	if res.Code > 0 {
+		return res, fmt.Errorf("tx %s failed (SDK code %d): %s", res.TxHash, res.Code, res.RawLog)
+	}
+	return res, nil
+}
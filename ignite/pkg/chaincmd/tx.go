@@ -0,0 +1,37 @@
+package chaincmd
+
+import (
+	"github.com/ignite-hq/cli/ignite/pkg/cmdrunner/step"
+)
+
+// TxSignCommand returns the command to sign the tx in the JSON file txPath with the key of
+// from, the signed tx is printed in JSON.
+func (c ChainCmd) TxSignCommand(from, txPath string) step.Option {
+	command := []string{
+		commandTx,
+		"sign",
+		txPath,
+		optionFrom, from,
+		optionOutput, constJSON,
+	}
+	command = c.attachChainID(command)
+	command = c.attachKeyringBackend(command)
+	command = c.attachNode(command)
+
+	return c.cliCommand(command)
+}
+
+// TxBroadcastCommand returns the command to broadcast the signed tx in the JSON file txPath,
+// the command returns once the tx is included in a block.
+func (c ChainCmd) TxBroadcastCommand(txPath string) step.Option {
+	command := []string{
+		commandTx,
+		"broadcast",
+		txPath,
+		optionBroadcastMode, constBlock,
+		optionOutput, constJSON,
+	}
+	command = c.attachNode(command)
+
+	return c.cliCommand(command)
+}
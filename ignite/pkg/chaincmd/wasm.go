@@ -0,0 +1,69 @@
+package chaincmd
+
+import (
+	"strconv"
+
+	"github.com/ignite-hq/cli/ignite/pkg/cmdrunner/step"
+)
+
+const (
+	commandWasm = "wasm"
+
+	optionFrom          = "--from"
+	optionGas           = "--gas"
+	optionGasAdjustment = "--gas-adjustment"
+	optionGasPrices     = "--gas-prices"
+	optionLabel         = "--label"
+	optionAdmin         = "--admin"
+
+	constBlock         = "block"
+	constGasAuto       = "auto"
+	constGasAdjustment = "1.3"
+)
+
+// WasmStoreCommand returns the command to store the wasm code of a contract.
+func (c ChainCmd) WasmStoreCommand(from, wasmPath string) step.Option {
+	return c.wasmTxCommand(from, "store", wasmPath)
+}
+
+// WasmInstantiateCommand returns the command to instantiate a contract from its code id.
+// The admin is allowed to migrate the contract and funds are sent to the contract, both
+// are optional.
+func (c ChainCmd) WasmInstantiateCommand(from string, codeID uint64, msg, label, admin, funds string) step.Option {
+	args := []string{"instantiate", strconv.FormatUint(codeID, 10), msg, optionLabel, label}
+	if admin != "" {
+		args = append(args, optionAdmin, admin)
+	}
+	if funds != "" {
+		args = append(args, optionAmount, funds)
+	}
+	return c.wasmTxCommand(from, args...)
+}
+
+// WasmMigrateCommand returns the command to migrate a contract to a new code id.
+func (c ChainCmd) WasmMigrateCommand(from, contract string, codeID uint64, msg string) step.Option {
+	return c.wasmTxCommand(from, "migrate", contract, strconv.FormatUint(codeID, 10), msg)
+}
+
+// wasmTxCommand returns the command to broadcast a wasm tx signed by from, the command
+// returns once the tx is included in a block.
+func (c ChainCmd) wasmTxCommand(from string, args ...string) step.Option {
+	command := append([]string{commandTx, commandWasm}, args...)
+	command = append(command,
+		optionFrom, from,
+		optionBroadcastMode, constBlock,
+		optionOutput, constJSON,
+		optionYes,
+	)
+
+	// the gas of wasm txs is estimated unless a gas limit is set.
+	if c.gasAdjustment == 0 && c.maxGas == 0 {
+		command = append(command, optionGas, constGasAuto, optionGasAdjustment, constGasAdjustment)
+	}
+	command = c.attachGas(command)
+	command = c.attachChainID(command)
+	command = c.attachKeyringBackend(command)
+	command = c.attachNode(command)
+
+	return c.cliCommand(command)
+}
@@ -0,0 +1,307 @@
+// Package chaintemplate loads chain templates from git repositories and generates new chains
+// from them.
+package chaintemplate
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/goccy/go-yaml"
+	"github.com/otiai10/copy"
+
+	"github.com/ignite-hq/cli/ignite/chainconfig"
+	"github.com/ignite-hq/cli/ignite/pkg/gomodulepath"
+	"github.com/ignite-hq/cli/ignite/pkg/xfilepath"
+)
+
+var (
+	// cacheDirPath returns the path of the directory where the template repositories are cached.
+	cacheDirPath = xfilepath.Join(chainconfig.ConfigDirPath, xfilepath.Path("templates"))
+
+	// RegistryPath returns the path of the registry that names template repositories.
+	RegistryPath = xfilepath.Join(chainconfig.ConfigDirPath, xfilepath.Path("templates.yml"))
+)
+
+// DefaultRegistry holds the templates maintained with Ignite CLI, they are available without
+// a registry.
+var DefaultRegistry = Registry{
+	"defi":            "https://github.com/ignite-hq/template-defi",
+	"minimal":         "https://github.com/ignite-hq/template-minimal",
+	"nft-marketplace": "https://github.com/ignite-hq/template-nft-marketplace",
+	"rollup":          "https://github.com/ignite-hq/template-rollup",
+}
+
+// Registry holds the git repositories of chain templates by name, so templates can be
+// used by name instead of by URL, e.g. "defi: https://github.com/username/template-defi".
+type Registry map[string]string
+
+// LoadRegistry loads the template registry merged over the default one, the templates of the
+// registry replace the default templates with the same name. Only the default templates are
+// registered when the registry doesn't exist.
+func LoadRegistry() (Registry, error) {
+	r := make(Registry, len(DefaultRegistry))
+	for name, url := range DefaultRegistry {
+		r[name] = url
+	}
+
+	path, err := RegistryPath()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return r, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var registered Registry
+	if err := yaml.Unmarshal(data, &registered); err != nil {
+		return nil, fmt.Errorf("cannot parse %s: %w", path, err)
+	}
+	for name, url := range registered {
+		r[name] = url
+	}
+	return r, nil
+}
+
+// Names returns the names of the registered templates in alphabetical order.
+func (r Registry) Names() []string {
+	names := make([]string, 0, len(r))
+	for name := range r {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Template is a chain template loaded on the file system.
+type Template struct {
+	// Source is the name, the git URL or the local path the template is loaded from.
+	Source string
+
+	// Path is the local path of the template.
+	Path string
+
+	// Remote is true when the template is fetched from a git repository.
+	Remote bool
+
+	Manifest Manifest
+}
+
+// Load loads the template from source which is the name of a template in registry, the URL
+// of a git repository or a local directory. Repositories are cached and updated on each load,
+// the cached version is used when the repository cannot be reached.
+func Load(ctx context.Context, registry Registry, source string) (Template, error) {
+	t := Template{Source: source}
+
+	url, registered := registry[source]
+	switch {
+	case registered:
+		// the repository of the template is fetched below.
+	case isLocalDir(source):
+		path, err := filepath.Abs(source)
+		if err != nil {
+			return Template{}, err
+		}
+		t.Path = path
+	case strings.Contains(source, "/"):
+		url = source
+		if !strings.Contains(url, "://") && !strings.HasPrefix(url, "git@") {
+			url = "https://" + url
+		}
+	case len(registry) == 0:
+		return Template{}, fmt.Errorf("unknown template %q, no templates are registered", source)
+	default:
+		return Template{}, fmt.Errorf("unknown template %q, registered templates: %s", source, strings.Join(registry.Names(), ", "))
+	}
+
+	if t.Path == "" {
+		var err error
+		if t.Path, err = fetch(ctx, url); err != nil {
+			return Template{}, fmt.Errorf("cannot fetch the %q template: %w", source, err)
+		}
+		t.Remote = true
+	}
+
+	f, err := os.Open(filepath.Join(t.Path, ManifestFile))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return Template{}, fmt.Errorf("template %q has no %s manifest", source, ManifestFile)
+		}
+		return Template{}, err
+	}
+	defer f.Close()
+
+	if t.Manifest, err = ParseManifest(f); err != nil {
+		return Template{}, err
+	}
+
+	return t, nil
+}
+
+func isLocalDir(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && info.IsDir()
+}
+
+// fetch clones the repository at url in the cache or updates the cached clone,
+// and returns the path of the clone.
+func fetch(ctx context.Context, url string) (string, error) {
+	cacheDir, err := cacheDirPath()
+	if err != nil {
+		return "", err
+	}
+	path := filepath.Join(cacheDir, fmt.Sprintf("%x", sha256.Sum256([]byte(url))))
+
+	repo, err := git.PlainOpen(path)
+	if errors.Is(err, git.ErrRepositoryNotExists) {
+		if _, err := git.PlainCloneContext(ctx, path, false, &git.CloneOptions{
+			URL:   url,
+			Depth: 1,
+		}); err != nil {
+			os.RemoveAll(path)
+			return "", err
+		}
+		return path, nil
+	}
+	if err != nil {
+		return "", err
+	}
+
+	// the cached version is used when it is up to date or the repository cannot be reached.
+	if err := repo.FetchContext(ctx, &git.FetchOptions{Depth: 1, Force: true}); err != nil {
+		return path, nil
+	}
+
+	head, err := repo.Head()
+	if err != nil {
+		return "", err
+	}
+	remote, err := repo.Reference(plumbing.NewRemoteReferenceName(git.DefaultRemoteName, head.Name().Short()), true)
+	if err != nil {
+		return "", err
+	}
+
+	wt, err := repo.Worktree()
+	if err != nil {
+		return "", err
+	}
+	if err := wt.Reset(&git.ResetOptions{Commit: remote.Hash(), Mode: git.HardReset}); err != nil {
+		return "", err
+	}
+
+	return path, nil
+}
+
+// Generate generates a new chain at path from the template. The values from the manifest are
+// substituted with the ones of the new chain in the content of the files and in the names of
+// files and directories. Variables hold the answers by variable name, default answers are used
+// for the missing ones. Symbolic links and the manifest are not copied.
+func (t Template) Generate(path string, pathInfo gomodulepath.Path, addressPrefix string, variables map[string]string) error {
+	m := t.Manifest
+
+	substitutions := [][2]string{
+		{m.ModulePath, pathInfo.RawPath},
+		{m.AppName, pathInfo.Package},
+	}
+	if m.AddressPrefix != "" && addressPrefix != "" {
+		substitutions = append(substitutions, [2]string{m.AddressPrefix, addressPrefix})
+	}
+	for _, v := range m.Variables {
+		answer, ok := variables[v.Name]
+		if !ok {
+			answer = v.DefaultAnswer()
+		}
+		if err := v.Validate(answer); err != nil {
+			return err
+		}
+		substitutions = append(substitutions, [2]string{v.Value, answer})
+	}
+
+	// longer values are substituted first since they can contain shorter ones,
+	// e.g. the module path usually contains the app name.
+	sort.SliceStable(substitutions, func(i, j int) bool {
+		return len(substitutions[i][0]) > len(substitutions[j][0])
+	})
+	var pairs []string
+	for _, s := range substitutions {
+		pairs = append(pairs, s[0], s[1])
+	}
+	replacer := strings.NewReplacer(pairs...)
+
+	manifestPath := filepath.Join(t.Path, ManifestFile)
+	if err := copy.Copy(t.Path, path, copy.Options{
+		OnSymlink: func(string) copy.SymlinkAction {
+			return copy.Skip
+		},
+		Skip: func(src string) (bool, error) {
+			return filepath.Base(src) == ".git" || src == manifestPath, nil
+		},
+	}); err != nil {
+		return err
+	}
+
+	var renames []string
+	err := filepath.WalkDir(path, func(filePath string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if filePath == path {
+			return nil
+		}
+		if strings.Contains(d.Name(), m.AppName) {
+			renames = append(renames, filePath)
+		}
+		if d.IsDir() {
+			return nil
+		}
+
+		content, err := os.ReadFile(filePath)
+		if err != nil {
+			return err
+		}
+
+		// binary files are kept as is.
+		if bytes.IndexByte(content, 0) != -1 {
+			return nil
+		}
+
+		replaced := replacer.Replace(string(content))
+		if replaced == string(content) {
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		return os.WriteFile(filePath, []byte(replaced), info.Mode())
+	})
+	if err != nil {
+		return err
+	}
+
+	// rename the deepest paths first so the parent paths collected during the walk stay valid.
+	for i := len(renames) - 1; i >= 0; i-- {
+		oldPath := renames[i]
+		newName := strings.ReplaceAll(filepath.Base(oldPath), m.AppName, pathInfo.Package)
+		if err := os.Rename(oldPath, filepath.Join(filepath.Dir(oldPath), newName)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
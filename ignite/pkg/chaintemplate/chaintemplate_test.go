@@ -0,0 +1,141 @@
+package chaintemplate
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite-hq/cli/ignite/chainconfig"
+	"github.com/ignite-hq/cli/ignite/pkg/gomodulepath"
+	"github.com/ignite-hq/cli/ignite/pkg/xfilepath"
+)
+
+func TestLoadAndGenerate(t *testing.T) {
+	src := t.TempDir()
+	files := map[string]string{
+		ManifestFile: `
+module_path: github.com/ignite-hq/template-defi
+app_name: defi
+address_prefix: defiprefix
+variables:
+  - name: denom
+    value: udefi
+`,
+		"go.mod":                 "module github.com/ignite-hq/template-defi\n",
+		"cmd/defid/main.go":      "import \"github.com/ignite-hq/template-defi/app\"\n",
+		"app/prefix.go":          "AccountAddressPrefix = \"defiprefix\"\n",
+		"x/defi/genesis.go":      "BondDenom = \"udefi\"\n",
+		".git/HEAD":              "ref: refs/heads/main\n",
+		"vue/src/assets/logo.go": "defi",
+	}
+	for name, content := range files {
+		path := filepath.Join(src, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+	}
+
+	tpl, err := Load(context.Background(), nil, src)
+	require.NoError(t, err)
+	require.False(t, tpl.Remote)
+	require.Equal(t, "defi", tpl.Manifest.AppName)
+
+	pathInfo, err := gomodulepath.Parse("github.com/username/mars")
+	require.NoError(t, err)
+
+	dst := filepath.Join(t.TempDir(), "mars")
+	require.NoError(t, tpl.Generate(dst, pathInfo, "mars", map[string]string{"denom": "umars"}))
+
+	expected := map[string]string{
+		"go.mod":                 "module github.com/username/mars\n",
+		"cmd/marsd/main.go":      "import \"github.com/username/mars/app\"\n",
+		"app/prefix.go":          "AccountAddressPrefix = \"mars\"\n",
+		"x/mars/genesis.go":      "BondDenom = \"umars\"\n",
+		"vue/src/assets/logo.go": "mars",
+	}
+	for name, content := range expected {
+		data, err := os.ReadFile(filepath.Join(dst, name))
+		require.NoError(t, err)
+		require.Equal(t, content, string(data))
+	}
+
+	require.NoFileExists(t, filepath.Join(dst, ManifestFile))
+	require.NoDirExists(t, filepath.Join(dst, ".git"))
+	require.NoDirExists(t, filepath.Join(dst, "cmd/defid"))
+}
+
+func TestLoadUnknownTemplate(t *testing.T) {
+	_, err := Load(context.Background(), nil, "unknown")
+	require.ErrorContains(t, err, `unknown template "unknown", no templates are registered`)
+
+	_, err = Load(context.Background(), Registry{"defi": "", "rollup": ""}, "unknown")
+	require.ErrorContains(t, err, "registered templates: defi, rollup")
+}
+
+func TestLoadRegistered(t *testing.T) {
+	cacheDir := t.TempDir()
+	cacheDirPath = func() (string, error) { return cacheDir, nil }
+	t.Cleanup(func() { cacheDirPath = xfilepath.Join(chainconfig.ConfigDirPath, xfilepath.Path("templates")) })
+
+	repo := t.TempDir()
+	git := func(args ...string) {
+		cmd := exec.Command("git", append([]string{"-C", repo, "-c", "user.name=test", "-c", "user.email=test@test"}, args...)...)
+		out, err := cmd.CombinedOutput()
+		require.NoError(t, err, string(out))
+	}
+	writeManifest := func(appName string) {
+		manifest := fmt.Sprintf("module_path: github.com/username/template\napp_name: %s\n", appName)
+		require.NoError(t, os.WriteFile(filepath.Join(repo, ManifestFile), []byte(manifest), 0644))
+		git("add", "-A")
+		git("commit", "-m", appName)
+	}
+	git("init", "-b", "main")
+	writeManifest("defi")
+
+	registry := Registry{"defi": "file://" + repo}
+
+	tpl, err := Load(context.Background(), registry, "defi")
+	require.NoError(t, err)
+	require.True(t, tpl.Remote)
+	require.Equal(t, "defi", tpl.Manifest.AppName)
+	require.DirExists(t, filepath.Join(tpl.Path, ".git"))
+
+	// the cached clone is updated on each load.
+	writeManifest("rollup")
+
+	tpl, err = Load(context.Background(), registry, "defi")
+	require.NoError(t, err)
+	require.Equal(t, "rollup", tpl.Manifest.AppName)
+}
+
+func TestLoadRegistry(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "templates.yml")
+	RegistryPath = func() (string, error) { return path, nil }
+	t.Cleanup(func() { RegistryPath = xfilepath.Join(chainconfig.ConfigDirPath, xfilepath.Path("templates.yml")) })
+
+	registry, err := LoadRegistry()
+	require.NoError(t, err)
+	require.Equal(t, DefaultRegistry, registry)
+	require.Equal(t, []string{"defi", "minimal", "nft-marketplace", "rollup"}, registry.Names())
+
+	require.NoError(t, os.WriteFile(path, []byte(`
+rollup: https://github.com/username/template-rollup
+dao: https://github.com/username/template-dao
+`), 0644))
+
+	registry, err = LoadRegistry()
+	require.NoError(t, err)
+	require.Equal(t, "https://github.com/username/template-rollup", registry["rollup"])
+	require.Equal(t, DefaultRegistry["defi"], registry["defi"])
+	require.Equal(t, []string{"dao", "defi", "minimal", "nft-marketplace", "rollup"}, registry.Names())
+	require.Equal(t, "https://github.com/ignite-hq/template-rollup", DefaultRegistry["rollup"], "the default registry must not be modified")
+
+	require.NoError(t, os.WriteFile(path, []byte("- defi"), 0644))
+
+	_, err = LoadRegistry()
+	require.ErrorContains(t, err, "cannot parse")
+}
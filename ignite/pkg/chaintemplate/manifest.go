@@ -0,0 +1,179 @@
+package chaintemplate
+
+import (
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/blang/semver"
+	"github.com/goccy/go-yaml"
+)
+
+// ManifestFile is the name of the manifest file at the root of a chain template.
+const ManifestFile = "template.yml"
+
+var variableNameRe = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
+// Manifest describes a chain template. Its values are the ones used in the source code of
+// the template, they are substituted with the values of the new chain so templates stay
+// working chains that can be built and tested as is.
+type Manifest struct {
+	// ModulePath is the Go module path of the template.
+	ModulePath string `yaml:"module_path"`
+
+	// AppName is the name of the template app, also used to name its binary.
+	AppName string `yaml:"app_name"`
+
+	// AddressPrefix is the account address prefix of the template app.
+	AddressPrefix string `yaml:"address_prefix"`
+
+	// Ignite is the range of Ignite CLI versions supported by the template, e.g. ">=0.21.0 <0.23.0".
+	// All versions are supported when it is empty.
+	Ignite string `yaml:"ignite"`
+
+	// Variables are additional values of the template that users can customize.
+	Variables []Variable `yaml:"variables"`
+
+	// Hooks are the commands to run on the scaffolded chain.
+	Hooks Hooks `yaml:"hooks"`
+}
+
+// Variable is a value used in the source code of a template that is asked to users.
+type Variable struct {
+	// Name identifies the variable.
+	Name string `yaml:"name"`
+
+	// Prompt is the question asked to users, the name is used when it is empty.
+	Prompt string `yaml:"prompt"`
+
+	// Value is the value used in the source code of the template.
+	Value string `yaml:"value"`
+
+	// Default is the default answer, the value is used when it is empty.
+	Default string `yaml:"default"`
+
+	// Pattern is an optional regular expression that answers must match.
+	Pattern string `yaml:"pattern"`
+}
+
+// Hooks holds the commands run by the shell in the directory of the scaffolded chain.
+type Hooks struct {
+	// PostScaffold commands run once the chain is scaffolded, before its first commit.
+	PostScaffold []string `yaml:"post_scaffold"`
+}
+
+// DefaultAnswer returns the default answer of the variable.
+func (v Variable) DefaultAnswer() string {
+	if v.Default != "" {
+		return v.Default
+	}
+	return v.Value
+}
+
+// Question returns the question asked to users for the variable.
+func (v Variable) Question() string {
+	if v.Prompt != "" {
+		return v.Prompt
+	}
+	return v.Name
+}
+
+// Validate checks that answer is a valid value for the variable.
+func (v Variable) Validate(answer string) error {
+	if answer == "" {
+		return fmt.Errorf("%s: value is required", v.Name)
+	}
+	if v.Pattern == "" {
+		return nil
+	}
+	if !regexp.MustCompile(v.Pattern).MatchString(answer) {
+		return fmt.Errorf("%s: %q does not match %s", v.Name, answer, v.Pattern)
+	}
+	return nil
+}
+
+// ParseManifest parses and validates a template manifest.
+func ParseManifest(r io.Reader) (Manifest, error) {
+	var m Manifest
+	if err := yaml.NewDecoder(r).Decode(&m); err != nil {
+		return Manifest{}, &ManifestError{err.Error()}
+	}
+	return m, m.validate()
+}
+
+// CheckVersion checks that the template supports the given version of Ignite CLI.
+func (m Manifest) CheckVersion(version string) error {
+	if m.Ignite == "" {
+		return nil
+	}
+
+	v, err := semver.Parse(strings.TrimPrefix(version, "v"))
+	if err != nil {
+		// development builds support all templates.
+		return nil
+	}
+
+	versionRange, err := semver.ParseRange(m.Ignite)
+	if err != nil {
+		return err
+	}
+	if !versionRange(v) {
+		return fmt.Errorf("template supports Ignite CLI %s, current version is %s", m.Ignite, version)
+	}
+	return nil
+}
+
+func (m Manifest) validate() error {
+	if m.ModulePath == "" {
+		return &ManifestError{"module_path is required"}
+	}
+	if m.AppName == "" {
+		return &ManifestError{"app_name is required"}
+	}
+	if m.Ignite != "" {
+		if _, err := semver.ParseRange(m.Ignite); err != nil {
+			return &ManifestError{fmt.Sprintf("invalid ignite version range: %s", err)}
+		}
+	}
+
+	names := make(map[string]bool)
+	for _, v := range m.Variables {
+		if !variableNameRe.MatchString(v.Name) {
+			return &ManifestError{fmt.Sprintf("invalid variable name %q", v.Name)}
+		}
+		if names[v.Name] {
+			return &ManifestError{fmt.Sprintf("variable %q is declared more than once", v.Name)}
+		}
+		names[v.Name] = true
+
+		if v.Value == "" {
+			return &ManifestError{fmt.Sprintf("variable %q has no value", v.Name)}
+		}
+		if v.Pattern != "" {
+			if _, err := regexp.Compile(v.Pattern); err != nil {
+				return &ManifestError{fmt.Sprintf("variable %q has an invalid pattern: %s", v.Name, err)}
+			}
+		}
+		if err := v.Validate(v.DefaultAnswer()); err != nil {
+			return &ManifestError{fmt.Sprintf("invalid default: %s", err)}
+		}
+	}
+
+	for _, h := range m.Hooks.PostScaffold {
+		if strings.TrimSpace(h) == "" {
+			return &ManifestError{"hooks cannot be empty"}
+		}
+	}
+
+	return nil
+}
+
+// ManifestError is returned when a template manifest is not valid.
+type ManifestError struct {
+	Message string
+}
+
+func (e *ManifestError) Error() string {
+	return fmt.Sprintf("%s is not valid: %s", ManifestFile, e.Message)
+}
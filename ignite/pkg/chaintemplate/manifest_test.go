@@ -0,0 +1,131 @@
+package chaintemplate
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseManifest(t *testing.T) {
+	cases := []struct {
+		name     string
+		manifest string
+		err      string
+	}{
+		{
+			name: "valid",
+			manifest: `
+module_path: github.com/ignite-hq/template-defi
+app_name: defi
+ignite: ">=0.21.0"
+variables:
+  - name: denom
+    prompt: Staking denom
+    value: udefi
+    pattern: ^u[a-z]+$
+hooks:
+  post_scaffold:
+    - make install
+`,
+		},
+		{
+			name:     "missing module path",
+			manifest: "app_name: defi",
+			err:      "module_path is required",
+		},
+		{
+			name:     "missing app name",
+			manifest: "module_path: github.com/ignite-hq/template-defi",
+			err:      "app_name is required",
+		},
+		{
+			name: "invalid version range",
+			manifest: `
+module_path: github.com/ignite-hq/template-defi
+app_name: defi
+ignite: latest
+`,
+			err: "invalid ignite version range",
+		},
+		{
+			name: "invalid variable name",
+			manifest: `
+module_path: github.com/ignite-hq/template-defi
+app_name: defi
+variables:
+  - name: Denom
+    value: udefi
+`,
+			err: `invalid variable name "Denom"`,
+		},
+		{
+			name: "duplicated variable",
+			manifest: `
+module_path: github.com/ignite-hq/template-defi
+app_name: defi
+variables:
+  - name: denom
+    value: udefi
+  - name: denom
+    value: udefi
+`,
+			err: `variable "denom" is declared more than once`,
+		},
+		{
+			name: "variable without value",
+			manifest: `
+module_path: github.com/ignite-hq/template-defi
+app_name: defi
+variables:
+  - name: denom
+`,
+			err: `variable "denom" has no value`,
+		},
+		{
+			name: "default not matching the pattern",
+			manifest: `
+module_path: github.com/ignite-hq/template-defi
+app_name: defi
+variables:
+  - name: denom
+    value: udefi
+    default: defi
+    pattern: ^u[a-z]+$
+`,
+			err: "invalid default",
+		},
+		{
+			name: "empty hook",
+			manifest: `
+module_path: github.com/ignite-hq/template-defi
+app_name: defi
+hooks:
+  post_scaffold:
+    - " "
+`,
+			err: "hooks cannot be empty",
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseManifest(strings.NewReader(tt.manifest))
+			if tt.err != "" {
+				require.ErrorContains(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestManifestCheckVersion(t *testing.T) {
+	m := Manifest{Ignite: ">=0.21.0 <0.23.0"}
+
+	require.NoError(t, m.CheckVersion("v0.22.1"))
+	require.NoError(t, m.CheckVersion("development"))
+	require.Error(t, m.CheckVersion("v0.20.0"))
+	require.Error(t, m.CheckVersion("v0.23.0"))
+	require.NoError(t, Manifest{}.CheckVersion("v0.20.0"))
+}
@@ -5,17 +5,48 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"os"
 	"reflect"
+	"strconv"
 	"strings"
 
 	"github.com/AlecAivazis/survey/v2"
+	"github.com/AlecAivazis/survey/v2/core"
 	"github.com/AlecAivazis/survey/v2/terminal"
 	"github.com/spf13/pflag"
+
+	"github.com/ignite-hq/cli/ignite/pkg/i18n"
 )
 
 // ErrConfirmationFailed is returned when second answer is not the same with first one.
 var ErrConfirmationFailed = errors.New("failed to confirm, your answers were different")
 
+// ErrNonInteractive is returned when a question without default answer has to be answered
+// in non-interactive mode.
+var ErrNonInteractive = errors.New("cannot prompt in non-interactive mode")
+
+const (
+	// EnvNonInteractive is the environment variable that enables the non-interactive mode
+	// when it is true.
+	EnvNonInteractive = "IGNITE_NON_INTERACTIVE"
+
+	// EnvCI is the environment variable set to true by CI services, it enables the
+	// non-interactive mode unless IGNITE_NON_INTERACTIVE is false.
+	EnvCI = "CI"
+)
+
+// IsNonInteractive checks if the questions are answered without prompting. In
+// non-interactive mode, questions take their default answers and questions that are
+// required without default answer fail with ErrNonInteractive.
+func IsNonInteractive() bool {
+	for _, env := range []string{EnvNonInteractive, EnvCI} {
+		if v, err := strconv.ParseBool(os.Getenv(env)); err == nil {
+			return v
+		}
+	}
+	return false
+}
+
 // Question holds information on what to ask to user and where
 // the answer stored at.
 type Question struct {
@@ -71,14 +102,18 @@ func NewQuestion(question string, answer interface{}, options ...Option) Questio
 }
 
 func ask(q Question) error {
+	if IsNonInteractive() {
+		return answerDefault(q)
+	}
+
 	var prompt survey.Prompt
 
 	if !q.hidden {
 		input := &survey.Input{
-			Message: q.question,
+			Message: i18n.T(q.question),
 		}
 		if !q.required {
-			input.Message += " (optional)"
+			input.Message += " " + i18n.T("(optional)")
 		}
 		if q.defaultAnswer != nil {
 			input.Default = fmt.Sprintf("%v", q.defaultAnswer)
@@ -86,7 +121,7 @@ func ask(q Question) error {
 		prompt = input
 	} else {
 		prompt = &survey.Password{
-			Message: q.question,
+			Message: i18n.T(q.question),
 		}
 	}
 
@@ -117,6 +152,21 @@ func ask(q Question) error {
 	return nil
 }
 
+// answerDefault answers q with its default answer without prompting.
+func answerDefault(q Question) error {
+	if q.defaultAnswer == nil {
+		if q.required {
+			return fmt.Errorf("%w: %q has no default answer", ErrNonInteractive, q.question)
+		}
+		return nil
+	}
+	// the default answer is written like an answer typed in the prompt.
+	if err := core.WriteAnswer(q.answer, "", fmt.Sprintf("%v", q.defaultAnswer)); err != nil {
+		return fmt.Errorf("default answer of %q: %w", q.question, err)
+	}
+	return nil
+}
+
 // Ask asks questions and collect answers.
 func Ask(question ...Question) (err error) {
 	defer func() {
@@ -130,7 +180,7 @@ func Ask(question ...Question) (err error) {
 			return err
 		}
 
-		if q.shouldConfirm {
+		if q.shouldConfirm && !IsNonInteractive() {
 			var secondAnswer string
 
 			options := []Option{}
@@ -0,0 +1,54 @@
+package cliquiz
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsNonInteractive(t *testing.T) {
+	tests := []struct {
+		name           string
+		nonInteractive string
+		ci             string
+		want           bool
+	}{
+		{name: "not set"},
+		{name: "enabled", nonInteractive: "true", want: true},
+		{name: "CI", ci: "true", want: true},
+		{name: "disabled in CI", nonInteractive: "false", ci: "true"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(EnvNonInteractive, tt.nonInteractive)
+			t.Setenv(EnvCI, tt.ci)
+			require.Equal(t, tt.want, IsNonInteractive())
+		})
+	}
+}
+
+func TestAskNonInteractive(t *testing.T) {
+	t.Setenv(EnvNonInteractive, "true")
+
+	var (
+		port     string
+		gasLimit int64
+		faucet   string
+		pass     string
+	)
+	err := Ask(
+		NewQuestion("Port", &port, DefaultAnswer("transfer"), Required()),
+		NewQuestion("Gas limit", &gasLimit, DefaultAnswer(400000), Required()),
+		NewQuestion("Faucet", &faucet),
+		NewQuestion("Passphrase", &pass, HideAnswer(), GetConfirmation()),
+	)
+	require.NoError(t, err)
+	require.Equal(t, "transfer", port)
+	require.EqualValues(t, 400000, gasLimit)
+	require.Empty(t, faucet)
+	require.Empty(t, pass)
+
+	var mnemonic string
+	err = Ask(NewQuestion("Mnemonic", &mnemonic, Required()))
+	require.ErrorIs(t, err, ErrNonInteractive)
+}
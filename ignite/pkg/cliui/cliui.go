@@ -13,6 +13,7 @@ import (
 	"github.com/ignite-hq/cli/ignite/pkg/cliui/entrywriter"
 	"github.com/ignite-hq/cli/ignite/pkg/cliui/icons"
 	"github.com/ignite-hq/cli/ignite/pkg/events"
+	"github.com/ignite-hq/cli/ignite/pkg/i18n"
 )
 
 // Session controls command line interaction with users.
@@ -67,9 +68,9 @@ func (s Session) EventBus() events.Bus {
 	return s.ev
 }
 
-// StartSpinner starts spinner.
+// StartSpinner starts spinner, its text is translated.
 func (s Session) StartSpinner(text string) {
-	s.spinner.SetText(text).Start()
+	s.spinner.SetText(i18n.T(text)).Start()
 }
 
 // StopSpinner stops spinner.
@@ -89,19 +90,19 @@ func (s Session) PauseSpinner() (mightResume func()) {
 	return f
 }
 
-// Printf prints formatted arbitrary message.
+// Printf prints formatted arbitrary message, the format is translated.
 func (s Session) Printf(format string, a ...interface{}) error {
 	s.Wait()
 	defer s.PauseSpinner()()
-	_, err := fmt.Fprintf(s.out, format, a...)
+	_, err := fmt.Fprintf(s.out, i18n.T(format), a...)
 	return err
 }
 
-// Println prints arbitrary message with line break.
+// Println prints arbitrary message with line break, the strings of the message are translated.
 func (s Session) Println(messages ...interface{}) error {
 	s.Wait()
 	defer s.PauseSpinner()()
-	_, err := fmt.Fprintln(s.out, messages...)
+	_, err := fmt.Fprintln(s.out, translate(messages)...)
 	return err
 }
 
@@ -110,11 +111,11 @@ func (s Session) PrintSaidNo() error {
 	return s.Println("said no")
 }
 
-// Println prints arbitrary message
+// Println prints arbitrary message, the strings of the message are translated.
 func (s Session) Print(messages ...interface{}) error {
 	s.Wait()
 	defer s.PauseSpinner()()
-	_, err := fmt.Fprint(s.out, messages...)
+	_, err := fmt.Fprint(s.out, translate(messages)...)
 	return err
 }
 
@@ -125,12 +126,16 @@ func (s Session) Ask(questions ...cliquiz.Question) error {
 	return cliquiz.Ask(questions...)
 }
 
-// AskConfirm asks yes/no question in the terminal.
+// AskConfirm asks yes/no question in the terminal, it fails with cliquiz.ErrNonInteractive
+// in non-interactive mode.
 func (s Session) AskConfirm(message string) error {
+	if cliquiz.IsNonInteractive() {
+		return cliquiz.ErrNonInteractive
+	}
 	s.Wait()
 	defer s.PauseSpinner()()
 	prompt := promptui.Prompt{
-		Label:     message,
+		Label:     i18n.T(message),
 		IsConfirm: true,
 	}
 	_, err := prompt.Run()
@@ -180,3 +185,15 @@ func (s Session) printLoop() {
 	}
 	s.printLoopWg.Done()
 }
+
+// translate translates the strings of messages.
+func translate(messages []interface{}) []interface{} {
+	translated := make([]interface{}, len(messages))
+	for i, message := range messages {
+		if text, ok := message.(string); ok {
+			message = i18n.T(text)
+		}
+		translated[i] = message
+	}
+	return translated
+}
@@ -12,6 +12,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/bech32"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/go-bip39"
+
+	"github.com/ignite-hq/cli/ignite/pkg/randstr"
 )
 
 const (
@@ -27,6 +29,10 @@ var KeyringHome = os.ExpandEnv("$HOME/.ignite/accounts")
 
 var (
 	ErrAccountExists = errors.New("account already exists")
+
+	// ErrLedgerMigration is returned when a Ledger account is migrated, Ledger accounts are
+	// created again from the device.
+	ErrLedgerMigration = errors.New("ledger accounts cannot be migrated")
 )
 
 const (
@@ -283,6 +289,49 @@ func (r Registry) List() ([]Account, error) {
 	return accounts, nil
 }
 
+// Migrate copies the account name to the registry to, the account keeps its name and its
+// address. Private keys are encrypted again by the keyring of to, offline and multisig
+// accounts are copied as public keys.
+func (r Registry) Migrate(to Registry, name string) (Account, error) {
+	acc, err := r.GetByName(name)
+	if err != nil {
+		return Account{}, err
+	}
+	_, err = to.GetByName(name)
+	if err == nil {
+		return Account{}, ErrAccountExists
+	}
+	var accErr *AccountDoesNotExistError
+	if !errors.As(err, &accErr) {
+		return Account{}, err
+	}
+
+	switch acc.Info.GetType() {
+	case keyring.TypeLocal:
+		// the passphrase only protects the key while it is moved between the keyrings.
+		passphrase := randstr.Runes(32)
+		armor, err := r.Keyring.ExportPrivKeyArmor(name, passphrase)
+		if err != nil {
+			return Account{}, err
+		}
+		if err := to.Keyring.ImportPrivKey(name, armor, passphrase); err != nil {
+			return Account{}, err
+		}
+	case keyring.TypeOffline:
+		if _, err := to.Keyring.SavePubKey(name, acc.Info.GetPubKey(), acc.Info.GetAlgo()); err != nil {
+			return Account{}, err
+		}
+	case keyring.TypeMulti:
+		if _, err := to.Keyring.SaveMultisig(name, acc.Info.GetPubKey()); err != nil {
+			return Account{}, err
+		}
+	default:
+		return Account{}, ErrLedgerMigration
+	}
+
+	return to.GetByName(name)
+}
+
 // DeleteByName deletes an account by name.
 func (r Registry) DeleteByName(name string) error {
 	err := r.Keyring.Delete(name)
@@ -0,0 +1,47 @@
+package cosmosaccount_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite-hq/cli/ignite/pkg/cosmosaccount"
+)
+
+func TestMigrate(t *testing.T) {
+	src, err := cosmosaccount.NewInMemory()
+	require.NoError(t, err)
+	dst, err := cosmosaccount.NewInMemory()
+	require.NoError(t, err)
+
+	_, _, err = src.Create("alice")
+	require.NoError(t, err)
+
+	// bob is an offline account, only its public key is known.
+	other, err := cosmosaccount.NewInMemory()
+	require.NoError(t, err)
+	bob, _, err := other.Create("bob")
+	require.NoError(t, err)
+	_, err = src.Keyring.SavePubKey("bob", bob.Info.GetPubKey(), bob.Info.GetAlgo())
+	require.NoError(t, err)
+
+	for _, name := range []string{"alice", "bob"} {
+		srcAcc, err := src.GetByName(name)
+		require.NoError(t, err)
+		acc, err := src.Migrate(dst, name)
+		require.NoError(t, err)
+		require.Equal(t, srcAcc.Address("cosmos"), acc.Address("cosmos"))
+		require.Equal(t, srcAcc.Info.GetType(), acc.Info.GetType())
+	}
+
+	_, err = src.Migrate(dst, "alice")
+	require.ErrorIs(t, err, cosmosaccount.ErrAccountExists)
+
+	_, err = src.Migrate(dst, "carol")
+	var accErr *cosmosaccount.AccountDoesNotExistError
+	require.ErrorAs(t, err, &accErr)
+
+	// the private key is usable in the target keyring.
+	_, _, err = dst.Keyring.Sign("alice", []byte("message"))
+	require.NoError(t, err)
+}
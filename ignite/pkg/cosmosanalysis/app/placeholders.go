@@ -0,0 +1,360 @@
+package app
+
+import (
+	"bytes"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// placeholderPrefix is the prefix of the comments replaced by the scaffolded code.
+const placeholderPrefix = "// this line is used by starport scaffolding # "
+
+// Placeholders of app.go, in the order they appear in the apps scaffolded by Ignite CLI.
+const (
+	PlaceholderModuleImport      = "stargate/app/moduleImport"
+	PlaceholderModuleBasic       = "stargate/app/moduleBasic"
+	PlaceholderMaccPerms         = "stargate/app/maccPerms"
+	PlaceholderKeeperDeclaration = "stargate/app/keeperDeclaration"
+	PlaceholderStoreKey          = "stargate/app/storeKey"
+	PlaceholderScopedKeeper      = "stargate/app/scopedKeeper"
+	PlaceholderKeeperDefinition  = "stargate/app/keeperDefinition"
+	PlaceholderIBCRouter         = "ibc/app/router"
+	PlaceholderAppModule         = "stargate/app/appModule"
+	PlaceholderBeginBlockers     = "stargate/app/beginBlockers"
+	PlaceholderEndBlockers       = "stargate/app/endBlockers"
+	PlaceholderInitGenesis       = "stargate/app/initGenesis"
+	PlaceholderBeforeInitReturn  = "stargate/app/beforeInitReturn"
+	PlaceholderParamSubspace     = "stargate/app/paramSubspace"
+)
+
+// placeholders are the placeholders of app.go in order.
+var placeholders = []string{
+	PlaceholderModuleImport, PlaceholderModuleBasic, PlaceholderMaccPerms, PlaceholderKeeperDeclaration,
+	PlaceholderStoreKey, PlaceholderScopedKeeper, PlaceholderKeeperDefinition, PlaceholderIBCRouter,
+	PlaceholderAppModule, PlaceholderBeginBlockers, PlaceholderEndBlockers, PlaceholderInitGenesis,
+	PlaceholderBeforeInitReturn, PlaceholderParamSubspace,
+}
+
+// argsPlaceholders are the placeholders added at the end of the arguments of the calls to
+// the functions by their name.
+var argsPlaceholders = map[string]string{
+	"NewBasicManager":       PlaceholderModuleBasic,
+	"NewKVStoreKeys":        PlaceholderStoreKey,
+	"NewManager":            PlaceholderAppModule,
+	"NewSimulationManager":  PlaceholderAppModule,
+	"SetOrderBeginBlockers": PlaceholderBeginBlockers,
+	"SetOrderEndBlockers":   PlaceholderEndBlockers,
+	"SetOrderInitGenesis":   PlaceholderInitGenesis,
+}
+
+// modulePackage is the package of the module managers of the Cosmos SDK.
+const modulePackage = "github.com/cosmos/cosmos-sdk/types/module"
+
+// insertion is a text inserted at an offset of a source.
+type insertion struct {
+	offset      int
+	text        string
+	placeholder string
+}
+
+// AddPlaceholders adds the placeholders used to scaffold modules to the source of the app.go
+// of a chain that was not scaffolded by Ignite CLI. The placeholders are added where the
+// apps scaffolded by Ignite CLI have them, e.g. at the end of the arguments of
+// module.NewBasicManager.
+// It returns the new source, the added placeholders and the placeholders missing because
+// the source has no place for them. Placeholders already in the source are kept as is.
+func AddPlaceholders(src []byte) (out []byte, added, missing []string, err error) {
+	fileSet := token.NewFileSet()
+	f, err := parser.ParseFile(fileSet, "", src, parser.ParseComments)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	a := placeholderAdder{src: src, fileSet: fileSet, imports: importPaths(f)}
+	ast.Inspect(f, a.inspect)
+	for _, decl := range f.Decls {
+		a.inspectDecl(decl)
+	}
+
+	// placeholders that are already in the source are not added again.
+	var (
+		insertions []insertion
+		inserted   = make(map[string]bool)
+	)
+	for _, ins := range a.insertions {
+		if !bytes.Contains(src, []byte(placeholderPrefix+ins.placeholder+"\n")) {
+			insertions = append(insertions, ins)
+			inserted[ins.placeholder] = true
+		}
+	}
+	for _, placeholder := range placeholders {
+		switch {
+		case inserted[placeholder]:
+			added = append(added, placeholder)
+		case !bytes.Contains(src, []byte(placeholderPrefix+placeholder)):
+			missing = append(missing, placeholder)
+		}
+	}
+
+	// insert from the end so the offsets of the next insertions are kept.
+	sort.SliceStable(insertions, func(i, j int) bool {
+		return insertions[i].offset > insertions[j].offset
+	})
+	out = append([]byte(nil), src...)
+	for _, ins := range insertions {
+		out = append(out[:ins.offset], append([]byte(ins.text), out[ins.offset:]...)...)
+	}
+
+	out, err = format.Source(out)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return out, added, missing, nil
+}
+
+type placeholderAdder struct {
+	src        []byte
+	fileSet    *token.FileSet
+	imports    map[string]string
+	insertions []insertion
+}
+
+func (a *placeholderAdder) inspect(n ast.Node) bool {
+	switch n := n.(type) {
+	case *ast.CallExpr:
+		name := funcName(n)
+		if placeholder, ok := argsPlaceholders[name]; ok && (!isModuleManager(name) || a.imports[funcPackage(n)] == modulePackage) {
+			var last token.Pos
+			if len(n.Args) > 0 {
+				last = n.Args[len(n.Args)-1].End()
+			}
+			a.beforeClose(n.Lparen, last, n.Rparen, placeholder)
+		}
+
+	case *ast.ValueSpec:
+		if len(n.Names) == 1 && n.Names[0].Name == "maccPerms" && len(n.Values) == 1 {
+			if lit, ok := n.Values[0].(*ast.CompositeLit); ok {
+				var last token.Pos
+				if len(lit.Elts) > 0 {
+					last = lit.Elts[len(lit.Elts)-1].End()
+				}
+				a.beforeClose(lit.Lbrace, last, lit.Rbrace, PlaceholderMaccPerms)
+			}
+		}
+
+	case *ast.TypeSpec:
+		// the app is the struct embedding the base app.
+		if s, ok := n.Type.(*ast.StructType); ok && embedsBaseApp(s) {
+			a.beforeClose(s.Fields.Opening, token.NoPos, s.Fields.Closing, PlaceholderKeeperDeclaration)
+		}
+	}
+	return true
+}
+
+func (a *placeholderAdder) inspectDecl(decl ast.Decl) {
+	switch decl := decl.(type) {
+	case *ast.GenDecl:
+		if decl.Tok == token.IMPORT && decl.Rparen.IsValid() && !a.has(PlaceholderModuleImport) {
+			a.beforeClose(decl.Lparen, token.NoPos, decl.Rparen, PlaceholderModuleImport)
+		}
+
+	case *ast.FuncDecl:
+		if decl.Recv != nil || decl.Body == nil {
+			return
+		}
+		switch decl.Name.Name {
+		case "New":
+			a.inspectNew(decl.Body.List)
+		case "initParamsKeeper":
+			if ret := lastReturn(decl.Body.List); ret != nil {
+				a.beforeStmt(ret, PlaceholderParamSubspace)
+			}
+		}
+	}
+}
+
+// inspectNew finds the places of the placeholders of the constructor of the app.
+func (a *placeholderAdder) inspectNew(stmts []ast.Stmt) {
+	var (
+		lastScope         ast.Stmt
+		keeperDefinitions ast.Stmt
+	)
+	for _, stmt := range stmts {
+		call := stmtCall(stmt)
+		if call == nil {
+			continue
+		}
+		switch funcName(call) {
+		case "ScopeToModule":
+			lastScope = stmt
+		case "NewRouter":
+			// the modules are defined before the IBC router so they can be routed.
+			if keeperDefinitions == nil && strings.Contains(a.imports[funcPackage(call)], "05-port") {
+				keeperDefinitions = stmt
+			}
+		case "SetRouter":
+			if _, ok := stmt.(*ast.ExprStmt); ok {
+				a.beforeStmt(stmt, PlaceholderIBCRouter)
+			}
+		case "NewManager":
+			if keeperDefinitions == nil && a.imports[funcPackage(call)] == modulePackage {
+				keeperDefinitions = stmt
+			}
+		}
+	}
+
+	if lastScope != nil {
+		a.afterStmt(lastScope, PlaceholderScopedKeeper)
+	}
+	if keeperDefinitions != nil {
+		a.beforeStmt(keeperDefinitions, PlaceholderKeeperDefinition)
+	}
+	if ret := lastReturn(stmts); ret != nil {
+		a.beforeStmt(ret, PlaceholderBeforeInitReturn)
+	}
+}
+
+func (a *placeholderAdder) has(placeholder string) bool {
+	for _, ins := range a.insertions {
+		if ins.placeholder == placeholder {
+			return true
+		}
+	}
+	return false
+}
+
+func (a *placeholderAdder) add(offset int, text, placeholder string) {
+	a.insertions = append(a.insertions, insertion{offset, text, placeholder})
+}
+
+// beforeClose adds the placeholder on its own line before the closing parenthesis or brace,
+// last is the end of the last element before the closing, if any. When the elements are on
+// the line of the closing, they are moved to their own line after the opening.
+func (a *placeholderAdder) beforeClose(opening, last, closing token.Pos, placeholder string) {
+	closingOffset := a.offset(closing)
+	indent := a.indent(closingOffset)
+	if last.IsValid() && a.line(last) == a.line(closing) {
+		if a.line(opening) == a.line(closing) {
+			a.add(a.offset(opening)+1, "\n"+indent+"\t", placeholder)
+		}
+		a.add(a.offset(last), ",\n"+indent+"\t"+placeholderPrefix+placeholder+"\n"+indent, placeholder)
+		return
+	}
+	a.add(a.lineStart(closingOffset), indent+"\t"+placeholderPrefix+placeholder+"\n", placeholder)
+}
+
+func (a *placeholderAdder) beforeStmt(stmt ast.Stmt, placeholder string) {
+	offset := a.offset(stmt.Pos())
+	a.add(a.lineStart(offset), a.indent(offset)+placeholderPrefix+placeholder+"\n", placeholder)
+}
+
+func (a *placeholderAdder) afterStmt(stmt ast.Stmt, placeholder string) {
+	offset := a.offset(stmt.End())
+	a.add(offset, "\n"+a.indent(a.offset(stmt.Pos()))+placeholderPrefix+placeholder, placeholder)
+}
+
+func (a *placeholderAdder) offset(pos token.Pos) int {
+	return a.fileSet.Position(pos).Offset
+}
+
+func (a *placeholderAdder) line(pos token.Pos) int {
+	return a.fileSet.Position(pos).Line
+}
+
+// lineStart returns the offset of the start of the line of offset.
+func (a *placeholderAdder) lineStart(offset int) int {
+	return bytes.LastIndexByte(a.src[:offset], '\n') + 1
+}
+
+// indent returns the indentation of the line of offset.
+func (a *placeholderAdder) indent(offset int) string {
+	line := a.src[a.lineStart(offset):]
+	return string(line[:len(line)-len(bytes.TrimLeft(line, " \t"))])
+}
+
+// importPaths returns the paths of the imports of f by their name.
+func importPaths(f *ast.File) map[string]string {
+	imports := make(map[string]string)
+	for _, spec := range f.Imports {
+		path, _ := strconv.Unquote(spec.Path.Value)
+		name := path[strings.LastIndex(path, "/")+1:]
+		if spec.Name != nil {
+			name = spec.Name.Name
+		}
+		imports[name] = path
+	}
+	return imports
+}
+
+// isModuleManager checks if the function name is the name of a constructor of a manager of
+// the modules.
+func isModuleManager(name string) bool {
+	return name == "NewBasicManager" || name == "NewManager" || name == "NewSimulationManager"
+}
+
+// funcName returns the name of the function called, without its package or receiver.
+func funcName(call *ast.CallExpr) string {
+	switch fun := call.Fun.(type) {
+	case *ast.SelectorExpr:
+		return fun.Sel.Name
+	case *ast.Ident:
+		return fun.Name
+	}
+	return ""
+}
+
+// funcPackage returns the package of the function called, if any.
+func funcPackage(call *ast.CallExpr) string {
+	if fun, ok := call.Fun.(*ast.SelectorExpr); ok {
+		if x, ok := fun.X.(*ast.Ident); ok {
+			return x.Name
+		}
+	}
+	return ""
+}
+
+// stmtCall returns the call of an expression or an assignment statement.
+func stmtCall(stmt ast.Stmt) *ast.CallExpr {
+	var expr ast.Expr
+	switch stmt := stmt.(type) {
+	case *ast.ExprStmt:
+		expr = stmt.X
+	case *ast.AssignStmt:
+		if len(stmt.Rhs) == 1 {
+			expr = stmt.Rhs[0]
+		}
+	}
+	call, _ := expr.(*ast.CallExpr)
+	return call
+}
+
+func lastReturn(stmts []ast.Stmt) ast.Stmt {
+	for i := len(stmts) - 1; i >= 0; i-- {
+		if ret, ok := stmts[i].(*ast.ReturnStmt); ok {
+			return ret
+		}
+	}
+	return nil
+}
+
+// embedsBaseApp checks if the struct embeds *baseapp.BaseApp.
+func embedsBaseApp(s *ast.StructType) bool {
+	for _, field := range s.Fields.List {
+		if len(field.Names) != 0 {
+			continue
+		}
+		star, ok := field.Type.(*ast.StarExpr)
+		if !ok {
+			continue
+		}
+		if sel, ok := star.X.(*ast.SelectorExpr); ok && sel.Sel.Name == "BaseApp" {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,163 @@
+package app_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite-hq/cli/ignite/pkg/cosmosanalysis/app"
+)
+
+const adoptedApp = `package app
+
+import (
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	ibcporttypes "github.com/cosmos/ibc-go/v3/modules/core/05-port/types"
+)
+
+var (
+	ModuleBasics = module.NewBasicManager(
+		bank.AppModuleBasic{},
+	)
+
+	maccPerms = map[string][]string{banktypes.ModuleName: nil}
+)
+
+type App struct {
+	*baseapp.BaseApp
+
+	BankKeeper bankkeeper.Keeper
+}
+
+func New() *App {
+	app := &App{}
+	keys := sdk.NewKVStoreKeys(banktypes.StoreKey, ibchost.StoreKey)
+
+	scopedIBCKeeper := app.CapabilityKeeper.ScopeToModule(ibchost.ModuleName)
+
+	ibcRouter := ibcporttypes.NewRouter()
+	app.IBCKeeper.SetRouter(ibcRouter)
+
+	app.mm = module.NewManager(
+		bank.NewAppModule(appCodec, app.BankKeeper, app.AccountKeeper),
+	)
+	app.mm.SetOrderBeginBlockers(banktypes.ModuleName)
+	app.mm.SetOrderEndBlockers(banktypes.ModuleName)
+	app.mm.SetOrderInitGenesis(banktypes.ModuleName)
+
+	return app
+}
+
+func initParamsKeeper() paramskeeper.Keeper {
+	paramsKeeper := paramskeeper.NewKeeper()
+	paramsKeeper.Subspace(banktypes.ModuleName)
+	return paramsKeeper
+}
+`
+
+const adoptedAppWithPlaceholders = `package app
+
+import (
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	ibcporttypes "github.com/cosmos/ibc-go/v3/modules/core/05-port/types"
+	// this line is used by starport scaffolding # stargate/app/moduleImport
+)
+
+var (
+	ModuleBasics = module.NewBasicManager(
+		bank.AppModuleBasic{},
+		// this line is used by starport scaffolding # stargate/app/moduleBasic
+	)
+
+	maccPerms = map[string][]string{
+		banktypes.ModuleName: nil,
+		// this line is used by starport scaffolding # stargate/app/maccPerms
+	}
+)
+
+type App struct {
+	*baseapp.BaseApp
+
+	BankKeeper bankkeeper.Keeper
+	// this line is used by starport scaffolding # stargate/app/keeperDeclaration
+}
+
+func New() *App {
+	app := &App{}
+	keys := sdk.NewKVStoreKeys(
+		banktypes.StoreKey, ibchost.StoreKey,
+		// this line is used by starport scaffolding # stargate/app/storeKey
+	)
+
+	scopedIBCKeeper := app.CapabilityKeeper.ScopeToModule(ibchost.ModuleName)
+	// this line is used by starport scaffolding # stargate/app/scopedKeeper
+
+	// this line is used by starport scaffolding # stargate/app/keeperDefinition
+	ibcRouter := ibcporttypes.NewRouter()
+	// this line is used by starport scaffolding # ibc/app/router
+	app.IBCKeeper.SetRouter(ibcRouter)
+
+	app.mm = module.NewManager(
+		bank.NewAppModule(appCodec, app.BankKeeper, app.AccountKeeper),
+		// this line is used by starport scaffolding # stargate/app/appModule
+	)
+	app.mm.SetOrderBeginBlockers(
+		banktypes.ModuleName,
+		// this line is used by starport scaffolding # stargate/app/beginBlockers
+	)
+	app.mm.SetOrderEndBlockers(
+		banktypes.ModuleName,
+		// this line is used by starport scaffolding # stargate/app/endBlockers
+	)
+	app.mm.SetOrderInitGenesis(
+		banktypes.ModuleName,
+		// this line is used by starport scaffolding # stargate/app/initGenesis
+	)
+
+	// this line is used by starport scaffolding # stargate/app/beforeInitReturn
+	return app
+}
+
+func initParamsKeeper() paramskeeper.Keeper {
+	paramsKeeper := paramskeeper.NewKeeper()
+	paramsKeeper.Subspace(banktypes.ModuleName)
+	// this line is used by starport scaffolding # stargate/app/paramSubspace
+	return paramsKeeper
+}
+`
+
+func TestAddPlaceholders(t *testing.T) {
+	out, added, missing, err := app.AddPlaceholders([]byte(adoptedApp))
+	require.NoError(t, err)
+	require.Equal(t, adoptedAppWithPlaceholders, string(out))
+	require.Len(t, added, 14)
+	require.Empty(t, missing)
+
+	// placeholders are not added twice.
+	out, added, missing, err = app.AddPlaceholders(out)
+	require.NoError(t, err)
+	require.Equal(t, adoptedAppWithPlaceholders, string(out))
+	require.Empty(t, added)
+	require.Empty(t, missing)
+}
+
+func TestAddPlaceholdersMissing(t *testing.T) {
+	_, added, missing, err := app.AddPlaceholders([]byte(`package app
+
+import (
+	"github.com/cosmos/cosmos-sdk/types/module"
+)
+
+var ModuleBasics = module.NewBasicManager()
+`))
+	require.NoError(t, err)
+	require.Equal(t, []string{app.PlaceholderModuleImport, app.PlaceholderModuleBasic}, added)
+	require.Contains(t, missing, app.PlaceholderKeeperDefinition)
+	require.NotContains(t, missing, app.PlaceholderModuleBasic)
+}
@@ -9,6 +9,7 @@ import (
 	"go/token"
 	"os"
 	"path/filepath"
+	"sort"
 
 	"github.com/pkg/errors"
 	"golang.org/x/mod/modfile"
@@ -126,6 +127,9 @@ func findImplementationInFiles(files []*ast.File, interfaceList []string) (found
 		}
 	}
 
+	// keep a stable order, generated code depends on it.
+	sort.Strings(found)
+
 	return found
 }
 
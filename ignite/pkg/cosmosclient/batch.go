@@ -0,0 +1,88 @@
+package cosmosclient
+
+import (
+	"context"
+
+	rpchttp "github.com/tendermint/tendermint/rpc/client/http"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+)
+
+// BatchRequest is an RPC request of a batch.
+type BatchRequest func(ctx context.Context, batch *rpchttp.BatchHTTP) error
+
+// BlockRequest requests the block at height, its result is a *ctypes.ResultBlock.
+func BlockRequest(height int64) BatchRequest {
+	return func(ctx context.Context, batch *rpchttp.BatchHTTP) error {
+		_, err := batch.Block(ctx, &height)
+		return err
+	}
+}
+
+// BlockResultsRequest requests the results of the block at height, its result is a
+// *ctypes.ResultBlockResults.
+func BlockResultsRequest(height int64) BatchRequest {
+	return func(ctx context.Context, batch *rpchttp.BatchHTTP) error {
+		_, err := batch.BlockResults(ctx, &height)
+		return err
+	}
+}
+
+// TxRequest requests the transaction with hash, its result is a *ctypes.ResultTx.
+func TxRequest(hash []byte) BatchRequest {
+	return func(ctx context.Context, batch *rpchttp.BatchHTTP) error {
+		_, err := batch.Tx(ctx, hash, false)
+		return err
+	}
+}
+
+// StatusRequest requests the status of the node, its result is a *ctypes.ResultStatus.
+func StatusRequest() BatchRequest {
+	return func(ctx context.Context, batch *rpchttp.BatchHTTP) error {
+		_, err := batch.Status(ctx)
+		return err
+	}
+}
+
+// Batch sends the requests to the node in a single HTTP request and returns their results
+// in the order of the requests.
+func (c Client) Batch(ctx context.Context, reqs ...BatchRequest) ([]interface{}, error) {
+	if len(reqs) == 0 {
+		return nil, nil
+	}
+
+	batch := c.RPC.NewBatch()
+	for _, req := range reqs {
+		if err := req(ctx, batch); err != nil {
+			return nil, err
+		}
+	}
+	return batch.Send(ctx)
+}
+
+// BlockWithResults is a block with the results of its transactions and of its begin and
+// end block.
+type BlockWithResults struct {
+	Block   *ctypes.ResultBlock
+	Results *ctypes.ResultBlockResults
+}
+
+// BlocksWithResults returns the blocks at heights with their results, the blocks are
+// requested in a single HTTP request.
+func (c Client) BlocksWithResults(ctx context.Context, heights ...int64) ([]BlockWithResults, error) {
+	reqs := make([]BatchRequest, 0, len(heights)*2)
+	for _, height := range heights {
+		reqs = append(reqs, BlockRequest(height), BlockResultsRequest(height))
+	}
+
+	results, err := c.Batch(ctx, reqs...)
+	if err != nil {
+		return nil, err
+	}
+
+	blocks := make([]BlockWithResults, len(heights))
+	for i := range blocks {
+		blocks[i].Block = results[i*2].(*ctypes.ResultBlock)
+		blocks[i].Results = results[i*2+1].(*ctypes.ResultBlockResults)
+	}
+	return blocks, nil
+}
@@ -0,0 +1,55 @@
+package cosmosclient
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	rpchttp "github.com/tendermint/tendermint/rpc/client/http"
+)
+
+func TestBlocksWithResults(t *testing.T) {
+	var requests int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+
+		var reqs []struct {
+			ID     json.RawMessage   `json:"id"`
+			Method string            `json:"method"`
+			Params map[string]string `json:"params"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&reqs))
+
+		var res []string
+		for _, req := range reqs {
+			var result string
+			switch req.Method {
+			case "block":
+				result = fmt.Sprintf(`{"block":{"header":{"height":"%s"}}}`, req.Params["height"])
+			case "block_results":
+				result = fmt.Sprintf(`{"height":"%s"}`, req.Params["height"])
+			}
+			res = append(res, fmt.Sprintf(`{"jsonrpc":"2.0","id":%s,"result":%s}`, req.ID, result))
+		}
+		fmt.Fprintf(w, "[%s]", strings.Join(res, ","))
+	}))
+	defer srv.Close()
+
+	rpc, err := rpchttp.New(srv.URL, "/websocket")
+	require.NoError(t, err)
+	c := Client{RPC: rpc}
+
+	blocks, err := c.BlocksWithResults(context.Background(), 5, 6)
+	require.NoError(t, err)
+	require.Equal(t, 1, requests)
+	require.Len(t, blocks, 2)
+	require.EqualValues(t, 5, blocks[0].Block.Block.Height)
+	require.EqualValues(t, 5, blocks[0].Results.Height)
+	require.EqualValues(t, 6, blocks[1].Block.Block.Height)
+	require.EqualValues(t, 6, blocks[1].Results.Height)
+}
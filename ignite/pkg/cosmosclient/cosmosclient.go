@@ -35,6 +35,7 @@ import (
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	rpchttp "github.com/tendermint/tendermint/rpc/client/http"
 	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	jsonrpcclient "github.com/tendermint/tendermint/rpc/jsonrpc/client"
 	tmtypes "github.com/tendermint/tendermint/types"
 
 	"github.com/ignite-hq/cli/ignite/pkg/cosmosaccount"
@@ -84,9 +85,15 @@ type Client struct {
 	faucetDenom     string
 	faucetMinAmount uint64
 
+	gasPrices     string
+	gasAdjustment float64
+	maxGas        uint64
+
 	homePath           string
 	keyringServiceName string
 	keyringBackend     cosmosaccount.KeyringBackend
+
+	retry retryPolicy
 }
 
 // Option configures your client.
@@ -116,6 +123,14 @@ func WithKeyringBackend(backend cosmosaccount.KeyringBackend) Option {
 	}
 }
 
+// WithAccountRegistry sets the registry used to access accounts. When it is provided
+// the keyring options are ignored.
+func WithAccountRegistry(ar cosmosaccount.Registry) Option {
+	return func(c *Client) {
+		c.AccountRegistry = ar
+	}
+}
+
 // WithNodeAddress sets the node address of your chain. When this option is not provided
 // `http://localhost:26657` is used as default.
 func WithNodeAddress(addr string) Option {
@@ -124,6 +139,9 @@ func WithNodeAddress(addr string) Option {
 	}
 }
 
+// WithAddressPrefix sets the account address prefix of the chain. When this option is not
+// provided the prefix is discovered from the chain, and when it is provided it must match the
+// prefix of the chain. The prefix is used unchecked when the chain cannot be queried for it.
 func WithAddressPrefix(prefix string) Option {
 	return func(c *Client) {
 		c.addressPrefix = prefix
@@ -143,16 +161,46 @@ func WithUseFaucet(faucetAddress, denom string, minAmount uint64) Option {
 	}
 }
 
+// WithGasPrices sets the gas prices of the transactions, e.g. 0.025stake. When this option is
+// not provided the transactions have no fees.
+func WithGasPrices(gasPrices string) Option {
+	return func(c *Client) {
+		c.gasPrices = gasPrices
+	}
+}
+
+// WithGasAdjustment sets the factor the estimated gas of the transactions is multiplied by,
+// it is not used when a max gas is set.
+func WithGasAdjustment(adjustment float64) Option {
+	return func(c *Client) {
+		c.gasAdjustment = adjustment
+	}
+}
+
+// WithMaxGas sets the gas limit of the transactions, their gas is not estimated.
+func WithMaxGas(gas uint64) Option {
+	return func(c *Client) {
+		c.maxGas = gas
+	}
+}
+
 // New creates a new client with given options.
 func New(ctx context.Context, options ...Option) (Client, error) {
 	c := Client{
 		nodeAddress:     defaultNodeAddress,
 		keyringBackend:  cosmosaccount.KeyringTest,
-		addressPrefix:   "cosmos",
 		faucetAddress:   defaultFaucetAddress,
 		faucetDenom:     defaultFaucetDenom,
 		faucetMinAmount: defaultFaucetMinAmount,
+		gasAdjustment:   defaultGasAdjustment,
 		out:             io.Discard,
+		retry: retryPolicy{
+			maxAttempts:    defaultRetryMaxAttempts,
+			initialBackoff: defaultRetryInitialBackoff,
+			maxBackoff:     defaultRetryMaxBackoff,
+			jitter:         defaultRetryJitter,
+			retryable:      IsRetryableError,
+		},
 	}
 
 	var err error
@@ -161,7 +209,15 @@ func New(ctx context.Context, options ...Option) (Client, error) {
 		apply(&c)
 	}
 
-	if c.RPC, err = rpchttp.New(c.nodeAddress, "/websocket"); err != nil {
+	// queries and broadcasts that fail with a transient error are retried by the
+	// transport of the RPC client.
+	httpClient, err := jsonrpcclient.DefaultHTTPClient(c.nodeAddress)
+	if err != nil {
+		return Client{}, err
+	}
+	httpClient.Transport = retryTransport{httpClient.Transport, c.retry}
+
+	if c.RPC, err = rpchttp.NewWithClient(c.nodeAddress, "/websocket", httpClient); err != nil {
 		return Client{}, err
 	}
 
@@ -180,21 +236,42 @@ func New(ctx context.Context, options ...Option) (Client, error) {
 		c.homePath = filepath.Join(home, "."+c.chainID)
 	}
 
-	c.AccountRegistry, err = cosmosaccount.New(
-		cosmosaccount.WithKeyringServiceName(c.keyringServiceName),
-		cosmosaccount.WithKeyringBackend(c.keyringBackend),
-		cosmosaccount.WithHome(c.homePath),
-	)
-	if err != nil {
-		return Client{}, err
+	if c.AccountRegistry.Keyring == nil {
+		c.AccountRegistry, err = cosmosaccount.New(
+			cosmosaccount.WithKeyringServiceName(c.keyringServiceName),
+			cosmosaccount.WithKeyringBackend(c.keyringBackend),
+			cosmosaccount.WithHome(c.homePath),
+		)
+		if err != nil {
+			return Client{}, err
+		}
 	}
 
 	c.context = newContext(c.RPC, c.out, c.chainID, c.homePath).WithKeyring(c.AccountRegistry.Keyring)
-	c.Factory = newFactory(c.context)
+	if _, err := sdktypes.ParseDecCoins(c.gasPrices); err != nil {
+		return Client{}, fmt.Errorf("invalid gas prices %q: %w", c.gasPrices, err)
+	}
+	c.Factory = newFactory(c.context).
+		WithGasAdjustment(c.gasAdjustment).
+		WithGasPrices(c.gasPrices)
+
+	if c.addressPrefix, err = c.discoverAddressPrefix(ctx, c.addressPrefix); err != nil {
+		return Client{}, err
+	}
 
 	return c, nil
 }
 
+// ChainID returns the chain id reported by the node.
+func (c Client) ChainID() string {
+	return c.chainID
+}
+
+// AddressPrefix returns the account address prefix of the chain.
+func (c Client) AddressPrefix() string {
+	return c.addressPrefix
+}
+
 func (c Client) Account(accountName string) (cosmosaccount.Account, error) {
 	return c.AccountRegistry.GetByName(accountName)
 }
@@ -348,13 +425,17 @@ func (c Client) BroadcastTxWithProvision(accountName string, msgs ...sdktypes.Ms
 		return 0, nil, err
 	}
 
-	_, gas, err = tx.CalculateGas(ctx, txf, msgs...)
-	if err != nil {
-		return 0, nil, err
+	if c.maxGas > 0 {
+		gas = c.maxGas
+	} else {
+		_, gas, err = tx.CalculateGas(ctx, txf, msgs...)
+		if err != nil {
+			return 0, nil, err
+		}
+		// the simulated gas can vary from the actual gas needed for a real transaction
+		// we add an additional amount to endure sufficient gas is provided
+		gas += 10000
 	}
-	// the simulated gas can vary from the actual gas needed for a real transaction
-	// we add an additional amount to endure sufficient gas is provided
-	gas += 10000
 	txf = txf.WithGas(gas)
 
 	// Return the provision function
@@ -383,6 +464,12 @@ func (c Client) BroadcastTxWithProvision(accountName string, msgs ...sdktypes.Ms
 			resp, err = ctx.BroadcastTx(txBytes)
 		}
 
+		// a broadcast retried after its first attempt reached the node finds the tx in the
+		// mempool cache, the result of the tx is the result of the first attempt.
+		if err == nil && isTxInMempoolCache(resp) {
+			resp, err = c.waitTx(context.Background(), resp.TxHash)
+		}
+
 		return Response{
 			Codec:      ctx.Codec,
 			TxResponse: resp,
@@ -0,0 +1,125 @@
+package cosmosclient
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// maxDenomExponent is the max exponent between two units of a denom, limited by the
+// precision of sdk.Dec.
+const maxDenomExponent = sdktypes.Precision
+
+// denomUnit is a unit of a denom with its exponent relative to the base denom.
+type denomUnit struct {
+	metadata banktypes.Metadata
+	exponent uint32
+}
+
+// DenomConverter converts amounts between base denoms and display denoms with the bank
+// metadata of the denoms. Denoms without metadata are kept as is.
+type DenomConverter struct {
+	units map[string]denomUnit
+}
+
+// NewDenomConverter creates a new denom converter from the bank metadata of the denoms.
+func NewDenomConverter(metadatas ...banktypes.Metadata) DenomConverter {
+	c := DenomConverter{units: make(map[string]denomUnit)}
+	for _, m := range metadatas {
+		for _, u := range m.DenomUnits {
+			names := append([]string{u.Denom}, u.Aliases...)
+			for _, name := range names {
+				c.units[strings.ToLower(name)] = denomUnit{m, u.Exponent}
+			}
+		}
+		if m.Symbol != "" {
+			if display, ok := c.units[strings.ToLower(m.Display)]; ok {
+				c.units[strings.ToLower(m.Symbol)] = display
+			}
+		}
+	}
+	return c
+}
+
+// DenomConverter returns a denom converter with the bank metadata of all the denoms of the chain.
+func (c Client) DenomConverter(ctx context.Context) (DenomConverter, error) {
+	var (
+		metadatas   []banktypes.Metadata
+		queryClient = banktypes.NewQueryClient(c.context)
+		req         = &banktypes.QueryDenomsMetadataRequest{Pagination: &query.PageRequest{}}
+	)
+	for {
+		res, err := queryClient.DenomsMetadata(ctx, req)
+		if err != nil {
+			return DenomConverter{}, err
+		}
+		metadatas = append(metadatas, res.Metadatas...)
+
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			return NewDenomConverter(metadatas...), nil
+		}
+		req.Pagination.Key = res.Pagination.NextKey
+	}
+}
+
+// FormatCoin formats coin with its display denom for humans, e.g. 12500000uatom is formatted
+// as "12.5 ATOM". The symbol of the denom is used when it is set, the display denom in upper
+// case otherwise. Coins without metadata are formatted as amount and denom, e.g. "10 token".
+func (c DenomConverter) FormatCoin(coin sdktypes.Coin) string {
+	unit, ok := c.units[strings.ToLower(coin.Denom)]
+	if !ok {
+		return fmt.Sprintf("%s %s", coin.Amount, coin.Denom)
+	}
+
+	display, ok := c.units[strings.ToLower(unit.metadata.Display)]
+	if !ok || display.exponent <= unit.exponent || display.exponent-unit.exponent > maxDenomExponent {
+		return fmt.Sprintf("%s %s", coin.Amount, coin.Denom)
+	}
+
+	amount := sdktypes.NewDecFromIntWithPrec(coin.Amount, int64(display.exponent-unit.exponent)).String()
+	amount = strings.TrimRight(strings.TrimRight(amount, "0"), ".")
+
+	symbol := unit.metadata.Symbol
+	if symbol == "" {
+		symbol = strings.ToUpper(unit.metadata.Display)
+	}
+	return fmt.Sprintf("%s %s", amount, symbol)
+}
+
+// FormatCoins formats coins with their display denoms for humans, e.g. "12.5 ATOM, 10 token".
+func (c DenomConverter) FormatCoins(coins sdktypes.Coins) string {
+	formatted := make([]string, len(coins))
+	for i, coin := range coins {
+		formatted[i] = c.FormatCoin(coin)
+	}
+	return strings.Join(formatted, ", ")
+}
+
+// ParseDecCoin parses an amount of any unit of a denom, e.g. "12.5atom" or "12.5 ATOM", and
+// returns the coin in the base denom. The amount must be a whole number of base units.
+// Denoms without metadata are parsed as base denoms.
+func (c DenomConverter) ParseDecCoin(s string) (sdktypes.Coin, error) {
+	decCoin, err := sdktypes.ParseDecCoin(strings.ReplaceAll(s, " ", ""))
+	if err != nil {
+		return sdktypes.Coin{}, err
+	}
+
+	unit, ok := c.units[strings.ToLower(decCoin.Denom)]
+	if !ok {
+		unit = denomUnit{metadata: banktypes.Metadata{Base: decCoin.Denom}}
+	}
+	if unit.exponent > maxDenomExponent {
+		return sdktypes.Coin{}, fmt.Errorf("%s: exponent %d is not supported", decCoin.Denom, unit.exponent)
+	}
+
+	amount := decCoin.Amount.MulInt(sdktypes.NewIntWithDecimal(1, int(unit.exponent)))
+	if !amount.TruncateDec().Equal(amount) {
+		return sdktypes.Coin{}, fmt.Errorf("%s is not a whole number of %s", s, unit.metadata.Base)
+	}
+
+	return sdktypes.NewCoin(unit.metadata.Base, amount.TruncateInt()), nil
+}
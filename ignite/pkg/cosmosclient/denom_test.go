@@ -0,0 +1,76 @@
+package cosmosclient
+
+import (
+	"testing"
+
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+)
+
+var atomMetadata = banktypes.Metadata{
+	Base:    "uatom",
+	Display: "atom",
+	Symbol:  "ATOM",
+	DenomUnits: []*banktypes.DenomUnit{
+		{Denom: "uatom", Exponent: 0, Aliases: []string{"microatom"}},
+		{Denom: "matom", Exponent: 3},
+		{Denom: "atom", Exponent: 6},
+	},
+}
+
+func TestDenomConverterFormatCoin(t *testing.T) {
+	c := NewDenomConverter(atomMetadata)
+
+	tests := []struct {
+		coin sdktypes.Coin
+		want string
+	}{
+		{sdktypes.NewInt64Coin("uatom", 12_500_000), "12.5 ATOM"},
+		{sdktypes.NewInt64Coin("uatom", 3_000_000), "3 ATOM"},
+		{sdktypes.NewInt64Coin("uatom", 1), "0.000001 ATOM"},
+		{sdktypes.NewInt64Coin("uatom", 0), "0 ATOM"},
+		{sdktypes.NewInt64Coin("token", 10), "10 token"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.coin.String(), func(t *testing.T) {
+			require.Equal(t, tt.want, c.FormatCoin(tt.coin))
+		})
+	}
+
+	require.Equal(t, "10 token, 12.5 ATOM", c.FormatCoins(sdktypes.NewCoins(
+		sdktypes.NewInt64Coin("uatom", 12_500_000),
+		sdktypes.NewInt64Coin("token", 10),
+	)))
+}
+
+func TestDenomConverterParseDecCoin(t *testing.T) {
+	c := NewDenomConverter(atomMetadata)
+
+	tests := []struct {
+		s    string
+		want sdktypes.Coin
+		err  bool
+	}{
+		{s: "12.5atom", want: sdktypes.NewInt64Coin("uatom", 12_500_000)},
+		{s: "12.5 ATOM", want: sdktypes.NewInt64Coin("uatom", 12_500_000)},
+		{s: "2matom", want: sdktypes.NewInt64Coin("uatom", 2_000)},
+		{s: "10microatom", want: sdktypes.NewInt64Coin("uatom", 10)},
+		{s: "10uatom", want: sdktypes.NewInt64Coin("uatom", 10)},
+		{s: "10token", want: sdktypes.NewInt64Coin("token", 10)},
+		{s: "0.0000001atom", err: true},
+		{s: "1.5token", err: true},
+		{s: "atom", err: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			coin, err := c.ParseDecCoin(tt.s)
+			if tt.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, coin)
+		})
+	}
+}
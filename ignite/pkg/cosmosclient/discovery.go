@@ -0,0 +1,64 @@
+package cosmosclient
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	staking "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// defaultAddressPrefix is the address prefix used when the chain has no validators.
+const defaultAddressPrefix = "cosmos"
+
+// discoverAddressPrefix infers the account address prefix of the chain from the operator
+// address of a validator. When prefix is set, it is checked against the prefix of the chain
+// so transactions are not signed for addresses the chain rejects. The prefix cannot be
+// discovered on chains without validators, prefix or the default one is used then. When the
+// validators cannot be queried, e.g. on chains without the staking module, prefix is used
+// unchecked and an error is only returned when it is not set.
+func (c Client) discoverAddressPrefix(ctx context.Context, prefix string) (string, error) {
+	res, err := staking.NewQueryClient(c.context).Validators(ctx, &staking.QueryValidatorsRequest{
+		Pagination: &query.PageRequest{Limit: 1},
+	})
+	if err != nil && prefix != "" {
+		return prefix, nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("cannot discover the address prefix of chain %q: %w", c.chainID, err)
+	}
+	if len(res.Validators) == 0 {
+		if prefix == "" {
+			return defaultAddressPrefix, nil
+		}
+		return prefix, nil
+	}
+
+	discovered, err := addressPrefixFromValidator(res.Validators[0].OperatorAddress)
+	if err != nil {
+		return "", err
+	}
+
+	if prefix != "" && prefix != discovered {
+		return "", fmt.Errorf("address prefix %q doesn't match the prefix %q of chain %q", prefix, discovered, c.chainID)
+	}
+	return discovered, nil
+}
+
+// addressPrefixFromValidator returns the account address prefix of a validator operator
+// address, e.g. cosmos for cosmosvaloper1...
+func addressPrefixFromValidator(operatorAddress string) (string, error) {
+	hrp, _, err := bech32.DecodeAndConvert(operatorAddress)
+	if err != nil {
+		return "", err
+	}
+
+	prefix := strings.TrimSuffix(hrp, sdktypes.PrefixValidator+sdktypes.PrefixOperator)
+	if prefix == hrp {
+		return "", fmt.Errorf("%s is not a validator operator address", operatorAddress)
+	}
+	return prefix, nil
+}
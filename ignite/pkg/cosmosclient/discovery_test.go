@@ -0,0 +1,113 @@
+package cosmosclient
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	staking "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	rpchttp "github.com/tendermint/tendermint/rpc/client/http"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	rpctypes "github.com/tendermint/tendermint/rpc/jsonrpc/types"
+)
+
+func TestAddressPrefixFromValidator(t *testing.T) {
+	prefix, err := addressPrefixFromValidator("cosmosvaloper1dd246yq6z5vzjz9gh8cff46pll75yyl8pu8cup")
+	require.NoError(t, err)
+	require.Equal(t, "cosmos", prefix)
+
+	_, err = addressPrefixFromValidator("cosmos1dd246yq6z5vzjz9gh8cff46pll75yyl8ygndsj")
+	require.Error(t, err)
+
+	_, err = addressPrefixFromValidator("invalid")
+	require.Error(t, err)
+}
+
+func TestDiscoverAddressPrefix(t *testing.T) {
+	cases := []struct {
+		name       string
+		validators []staking.Validator
+		fail       bool
+		prefix     string
+		expected   string
+		err        string
+	}{
+		{
+			name:       "validator prefix",
+			validators: []staking.Validator{{OperatorAddress: "cosmosvaloper1dd246yq6z5vzjz9gh8cff46pll75yyl8pu8cup"}},
+			expected:   "cosmos",
+		},
+		{
+			name:       "matching prefix",
+			validators: []staking.Validator{{OperatorAddress: "cosmosvaloper1dd246yq6z5vzjz9gh8cff46pll75yyl8pu8cup"}},
+			prefix:     "cosmos",
+			expected:   "cosmos",
+		},
+		{
+			name:       "mismatching prefix",
+			validators: []staking.Validator{{OperatorAddress: "cosmosvaloper1dd246yq6z5vzjz9gh8cff46pll75yyl8pu8cup"}},
+			prefix:     "mars",
+			err:        `address prefix "mars" doesn't match the prefix "cosmos"`,
+		},
+		{
+			name:     "no validators",
+			expected: defaultAddressPrefix,
+		},
+		{
+			name:     "no validators with a prefix",
+			prefix:   "mars",
+			expected: "mars",
+		},
+		{
+			name: "query error",
+			fail: true,
+			err:  "cannot discover the address prefix",
+		},
+		{
+			name:     "query error with a prefix",
+			fail:     true,
+			prefix:   "mars",
+			expected: "mars",
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			node := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if tt.fail {
+					http.Error(w, "node is down", http.StatusInternalServerError)
+					return
+				}
+
+				var req rpctypes.RPCRequest
+				require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+
+				value, err := (&staking.QueryValidatorsResponse{Validators: tt.validators}).Marshal()
+				require.NoError(t, err)
+
+				res := rpctypes.NewRPCSuccessResponse(req.ID, ctypes.ResultABCIQuery{
+					Response: abci.ResponseQuery{Value: value},
+				})
+				require.NoError(t, json.NewEncoder(w).Encode(res))
+			}))
+			defer node.Close()
+
+			rpc, err := rpchttp.New(node.URL, "/websocket")
+			require.NoError(t, err)
+			c := Client{context: newContext(rpc, io.Discard, "mars-1", t.TempDir())}
+
+			prefix, err := c.discoverAddressPrefix(context.Background(), tt.prefix)
+			if tt.err != "" {
+				require.ErrorContains(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, prefix)
+		})
+	}
+}
@@ -0,0 +1,214 @@
+// Package query builds the queries of Tendermint events used to subscribe to events and to
+// search txs, e.g.:
+//
+//	q := query.Tx().And(query.EventType("transfer").AttrEq("recipient", addr))
+//	s, err := q.Build() // tm.event = 'Tx' AND transfer.recipient = 'cosmos1...'
+package query
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	tmquery "github.com/tendermint/tendermint/libs/pubsub/query"
+)
+
+// Operators of the conditions.
+const (
+	OpEqual          = "="
+	OpLess           = "<"
+	OpLessOrEqual    = "<="
+	OpGreater        = ">"
+	OpGreaterOrEqual = ">="
+	OpContains       = "CONTAINS"
+	OpExists         = "EXISTS"
+)
+
+// Types of the events emitted by Tendermint, matched by the tm.event key.
+const (
+	EventTx       = "Tx"
+	EventNewBlock = "NewBlock"
+)
+
+// forbiddenKeyChars are the chars that cannot be in a key of a condition.
+const forbiddenKeyChars = " \t\n\r\\()\"'=<>"
+
+// Date is a date compared to the value of an attribute, only its day is used.
+type Date time.Time
+
+// Query is a query of Tendermint events, its conditions all have to match.
+type Query struct {
+	conditions []string
+	errs       []error
+}
+
+// Event builds the conditions on the attributes of the events of a type.
+type Event struct {
+	typ string
+}
+
+// EventType returns the builder of the conditions on the events of type typ, e.g. transfer.
+func EventType(typ string) Event {
+	return Event{typ}
+}
+
+// Tendermint returns the query of the events emitted by Tendermint of type typ, e.g. EventTx.
+func Tendermint(typ string) Query {
+	return EventType("tm").AttrEq("event", typ)
+}
+
+// Tx returns the query of the events of txs.
+func Tx() Query {
+	return Tendermint(EventTx)
+}
+
+// NewBlock returns the query of the events of new blocks.
+func NewBlock() Query {
+	return Tendermint(EventNewBlock)
+}
+
+// TxHash returns the query of the events of the tx with hash.
+func TxHash(hash string) Query {
+	return EventType("tx").AttrEq("hash", strings.ToUpper(hash))
+}
+
+// TxHeight returns the query of the events of the txs of the block at height.
+func TxHeight(height int64) Query {
+	return EventType("tx").AttrEq("height", height)
+}
+
+// AttrEq matches the events with the attribute key equal to value.
+func (e Event) AttrEq(key string, value interface{}) Query {
+	return e.compare(key, OpEqual, value)
+}
+
+// AttrLt matches the events with the attribute key less than value.
+func (e Event) AttrLt(key string, value interface{}) Query {
+	return e.compare(key, OpLess, value)
+}
+
+// AttrLte matches the events with the attribute key less than or equal to value.
+func (e Event) AttrLte(key string, value interface{}) Query {
+	return e.compare(key, OpLessOrEqual, value)
+}
+
+// AttrGt matches the events with the attribute key greater than value.
+func (e Event) AttrGt(key string, value interface{}) Query {
+	return e.compare(key, OpGreater, value)
+}
+
+// AttrGte matches the events with the attribute key greater than or equal to value.
+func (e Event) AttrGte(key string, value interface{}) Query {
+	return e.compare(key, OpGreaterOrEqual, value)
+}
+
+// AttrContains matches the events with the attribute key containing s.
+func (e Event) AttrContains(key, s string) Query {
+	return e.compare(key, OpContains, s)
+}
+
+// AttrExists matches the events with the attribute key.
+func (e Event) AttrExists(key string) Query {
+	compositeKey, err := e.compositeKey(key)
+	if err != nil {
+		return Query{errs: []error{err}}
+	}
+	return Query{conditions: []string{compositeKey + " " + OpExists}}
+}
+
+func (e Event) compare(key, op string, value interface{}) Query {
+	compositeKey, err := e.compositeKey(key)
+	if err != nil {
+		return Query{errs: []error{err}}
+	}
+	operand, err := formatValue(value)
+	if err != nil {
+		return Query{errs: []error{fmt.Errorf("%s %s: %w", compositeKey, op, err)}}
+	}
+	return Query{conditions: []string{fmt.Sprintf("%s %s %s", compositeKey, op, operand)}}
+}
+
+// compositeKey returns the key of the attribute key of the events, e.g. transfer.recipient.
+func (e Event) compositeKey(key string) (string, error) {
+	switch {
+	case e.typ == "":
+		return "", errors.New("event type is empty")
+	case key == "":
+		return "", fmt.Errorf("attribute key of %s events is empty", e.typ)
+	}
+	compositeKey := e.typ + "." + key
+	if strings.ContainsAny(compositeKey, forbiddenKeyChars) {
+		return "", fmt.Errorf("key %q cannot contain spaces, parentheses, quotes, =, < or >", compositeKey)
+	}
+	return compositeKey, nil
+}
+
+// formatValue returns the operand of value in a condition.
+func formatValue(value interface{}) (string, error) {
+	switch v := value.(type) {
+	case string:
+		if strings.ContainsAny(v, `'"`) {
+			return "", fmt.Errorf("value %s cannot contain quotes", v)
+		}
+		return "'" + v + "'", nil
+	case int:
+		return strconv.Itoa(v), nil
+	case int32:
+		return strconv.FormatInt(int64(v), 10), nil
+	case int64:
+		return strconv.FormatInt(v, 10), nil
+	case uint:
+		return strconv.FormatUint(uint64(v), 10), nil
+	case uint32:
+		return strconv.FormatUint(uint64(v), 10), nil
+	case uint64:
+		return strconv.FormatUint(v, 10), nil
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	case time.Time:
+		return "TIME " + v.UTC().Format(time.RFC3339), nil
+	case Date:
+		return "DATE " + time.Time(v).UTC().Format("2006-01-02"), nil
+	case fmt.Stringer:
+		// types such as addresses and coins are compared by their string.
+		return formatValue(v.String())
+	default:
+		return "", fmt.Errorf("value of type %T cannot be compared", value)
+	}
+}
+
+// And returns the query matching the events matched by q and by all the queries.
+func (q Query) And(queries ...Query) Query {
+	and := Query{
+		conditions: append([]string{}, q.conditions...),
+		errs:       append([]error{}, q.errs...),
+	}
+	for _, query := range queries {
+		and.conditions = append(and.conditions, query.conditions...)
+		and.errs = append(and.errs, query.errs...)
+	}
+	return and
+}
+
+// String returns the query in the syntax of Tendermint, it is only valid when Build succeeds.
+func (q Query) String() string {
+	return strings.Join(q.conditions, " AND ")
+}
+
+// Build returns the query in the syntax of Tendermint, e.g. to subscribe to the events or
+// search the txs, or the first error of its conditions.
+func (q Query) Build() (string, error) {
+	if len(q.errs) > 0 {
+		return "", q.errs[0]
+	}
+	if len(q.conditions) == 0 {
+		return "", errors.New("query has no condition")
+	}
+	s := q.String()
+	if _, err := tmquery.New(s); err != nil {
+		return "", fmt.Errorf("invalid query %s: %w", s, err)
+	}
+	return s, nil
+}
@@ -0,0 +1,89 @@
+package query_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite-hq/cli/ignite/pkg/cosmosclient/query"
+)
+
+func TestBuild(t *testing.T) {
+	day := time.Date(2022, 5, 3, 14, 45, 0, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		query query.Query
+		want  string
+		err   string
+	}{
+		{
+			name:  "tx transfer",
+			query: query.Tx().And(query.EventType("transfer").AttrEq("recipient", "cosmos1recipient")),
+			want:  "tm.event = 'Tx' AND transfer.recipient = 'cosmos1recipient'",
+		},
+		{
+			name: "comparisons",
+			query: query.TxHeight(5).And(
+				query.EventType("message").AttrExists("action"),
+				query.EventType("transfer").AttrContains("amount", "stake"),
+				query.EventType("block").AttrGte("time", day),
+				query.EventType("block").AttrLt("date", query.Date(day)),
+				query.EventType("ratio").AttrGt("value", 1.5),
+				query.EventType("coins").AttrEq("amount", sdk.NewInt64Coin("stake", 10)),
+			),
+			want: "tx.height = 5 AND message.action EXISTS AND transfer.amount CONTAINS 'stake' AND " +
+				"block.time >= TIME 2022-05-03T14:45:00Z AND block.date < DATE 2022-05-03 AND " +
+				"ratio.value > 1.5 AND coins.amount = '10stake'",
+		},
+		{
+			name:  "tx hash",
+			query: query.TxHash("ab12"),
+			want:  "tx.hash = 'AB12'",
+		},
+		{
+			name:  "empty",
+			query: query.Query{},
+			err:   "query has no condition",
+		},
+		{
+			name:  "empty event type",
+			query: query.NewBlock().And(query.EventType("").AttrEq("recipient", "addr")),
+			err:   "event type is empty",
+		},
+		{
+			name:  "invalid key",
+			query: query.EventType("transfer").AttrEq("re cipient", "addr"),
+			err:   `key "transfer.re cipient" cannot contain spaces, parentheses, quotes, =, < or >`,
+		},
+		{
+			name:  "quoted value",
+			query: query.EventType("transfer").AttrEq("recipient", "a'b"),
+			err:   "transfer.recipient =: value a'b cannot contain quotes",
+		},
+		{
+			name:  "number not supported by tendermint",
+			query: query.EventType("ratio").AttrGt("value", -1),
+			err:   "invalid query ratio.value > -1: ",
+		},
+		{
+			name:  "invalid value",
+			query: query.EventType("transfer").AttrEq("recipient", []string{"addr"}),
+			err:   "transfer.recipient =: value of type []string cannot be compared",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := tt.query.Build()
+			if tt.err != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, s)
+		})
+	}
+}
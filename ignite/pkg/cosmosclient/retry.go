@@ -0,0 +1,209 @@
+package cosmosclient
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/cenkalti/backoff"
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+)
+
+// txCommitTimeout is the max duration waited for the commit of a tx found in the mempool
+// cache of the node.
+var txCommitTimeout = time.Minute
+
+const (
+	defaultRetryMaxAttempts    = 3
+	defaultRetryInitialBackoff = 500 * time.Millisecond
+	defaultRetryMaxBackoff     = 5 * time.Second
+	defaultRetryJitter         = 0.2
+)
+
+// HTTPStatusError is returned when the node, or a proxy in front of it, answers an RPC request
+// with a status meaning that the request can be retried later: 429, 502, 503 or 504.
+type HTTPStatusError struct {
+	StatusCode int
+	Status     string
+}
+
+func (e HTTPStatusError) Error() string {
+	return fmt.Sprintf("node responded with %s", e.Status)
+}
+
+// isRetryableStatus checks if an RPC request answered with code can be retried.
+func isRetryableStatus(code int) bool {
+	switch code {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// IsRetryableError checks if an RPC request failed with a transient error: a timeout, a
+// refused or reset connection, or an HTTPStatusError. Requests canceled by their context
+// are not retried.
+func IsRetryableError(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	var statusErr HTTPStatusError
+	if errors.As(err, &statusErr) {
+		return true
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+	return errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, io.EOF) ||
+		errors.Is(err, io.ErrUnexpectedEOF)
+}
+
+// retryPolicy is how the RPC requests that fail with a transient error are retried.
+type retryPolicy struct {
+	maxAttempts    int
+	initialBackoff time.Duration
+	maxBackoff     time.Duration
+	jitter         float64
+	retryable      func(error) bool
+}
+
+// WithRetry sets the max number of attempts of the RPC requests, queries and broadcasts,
+// that fail with a transient error and the bounds of the exponential backoff between the
+// attempts. maxAttempts of 1 disables the retries. By default, requests are attempted 3
+// times with a backoff from 500ms to 5s.
+//
+// The result of a broadcast retried after reaching the node is the result of the tx of its
+// first attempt.
+func WithRetry(maxAttempts int, initialBackoff, maxBackoff time.Duration) Option {
+	return func(c *Client) {
+		c.retry.maxAttempts = maxAttempts
+		c.retry.initialBackoff = initialBackoff
+		c.retry.maxBackoff = maxBackoff
+	}
+}
+
+// WithRetryJitter sets the randomization factor of the backoff between the attempts of a
+// request, between 0 and 1. It is 0.2 by default.
+func WithRetryJitter(jitter float64) Option {
+	return func(c *Client) {
+		c.retry.jitter = jitter
+	}
+}
+
+// WithRetryableError sets the function that checks if a failed RPC request is retried.
+// The error is an HTTPStatusError or the error of the HTTP transport. It is
+// IsRetryableError by default.
+func WithRetryableError(retryable func(error) bool) Option {
+	return func(c *Client) {
+		c.retry.retryable = retryable
+	}
+}
+
+// retryTransport retries the HTTP requests of the RPC client with policy.
+type retryTransport struct {
+	next   http.RoundTripper
+	policy retryPolicy
+}
+
+func (t retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	b := backoff.NewExponentialBackOff()
+	b.InitialInterval = t.policy.initialBackoff
+	b.MaxInterval = t.policy.maxBackoff
+	b.RandomizationFactor = t.policy.jitter
+	b.MaxElapsedTime = 0
+	b.Reset()
+
+	for attempt := 1; ; attempt++ {
+		res, err := t.next.RoundTrip(req)
+		if err == nil && isRetryableStatus(res.StatusCode) {
+			err = HTTPStatusError{res.StatusCode, res.Status}
+		}
+		if err == nil {
+			return res, nil
+		}
+
+		var wait time.Duration
+		if res != nil {
+			wait = retryAfter(res)
+			_, _ = io.Copy(io.Discard, res.Body)
+			res.Body.Close()
+		}
+
+		// requests with a body that cannot be read again are not retried.
+		canRewind := req.Body == nil || req.GetBody != nil
+		if attempt >= t.policy.maxAttempts || !canRewind || !t.policy.retryable(err) {
+			return nil, err
+		}
+
+		if next := b.NextBackOff(); next > wait {
+			wait = next
+		}
+		if wait > t.policy.maxBackoff {
+			wait = t.policy.maxBackoff
+		}
+		select {
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		case <-time.After(wait):
+		}
+
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req = req.Clone(req.Context())
+			req.Body = body
+		}
+	}
+}
+
+// retryAfter returns the delay in seconds of the Retry-After header of res.
+func retryAfter(res *http.Response) time.Duration {
+	seconds, err := strconv.Atoi(res.Header.Get("Retry-After"))
+	if err != nil || seconds < 0 {
+		return 0
+	}
+	return time.Duration(seconds) * time.Second
+}
+
+// isTxInMempoolCache checks if the tx of a broadcast was already in the mempool cache of the
+// node, e.g. when the broadcast is retried after its first attempt reached the node.
+func isTxInMempoolCache(resp *sdktypes.TxResponse) bool {
+	return resp.Codespace == sdkerrors.ErrTxInMempoolCache.Codespace() &&
+		resp.Code == sdkerrors.ErrTxInMempoolCache.ABCICode()
+}
+
+// waitTx waits until the tx with the hex encoded hash is committed and returns its result.
+func (c Client) waitTx(ctx context.Context, hash string) (*sdktypes.TxResponse, error) {
+	txHash, err := hex.DecodeString(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, txCommitTimeout)
+	defer cancel()
+
+	var resTx *ctypes.ResultTx
+	err = backoff.Retry(func() (err error) {
+		resTx, err = c.RPC.Tx(ctx, txHash, false)
+		return err
+	}, backoff.WithContext(backoff.NewConstantBackOff(time.Second), ctx))
+	if err != nil {
+		return nil, fmt.Errorf("tx %s is in the mempool but not committed: %w", hash, err)
+	}
+	return sdktypes.NewResponseResultTx(resTx, nil, ""), nil
+}
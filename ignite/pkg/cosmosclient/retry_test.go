@@ -0,0 +1,189 @@
+package cosmosclient
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	rpchttp "github.com/tendermint/tendermint/rpc/client/http"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	rpctypes "github.com/tendermint/tendermint/rpc/jsonrpc/types"
+)
+
+func newRetryTestClient(maxAttempts int) *http.Client {
+	return &http.Client{
+		Transport: retryTransport{http.DefaultTransport, retryPolicy{
+			maxAttempts:    maxAttempts,
+			initialBackoff: time.Millisecond,
+			maxBackoff:     10 * time.Millisecond,
+			jitter:         defaultRetryJitter,
+			retryable:      IsRetryableError,
+		}},
+	}
+}
+
+func TestRetryTransport(t *testing.T) {
+	var (
+		requests int
+		bodies   []string
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		body, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+		if requests < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprint(w, "ok")
+	}))
+	defer srv.Close()
+
+	res, err := newRetryTestClient(3).Post(srv.URL, "application/json", strings.NewReader("query"))
+	require.NoError(t, err)
+	defer res.Body.Close()
+	require.Equal(t, http.StatusOK, res.StatusCode)
+	require.Equal(t, 3, requests)
+	require.Equal(t, []string{"query", "query", "query"}, bodies)
+}
+
+func TestRetryTransportMaxAttempts(t *testing.T) {
+	var requests int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer srv.Close()
+
+	_, err := newRetryTestClient(2).Get(srv.URL)
+	var statusErr HTTPStatusError
+	require.ErrorAs(t, err, &statusErr)
+	require.Equal(t, http.StatusTooManyRequests, statusErr.StatusCode)
+	require.Equal(t, 2, requests)
+}
+
+func TestRetryTransportNotRetryable(t *testing.T) {
+	var requests int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer srv.Close()
+
+	res, err := newRetryTestClient(3).Get(srv.URL)
+	require.NoError(t, err)
+	defer res.Body.Close()
+	require.Equal(t, http.StatusBadRequest, res.StatusCode)
+	require.Equal(t, 1, requests)
+}
+
+func TestRetryTransportCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	var requests int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		cancel()
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL, nil)
+	require.NoError(t, err)
+	_, err = newRetryTestClient(3).Do(req)
+	require.ErrorIs(t, err, context.Canceled)
+	require.Equal(t, 1, requests)
+}
+
+type timeoutError struct{}
+
+func (timeoutError) Error() string   { return "i/o timeout" }
+func (timeoutError) Timeout() bool   { return true }
+func (timeoutError) Temporary() bool { return true }
+
+func TestIsRetryableError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"status", HTTPStatusError{http.StatusBadGateway, "502 Bad Gateway"}, true},
+		{"timeout", fmt.Errorf("post: %w", timeoutError{}), true},
+		{"connection refused", fmt.Errorf("dial: %w", syscall.ECONNREFUSED), true},
+		{"connection reset", fmt.Errorf("read: %w", syscall.ECONNRESET), true},
+		{"eof", io.ErrUnexpectedEOF, true},
+		{"canceled", context.Canceled, false},
+		{"deadline", fmt.Errorf("post: %w", context.DeadlineExceeded), false},
+		{"other", errors.New("invalid request"), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, IsRetryableError(tt.err))
+		})
+	}
+}
+
+func TestIsTxInMempoolCache(t *testing.T) {
+	require.True(t, isTxInMempoolCache(&sdktypes.TxResponse{
+		Codespace: sdkerrors.ErrTxInMempoolCache.Codespace(),
+		Code:      sdkerrors.ErrTxInMempoolCache.ABCICode(),
+	}))
+	require.False(t, isTxInMempoolCache(&sdktypes.TxResponse{}))
+	require.False(t, isTxInMempoolCache(&sdktypes.TxResponse{
+		Codespace: sdkerrors.ErrInsufficientFunds.Codespace(),
+		Code:      sdkerrors.ErrInsufficientFunds.ABCICode(),
+	}))
+}
+
+func TestWaitTx(t *testing.T) {
+	var requests int
+	node := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+
+		var req rpctypes.RPCRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		require.Equal(t, "tx", req.Method)
+
+		// the tx of the first attempt of the broadcast is committed after the retry.
+		res := rpctypes.RPCInternalError(req.ID, errors.New("tx (0A0B) not found"))
+		if requests > 1 {
+			res = rpctypes.NewRPCSuccessResponse(req.ID, ctypes.ResultTx{
+				Hash:     []byte{0x0a, 0x0b},
+				Height:   5,
+				TxResult: abci.ResponseDeliverTx{Data: []byte{0x01}, GasUsed: 100},
+			})
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(res))
+	}))
+	defer node.Close()
+
+	rpc, err := rpchttp.New(node.URL, "/websocket")
+	require.NoError(t, err)
+	c := Client{RPC: rpc}
+
+	resp, err := c.waitTx(context.Background(), "0A0B")
+	require.NoError(t, err)
+	require.Equal(t, 2, requests)
+	require.Equal(t, "0A0B", resp.TxHash)
+	require.EqualValues(t, 5, resp.Height)
+	require.EqualValues(t, 0, resp.Code)
+	require.Equal(t, "01", resp.Data)
+
+	// the broadcast fails when the tx is not committed in time.
+	defer func(timeout time.Duration) { txCommitTimeout = timeout }(txCommitTimeout)
+	txCommitTimeout = 10 * time.Millisecond
+	requests = 0
+	_, err = c.waitTx(context.Background(), "0A0B")
+	require.ErrorContains(t, err, "not committed")
+}
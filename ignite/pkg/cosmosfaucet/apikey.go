@@ -0,0 +1,204 @@
+package cosmosfaucet
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// APIKeyHeader is the header of the transfer requests that holds the API key.
+const APIKeyHeader = "X-API-Key"
+
+// apiKeySize is the number of random bytes of an API key.
+const apiKeySize = 32
+
+var (
+	// ErrAPIKeyRequired is returned when a transfer is requested without API key to a faucet
+	// that requires one.
+	ErrAPIKeyRequired = errors.New("API key is required")
+
+	// ErrInvalidAPIKey is returned when the API key of a transfer request is not known.
+	ErrInvalidAPIKey = errors.New("invalid API key")
+)
+
+// APIKey gives access to the faucet with the key, its limits replace the limits of the
+// faucet for the requests made with the key.
+type APIKey struct {
+	// Name identifies the holder of the key.
+	Name string
+
+	// Hash is the hex encoded SHA-256 hash of the key, the key itself is not kept.
+	Hash string
+
+	// Denoms are the denoms that can be requested with the key, all of them when empty.
+	Denoms []string
+
+	// CoinsMax holds the max amounts of the denoms that can be transferred to a single account
+	// with the key, the max amounts of the faucet are used for the other denoms.
+	CoinsMax map[string]uint64
+
+	// Domains are the domains of the web pages allowed to use the key, the requests with the
+	// key must come from one of them when set. Domains like *.example.com allow subdomains.
+	Domains []string
+}
+
+// NewAPIKey returns a new random API key and its hash.
+func NewAPIKey() (key, hash string, err error) {
+	b := make([]byte, apiKeySize)
+	if _, err := rand.Read(b); err != nil {
+		return "", "", err
+	}
+	key = hex.EncodeToString(b)
+	return key, HashAPIKey(key), nil
+}
+
+// HashAPIKey returns the hash of key.
+func HashAPIKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+// apiKeySet holds API keys by the hash of the keys, it is shared by the copies of a faucet
+// so the keys can be replaced while the faucet serves.
+type apiKeySet struct {
+	mu     sync.RWMutex
+	byHash map[string]APIKey
+}
+
+func newAPIKeySet() *apiKeySet {
+	return &apiKeySet{byHash: make(map[string]APIKey)}
+}
+
+func (s *apiKeySet) add(keys ...APIKey) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, k := range keys {
+		s.byHash[strings.ToLower(k.Hash)] = k
+	}
+}
+
+func (s *apiKeySet) set(keys ...APIKey) {
+	byHash := make(map[string]APIKey)
+	for _, k := range keys {
+		byHash[strings.ToLower(k.Hash)] = k
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.byHash = byHash
+}
+
+func (s *apiKeySet) get(hash string) (APIKey, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	k, ok := s.byHash[hash]
+	return k, ok
+}
+
+// APIKeys adds API keys to access the faucet.
+func APIKeys(keys ...APIKey) Option {
+	return func(f *Faucet) {
+		f.apiKeys.add(keys...)
+	}
+}
+
+// SetAPIKeys replaces the API keys of the faucet by keys, the requests made with the
+// previous keys that are not in keys fail with ErrInvalidAPIKey. It can be called while
+// the faucet serves.
+func (f Faucet) SetAPIKeys(keys ...APIKey) {
+	f.apiKeys.set(keys...)
+}
+
+// RequireAPIKey only serves the transfer requests made with an API key.
+func RequireAPIKey() Option {
+	return func(f *Faucet) {
+		f.requireAPIKey = true
+	}
+}
+
+// requestAPIKey returns the API key of the request, found is false when the request has no
+// API key and the faucet doesn't require one.
+func (f Faucet) requestAPIKey(r *http.Request) (key APIKey, found bool, err error) {
+	value := r.Header.Get(APIKeyHeader)
+	if value == "" {
+		if f.requireAPIKey {
+			return APIKey{}, false, ErrAPIKeyRequired
+		}
+		return APIKey{}, false, nil
+	}
+
+	key, ok := f.apiKeys.get(HashAPIKey(value))
+	if !ok {
+		return APIKey{}, false, ErrInvalidAPIKey
+	}
+	return key, true, nil
+}
+
+// checkOrigin checks that the request comes from one of the domains of the key.
+func (k APIKey) checkOrigin(r *http.Request) error {
+	if len(k.Domains) == 0 {
+		return nil
+	}
+
+	origin, err := url.Parse(r.Header.Get("Origin"))
+	if err != nil || origin.Hostname() == "" {
+		return fmt.Errorf("API key %q can only be used from its domains", k.Name)
+	}
+	host := strings.ToLower(origin.Hostname())
+	for _, domain := range k.Domains {
+		domain = strings.ToLower(domain)
+		if host == domain {
+			return nil
+		}
+		if strings.HasPrefix(domain, "*.") && strings.HasSuffix(host, domain[1:]) {
+			return nil
+		}
+	}
+	return fmt.Errorf("API key %q cannot be used from %s", k.Name, host)
+}
+
+// allowedCoins returns coins when their denoms can be requested with the key. Only the
+// allowed denoms are kept when coins are the default coins of the faucet.
+func (k APIKey) allowedCoins(coins sdk.Coins, isDefault bool) (sdk.Coins, error) {
+	if len(k.Denoms) == 0 {
+		return coins, nil
+	}
+
+	allowed := make(map[string]bool)
+	for _, denom := range k.Denoms {
+		allowed[denom] = true
+	}
+
+	var kept sdk.Coins
+	for _, c := range coins {
+		switch {
+		case allowed[c.Denom]:
+			kept = append(kept, c)
+		case !isDefault:
+			return nil, fmt.Errorf("%q denom cannot be requested with API key %q", c.Denom, k.Name)
+		}
+	}
+	if len(kept) == 0 {
+		return nil, fmt.Errorf("no coin can be requested with API key %q", k.Name)
+	}
+	return kept, nil
+}
+
+// coinsMax returns the max amounts of the denoms for the transfers made with the key.
+func (k APIKey) coinsMax(faucetCoinsMax map[string]uint64) map[string]uint64 {
+	coinsMax := make(map[string]uint64, len(faucetCoinsMax)+len(k.CoinsMax))
+	for denom, amount := range faucetCoinsMax {
+		coinsMax[denom] = amount
+	}
+	for denom, amount := range k.CoinsMax {
+		coinsMax[denom] = amount
+	}
+	return coinsMax
+}
@@ -0,0 +1,110 @@
+package cosmosfaucet
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequestAPIKey(t *testing.T) {
+	key, hash, err := NewAPIKey()
+	require.NoError(t, err)
+	require.Equal(t, HashAPIKey(key), hash)
+
+	f := Faucet{apiKeys: newAPIKeySet()}
+	APIKeys(APIKey{Name: "partner", Hash: hash})(&f)
+
+	r := httptest.NewRequest("POST", "/", nil)
+	_, found, err := f.requestAPIKey(r)
+	require.NoError(t, err)
+	require.False(t, found)
+
+	r.Header.Set(APIKeyHeader, key)
+	k, found, err := f.requestAPIKey(r)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, "partner", k.Name)
+
+	r.Header.Set(APIKeyHeader, "unknown")
+	_, _, err = f.requestAPIKey(r)
+	require.ErrorIs(t, err, ErrInvalidAPIKey)
+
+	// a revoked key is invalid for the copies of the faucet that serve.
+	served := f
+	f.SetAPIKeys()
+	r.Header.Set(APIKeyHeader, key)
+	_, _, err = served.requestAPIKey(r)
+	require.ErrorIs(t, err, ErrInvalidAPIKey)
+
+	f.SetAPIKeys(APIKey{Name: "partner", Hash: hash})
+	_, found, err = served.requestAPIKey(r)
+	require.NoError(t, err)
+	require.True(t, found)
+
+	RequireAPIKey()(&f)
+	r.Header.Del(APIKeyHeader)
+	_, _, err = f.requestAPIKey(r)
+	require.ErrorIs(t, err, ErrAPIKeyRequired)
+}
+
+func TestAPIKeyCheckOrigin(t *testing.T) {
+	k := APIKey{Name: "partner", Domains: []string{"app.partner.com", "*.partner.dev"}}
+	tests := []struct {
+		origin string
+		valid  bool
+	}{
+		{"https://app.partner.com", true},
+		{"https://APP.partner.com:8080", true},
+		{"https://staging.partner.dev", true},
+		{"https://partner.dev", false},
+		{"https://evilpartner.dev", false},
+		{"https://partner.com", false},
+		{"", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.origin, func(t *testing.T) {
+			r := httptest.NewRequest("POST", "/", nil)
+			if tt.origin != "" {
+				r.Header.Set("Origin", tt.origin)
+			}
+			err := k.checkOrigin(r)
+			if tt.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+
+	// keys without domains are used from anywhere.
+	require.NoError(t, APIKey{}.checkOrigin(httptest.NewRequest("POST", "/", nil)))
+}
+
+func TestAPIKeyAllowedCoins(t *testing.T) {
+	k := APIKey{Name: "partner", Denoms: []string{"token"}}
+	coins := sdk.NewCoins(sdk.NewInt64Coin("stake", 5), sdk.NewInt64Coin("token", 10))
+
+	allowed, err := k.allowedCoins(coins, true)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("token", 10)), allowed)
+
+	_, err = k.allowedCoins(coins, false)
+	require.EqualError(t, err, `"stake" denom cannot be requested with API key "partner"`)
+
+	_, err = k.allowedCoins(sdk.NewCoins(sdk.NewInt64Coin("stake", 5)), true)
+	require.Error(t, err)
+
+	allowed, err = APIKey{}.allowedCoins(coins, false)
+	require.NoError(t, err)
+	require.Equal(t, coins, allowed)
+}
+
+func TestAPIKeyCoinsMax(t *testing.T) {
+	k := APIKey{CoinsMax: map[string]uint64{"token": 1000}}
+	require.Equal(t,
+		map[string]uint64{"token": 1000, "stake": 50},
+		k.coinsMax(map[string]uint64{"token": 100, "stake": 50}),
+	)
+}
@@ -0,0 +1,42 @@
+package cosmosfaucet
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// ParseTransferRequests parses a batch of transfer requests from r.
+// Each line of the batch must contain an account address followed by the coins
+// to transfer separated by commas, e.g. `cosmos1...  10token,5stake`.
+// Empty lines and lines starting with # are ignored.
+func ParseTransferRequests(r io.Reader) ([]TransferRequest, error) {
+	var (
+		requests []TransferRequest
+		scanner  = bufio.NewScanner(r)
+		line     int
+	)
+
+	for scanner.Scan() {
+		line++
+
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+
+		fields := strings.Fields(text)
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("line %d: expected an address and a list of coins, got %q", line, text)
+		}
+
+		requests = append(requests, NewTransferRequest(fields[0], strings.Split(fields[1], ",")))
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return requests, nil
+}
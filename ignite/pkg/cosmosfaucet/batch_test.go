@@ -0,0 +1,29 @@
+package cosmosfaucet
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTransferRequests(t *testing.T) {
+	batch := `
+# funds for the test accounts
+cosmos1adn9gxjmrc3hrsdx5zpc9sj2ra7kgqkmphf8yw 10token,5stake
+
+cosmos1wpj4fmm8r2wzx7cmnfj4l4jh2qfl3lzlxpg05e   20token
+`
+
+	requests, err := ParseTransferRequests(strings.NewReader(batch))
+	require.NoError(t, err)
+	require.Equal(t, []TransferRequest{
+		NewTransferRequest("cosmos1adn9gxjmrc3hrsdx5zpc9sj2ra7kgqkmphf8yw", []string{"10token", "5stake"}),
+		NewTransferRequest("cosmos1wpj4fmm8r2wzx7cmnfj4l4jh2qfl3lzlxpg05e", []string{"20token"}),
+	}, requests)
+}
+
+func TestParseTransferRequestsInvalid(t *testing.T) {
+	_, err := ParseTransferRequests(strings.NewReader("cosmos1adn9gxjmrc3hrsdx5zpc9sj2ra7kgqkmphf8yw"))
+	require.EqualError(t, err, `line 1: expected an address and a list of coins, got "cosmos1adn9gxjmrc3hrsdx5zpc9sj2ra7kgqkmphf8yw"`)
+}
@@ -56,6 +56,12 @@ type Faucet struct {
 
 	limitRefreshWindow time.Duration
 
+	// apiKeys are the API keys to access the faucet.
+	apiKeys *apiKeySet
+
+	// requireAPIKey only serves the transfer requests with an API key when set.
+	requireAPIKey bool
+
 	// openAPIData holds template data customizations for serving OpenAPI page & spec.
 	openAPIData openAPIData
 }
@@ -113,6 +119,7 @@ func New(ctx context.Context, ccr chaincmdrunner.Runner, options ...Option) (Fau
 		runner:      ccr,
 		accountName: DefaultAccountName,
 		coinsMax:    make(map[string]uint64),
+		apiKeys:     newAPIKeySet(),
 		openAPIData: openAPIData{"Blockchain", "http://localhost:1317"},
 	}
 
@@ -14,8 +14,13 @@ import (
 func (f Faucet) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	router := mux.NewRouter()
 
-	router.Handle("/", cors.Default().Handler(http.HandlerFunc(f.faucetHandler))).
-		Methods(http.MethodPost)
+	// the API key header is allowed for the requests of web pages.
+	transferCORS := cors.New(cors.Options{
+		AllowedMethods: []string{http.MethodPost},
+		AllowedHeaders: []string{"Content-Type", APIKeyHeader},
+	})
+	router.Handle("/", transferCORS.Handler(http.HandlerFunc(f.faucetHandler))).
+		Methods(http.MethodPost, http.MethodOptions)
 
 	router.Handle("/info", cors.Default().Handler(http.HandlerFunc(f.faucetInfoHandler))).
 		Methods(http.MethodGet)
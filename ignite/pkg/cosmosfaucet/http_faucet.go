@@ -39,15 +39,36 @@ func (f Faucet) faucetHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// requests with an API key get the limits of the key.
+	key, hasKey, err := f.requestAPIKey(r)
+	if err != nil {
+		responseError(w, http.StatusUnauthorized, err)
+		return
+	}
+	coinsMax := f.coinsMax
+	if hasKey {
+		if err := key.checkOrigin(r); err != nil {
+			responseError(w, http.StatusForbidden, err)
+			return
+		}
+		coinsMax = key.coinsMax(f.coinsMax)
+	}
+
 	// determine coins to transfer.
 	coins, err := f.coinsFromRequest(req)
 	if err != nil {
 		responseError(w, http.StatusBadRequest, err)
 		return
 	}
+	if hasKey {
+		if coins, err = key.allowedCoins(coins, len(req.Coins) == 0); err != nil {
+			responseError(w, http.StatusForbidden, err)
+			return
+		}
+	}
 
 	// try performing the transfer
-	if err := f.Transfer(r.Context(), req.AccountAddress, coins); err != nil {
+	if err := f.transfer(r.Context(), req.AccountAddress, coins, coinsMax); err != nil {
 		if err == context.Canceled {
 			return
 		}
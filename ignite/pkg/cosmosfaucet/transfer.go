@@ -53,6 +53,12 @@ func (f Faucet) TotalTransferredAmount(ctx context.Context, toAccountAddress, de
 
 // Transfer transfer amount of tokens from the faucet account to toAccountAddress.
 func (f *Faucet) Transfer(ctx context.Context, toAccountAddress string, coins sdk.Coins) error {
+	return f.transfer(ctx, toAccountAddress, coins, f.coinsMax)
+}
+
+// transfer transfers coins to toAccountAddress unless it makes the total transferred amount
+// of a denom to the account exceed its max amount in coinsMax.
+func (f *Faucet) transfer(ctx context.Context, toAccountAddress string, coins sdk.Coins, coinsMax map[string]uint64) error {
 	transferMutex.Lock()
 	defer transferMutex.Unlock()
 
@@ -65,20 +71,20 @@ func (f *Faucet) Transfer(ctx context.Context, toAccountAddress string, coins sd
 			return err
 		}
 
-		if f.coinsMax[c.Denom] != 0 {
-			if totalSent >= f.coinsMax[c.Denom] {
+		if coinsMax[c.Denom] != 0 {
+			if totalSent >= coinsMax[c.Denom] {
 				return fmt.Errorf(
 					"account has reached to the max. allowed amount (%d) for %q denom",
-					f.coinsMax[c.Denom],
+					coinsMax[c.Denom],
 					c.Denom,
 				)
 			}
 
-			if (totalSent + c.Amount.Uint64()) > f.coinsMax[c.Denom] {
+			if (totalSent + c.Amount.Uint64()) > coinsMax[c.Denom] {
 				return fmt.Errorf(
 					`ask less amount for %q denom. account is reaching to the limit (%d) that faucet can tolerate`,
 					c.Denom,
-					f.coinsMax[c.Denom],
+					coinsMax[c.Denom],
 				)
 			}
 		}
@@ -3,6 +3,7 @@ package cosmosgen
 import (
 	"context"
 	"path/filepath"
+	"sort"
 
 	gomodmodule "golang.org/x/mod/module"
 
@@ -100,6 +101,17 @@ type generator struct {
 	thirdModules map[string][]module.Module // app dependency-modules pair.
 }
 
+// thirdModuleSources returns the source paths of the app dependencies in a stable order
+// so the generated code doesn't depend on the iteration order of thirdModules.
+func (g *generator) thirdModuleSources() []string {
+	sources := make([]string, 0, len(g.thirdModules))
+	for src := range g.thirdModules {
+		sources = append(sources, src)
+	}
+	sort.Strings(sources)
+	return sources
+}
+
 // Generate generates code from protoDir of an SDK app residing at appPath with given options.
 // protoDir must be relative to the projectPath.
 func Generate(ctx context.Context, cacheStorage cache.Storage, appPath, protoDir string, options ...Option) error {
@@ -60,8 +60,8 @@ func (g *dartGenerator) generateModules() error {
 	add(g.g.appPath, g.g.appModules)
 
 	if g.g.o.dartIncludeThirdParty {
-		for sourcePath, modules := range g.g.thirdModules {
-			add(sourcePath, modules)
+		for _, sourcePath := range g.g.thirdModuleSources() {
+			add(sourcePath, g.g.thirdModules[sourcePath])
 		}
 	}
 
@@ -93,8 +93,8 @@ func (g *jsGenerator) generateModules() error {
 	add(g.g.appPath, g.g.appModules)
 
 	if g.g.o.jsIncludeThirdParty {
-		for sourcePath, modules := range g.g.thirdModules {
-			add(sourcePath, modules)
+		for _, sourcePath := range g.g.thirdModuleSources() {
+			add(sourcePath, g.g.thirdModules[sourcePath])
 		}
 	}
 
@@ -0,0 +1,117 @@
+package cosmosgen
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ignite-hq/cli/ignite/pkg/cmdrunner/exec"
+	"github.com/ignite-hq/cli/ignite/pkg/cmdrunner/step"
+	"github.com/ignite-hq/cli/ignite/pkg/goanalysis"
+	"github.com/ignite-hq/cli/ignite/pkg/gocmd"
+	"github.com/ignite-hq/cli/ignite/pkg/multiformatname"
+)
+
+const (
+	mockgenPath   = "github.com/golang/mock/mockgen"
+	gomockPath    = "github.com/golang/mock/gomock"
+	gomockVersion = "v1.6.0"
+
+	// expectedKeepersFile is the file that declares the keepers a module depends on.
+	expectedKeepersFile = "expected_keepers.go"
+
+	// mockFileSuffix is the suffix of the files generated in the mocks package.
+	mockFileSuffix = "_" + expectedKeepersFile
+)
+
+// GenerateMocks generates gomock mocks for the interfaces of the expected keepers of every
+// module of the app at appPath, in the package at out relative to the app. The mocks of all
+// modules are generated in the same package, their names are prefixed with the module name
+// to avoid conflicts, e.g. MockMarsBankKeeper.
+func GenerateMocks(ctx context.Context, appPath, out string) error {
+	sources, err := filepath.Glob(filepath.Join(appPath, "x", "*", "types", expectedKeepersFile))
+	if err != nil {
+		return err
+	}
+
+	mocksPath := filepath.Join(appPath, out)
+
+	// remove the previous mocks so the ones of removed modules don't stay around.
+	previous, err := filepath.Glob(filepath.Join(mocksPath, "*"+mockFileSuffix))
+	if err != nil {
+		return err
+	}
+	for _, path := range previous {
+		if err := os.Remove(path); err != nil {
+			return err
+		}
+	}
+
+	if len(sources) == 0 {
+		return nil
+	}
+
+	if err := os.MkdirAll(mocksPath, 0755); err != nil {
+		return err
+	}
+
+	// mockgen is installed as a tool, only gomock that is imported by the generated mocks
+	// is added to the dependencies of the app.
+	if err := exec.Exec(ctx,
+		[]string{gocmd.Name(), gocmd.CommandInstall, gocmd.PackageLiteral(mockgenPath, gomockVersion)},
+	); err != nil {
+		return err
+	}
+	if err := exec.Exec(ctx,
+		[]string{gocmd.Name(), "get", gocmd.PackageLiteral(gomockPath, gomockVersion)},
+		exec.StepOption(step.Workdir(appPath)),
+	); err != nil {
+		return err
+	}
+
+	for _, source := range sources {
+		moduleName := filepath.Base(filepath.Dir(filepath.Dir(source)))
+
+		// the source path is written in the mocks, it is kept relative so they don't
+		// depend on the location of the app.
+		relSource, err := filepath.Rel(appPath, source)
+		if err != nil {
+			return err
+		}
+
+		interfaces, err := goanalysis.FindInterfaces(source)
+		if err != nil {
+			return err
+		}
+		if len(interfaces) == 0 {
+			continue
+		}
+
+		name, err := multiformatname.NewName(moduleName)
+		if err != nil {
+			return err
+		}
+
+		mockNames := make([]string, len(interfaces))
+		for i, iface := range interfaces {
+			mockNames[i] = fmt.Sprintf("%s=Mock%s%s", iface, name.UpperCamel, iface)
+		}
+
+		if err := exec.Exec(ctx,
+			[]string{
+				"mockgen",
+				"-source", relSource,
+				"-destination", filepath.Join(mocksPath, moduleName+mockFileSuffix),
+				"-package", filepath.Base(mocksPath),
+				"-mock_names", strings.Join(mockNames, ","),
+			},
+			exec.StepOption(step.Workdir(appPath)),
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -119,8 +119,8 @@ func generateOpenAPISpec(g *generator) error {
 		return err
 	}
 
-	for src, modules := range g.thirdModules {
-		if err := add(src, modules); err != nil {
+	for _, src := range g.thirdModuleSources() {
+		if err := add(src, g.thirdModules[src]); err != nil {
 			return err
 		}
 	}
@@ -0,0 +1,69 @@
+package cosmosgen
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTemplateVuexRootEntryPoints(t *testing.T) {
+	type module struct {
+		Name     string
+		Path     string
+		FullName string
+		FullPath string
+	}
+
+	dir := t.TempDir()
+	data := struct {
+		Modules     []module
+		PackageName string
+	}{
+		Modules: []module{
+			{Name: "CosmosBankV1Beta1", Path: "cosmos.bank.v1beta1", FullName: "CosmosCosmosSdkCosmosBankV1Beta1", FullPath: "cosmos/cosmos-sdk/cosmos.bank.v1beta1"},
+			{Name: "MarsMars", Path: "mars.mars", FullName: "MarsMarsMars", FullPath: "mars/mars/mars.mars"},
+		},
+		PackageName: "mars-js",
+	}
+
+	require.NoError(t, templateVuexRoot.Write(dir, "", data))
+
+	b, err := os.ReadFile(filepath.Join(dir, "package.json"))
+	require.NoError(t, err)
+
+	var pkg struct {
+		SideEffects bool              `json:"sideEffects"`
+		Exports     map[string]string `json:"exports"`
+	}
+	require.NoError(t, json.Unmarshal(b, &pkg))
+	require.False(t, pkg.SideEffects)
+	require.Equal(t, map[string]string{
+		".": "./index.js",
+		"./cosmos/cosmos-sdk/cosmos.bank.v1beta1": "./cosmos/cosmos-sdk/cosmos.bank.v1beta1/index.js",
+		"./mars/mars/mars.mars":                   "./mars/mars/mars.mars/index.js",
+		"./wallet":                                "./wallet.js",
+		"./*":                                     "./*",
+	}, pkg.Exports)
+
+	b, err = os.ReadFile(filepath.Join(dir, "index.ts"))
+	require.NoError(t, err)
+	require.Contains(t, string(b), "export * from './wallet'")
+	require.Contains(t, string(b), "export const MarsMarsMars = /* #__PURE__ */ load(MarsMarsMarsModule, 'mars.mars')")
+}
+
+func TestTemplateVuexRootWallet(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, templateVuexRoot.Write(dir, "", struct {
+		Modules     []struct{ FullName, FullPath, Path string }
+		PackageName string
+	}{PackageName: "mars-js"}))
+
+	b, err := os.ReadFile(filepath.Join(dir, "wallet.ts"))
+	require.NoError(t, err)
+	for _, adapter := range []string{"keplrAdapter", "leapAdapter", "mnemonicAdapter", "readOnlyAdapter"} {
+		require.Contains(t, string(b), "export function "+adapter+"(")
+	}
+}
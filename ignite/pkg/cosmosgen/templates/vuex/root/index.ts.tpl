@@ -1,10 +1,17 @@
 // THIS FILE IS GENERATED AUTOMATICALLY. DO NOT MODIFY.
 
-{{ range .Modules }}import {{ .FullName }} from './{{ .FullPath }}'
+{{ range .Modules }}import {{ .FullName }}Module from './{{ .FullPath }}'
+{{ end }}
+
+export * from './wallet'
+
+// every module has its own export so bundlers can drop the unused ones,
+// modules can also be imported from their own entry point.
+{{ range .Modules }}export const {{ .FullName }} = /* #__PURE__ */ load({{ .FullName }}Module, '{{ .Path }}')
 {{ end }}
 
 export default { 
-  {{ range .Modules }}{{ .FullName }}: load({{ .FullName }}, '{{ .Path }}'),
+  {{ range .Modules }}{{ .FullName }},
   {{ end }}
 }
 
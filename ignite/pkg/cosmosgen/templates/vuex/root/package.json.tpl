@@ -11,6 +11,13 @@
     }
   ],
   "main": "index.js",
+  "sideEffects": false,
+  "exports": {
+    {{ range .Modules }}"./{{ .FullPath }}": "./{{ .FullPath }}/index.js",
+    {{ end }}"./wallet": "./wallet.js",
+    "./*": "./*",
+    ".": "./index.js"
+  },
   "publishConfig": {
     "access": "public"
   }
@@ -0,0 +1,86 @@
+// THIS FILE IS GENERATED AUTOMATICALLY. DO NOT MODIFY.
+
+import { OfflineSigner, DirectSecp256k1HdWallet } from "@cosmjs/proto-signing";
+import { Secp256k1HdWallet } from "@cosmjs/launchpad";
+
+// SignMode is the mode used to sign transactions. Messages of custom modules can only be
+// signed in direct mode unless amino converters are registered for them.
+export type SignMode = "direct" | "amino";
+
+// WalletAdapter connects a wallet to a chain and returns the signer to pass to the tx clients.
+export interface WalletAdapter {
+  name: string;
+  readOnly: boolean;
+  connect(chainId: string): Promise<WalletConnection>;
+}
+
+export interface WalletConnection {
+  address: string;
+  signer?: OfflineSigner;
+}
+
+interface BrowserWallet {
+  enable(chainId: string): Promise<void>;
+  getOfflineSigner(chainId: string): OfflineSigner;
+  getOfflineSignerOnlyAmino(chainId: string): OfflineSigner;
+}
+
+export const MissingExtensionError = (name: string) => new Error(`${name} extension is not installed`);
+
+async function connectSigner(signer: OfflineSigner): Promise<WalletConnection> {
+  const [account] = await signer.getAccounts();
+  return { address: account.address, signer };
+}
+
+function browserWalletAdapter(name: string, key: string, signMode: SignMode): WalletAdapter {
+  return {
+    name,
+    readOnly: false,
+    async connect(chainId: string) {
+      const wallet: BrowserWallet | undefined = (<any>globalThis)[key];
+      if (!wallet) throw MissingExtensionError(name);
+
+      await wallet.enable(chainId);
+      const signer = signMode === "amino"
+        ? wallet.getOfflineSignerOnlyAmino(chainId)
+        : wallet.getOfflineSigner(chainId);
+      return connectSigner(signer);
+    },
+  };
+}
+
+// keplrAdapter connects the Keplr browser extension.
+export function keplrAdapter(signMode: SignMode = "direct"): WalletAdapter {
+  return browserWalletAdapter("Keplr", "keplr", signMode);
+}
+
+// leapAdapter connects the Leap browser extension.
+export function leapAdapter(signMode: SignMode = "direct"): WalletAdapter {
+  return browserWalletAdapter("Leap", "leap", signMode);
+}
+
+// mnemonicAdapter signs with the first account derived from a mnemonic, it is meant for
+// scripts and tests, mnemonics must never be used in frontends.
+export function mnemonicAdapter(mnemonic: string, prefix: string, signMode: SignMode = "direct"): WalletAdapter {
+  return {
+    name: "Mnemonic",
+    readOnly: false,
+    async connect() {
+      const signer = signMode === "amino"
+        ? await Secp256k1HdWallet.fromMnemonic(mnemonic, { prefix })
+        : await DirectSecp256k1HdWallet.fromMnemonic(mnemonic, { prefix });
+      return connectSigner(<OfflineSigner>signer);
+    },
+  };
+}
+
+// readOnlyAdapter watches an address without being able to sign transactions.
+export function readOnlyAdapter(address: string): WalletAdapter {
+  return {
+    name: "Read-only",
+    readOnly: true,
+    async connect() {
+      return { address };
+    },
+  };
+}
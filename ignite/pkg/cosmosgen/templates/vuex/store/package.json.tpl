@@ -12,6 +12,7 @@
     }
   ],
   "main": "index.js",
+  "sideEffects": false,
   "publishConfig": {
     "access": "public"
   }
@@ -0,0 +1,33 @@
+package cosmosutil
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Fee returns the fees paid by a tx with gasLimit at gasPrices, e.g. 0.025stake. Amounts are
+// rounded up like the fees computed by the SDK from gas prices.
+func Fee(gasPrices string, gasLimit uint64) (sdk.Coins, error) {
+	prices, err := sdk.ParseDecCoins(gasPrices)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := sdk.NewDec(int64(gasLimit))
+	fee := make(sdk.Coins, len(prices))
+	for i, price := range prices {
+		fee[i] = sdk.NewCoin(price.Denom, price.Amount.Mul(limit).Ceil().RoundInt())
+	}
+	return fee.Sort(), nil
+}
+
+// SubtractFee returns what is left of balances once fee is paid, it fails when balances don't
+// cover fee.
+func SubtractFee(balances, fee sdk.Coins) (sdk.Coins, error) {
+	left, hasNeg := balances.SafeSub(fee)
+	if hasNeg {
+		return nil, fmt.Errorf("balances %s do not cover the fee %s", balances, fee)
+	}
+	return left, nil
+}
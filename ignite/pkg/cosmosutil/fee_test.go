@@ -0,0 +1,38 @@
+package cosmosutil_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite-hq/cli/ignite/pkg/cosmosutil"
+)
+
+func TestFee(t *testing.T) {
+	fee, err := cosmosutil.Fee("0.025stake,0.0001token", 200000)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 5000), sdk.NewInt64Coin("token", 20)), fee)
+
+	fee, err = cosmosutil.Fee("0.3stake", 5)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 2)), fee)
+
+	fee, err = cosmosutil.Fee("", 200000)
+	require.NoError(t, err)
+	require.True(t, fee.IsZero())
+
+	_, err = cosmosutil.Fee("stake", 200000)
+	require.Error(t, err)
+}
+
+func TestSubtractFee(t *testing.T) {
+	balances := sdk.NewCoins(sdk.NewInt64Coin("stake", 10000), sdk.NewInt64Coin("token", 50))
+
+	left, err := cosmosutil.SubtractFee(balances, sdk.NewCoins(sdk.NewInt64Coin("stake", 5000)))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 5000), sdk.NewInt64Coin("token", 50)), left)
+
+	_, err = cosmosutil.SubtractFee(balances, sdk.NewCoins(sdk.NewInt64Coin("stake", 20000)))
+	require.Error(t, err)
+}
@@ -0,0 +1,77 @@
+package cosmosutil
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+// TxHashFormat is the encoding of a transaction hash.
+type TxHashFormat int
+
+const (
+	// TxHashUpper is the upper case hex encoding used by Tendermint, e.g. "4F2A...".
+	TxHashUpper TxHashFormat = iota
+
+	// TxHashLower is the lower case hex encoding, e.g. "4f2a...".
+	TxHashLower
+
+	// TxHashPrefixed is the lower case hex encoding prefixed with 0x, e.g. "0x4f2a...".
+	TxHashPrefixed
+)
+
+// txHashSize is the size of a transaction hash in bytes.
+const txHashSize = 32
+
+// TxHash returns the hash of the raw bytes of a transaction as included in a block, in the
+// upper case hex encoding reported by the nodes. The hash is computed on the exact bytes
+// since a re-encoded transaction can have a different hash.
+func TxHash(tx []byte) string {
+	return strings.ToUpper(hex.EncodeToString(tmtypes.Tx(tx).Hash()))
+}
+
+// NormalizeTxHash returns hash in the upper case hex encoding reported by the nodes.
+// hash can be in any of the supported formats.
+func NormalizeTxHash(hash string) (string, error) {
+	return FormatTxHash(hash, TxHashUpper)
+}
+
+// FormatTxHash converts hash in any of the supported formats to format.
+func FormatTxHash(hash string, format TxHashFormat) (string, error) {
+	raw := strings.TrimPrefix(strings.TrimPrefix(strings.TrimSpace(hash), "0x"), "0X")
+
+	b, err := hex.DecodeString(raw)
+	if err != nil {
+		return "", fmt.Errorf("invalid tx hash %q: %w", hash, err)
+	}
+	if len(b) != txHashSize {
+		return "", fmt.Errorf("invalid tx hash %q: expected %d bytes, got %d", hash, txHashSize, len(b))
+	}
+
+	lower := hex.EncodeToString(b)
+	switch format {
+	case TxHashUpper:
+		return strings.ToUpper(lower), nil
+	case TxHashLower:
+		return lower, nil
+	case TxHashPrefixed:
+		return "0x" + lower, nil
+	default:
+		return "", fmt.Errorf("unknown tx hash format %d", format)
+	}
+}
+
+// VerifyTxHash checks that hash, in any of the supported formats, is the hash of the raw
+// bytes of the transaction.
+func VerifyTxHash(tx []byte, hash string) error {
+	normalized, err := NormalizeTxHash(hash)
+	if err != nil {
+		return err
+	}
+	if computed := TxHash(tx); computed != normalized {
+		return fmt.Errorf("tx hash mismatch: computed %s, got %s", computed, normalized)
+	}
+	return nil
+}
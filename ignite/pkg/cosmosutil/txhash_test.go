@@ -0,0 +1,51 @@
+package cosmosutil_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite-hq/cli/ignite/pkg/cosmosutil"
+)
+
+const (
+	tx          = "raw tx bytes"
+	txHashUpper = "A1C6BA6C238F39E5E6FAD32A09C20D6BDF26C1969299278420B6A4D83CD3F975"
+)
+
+func TestTxHash(t *testing.T) {
+	hash := cosmosutil.TxHash([]byte(tx))
+	require.Equal(t, txHashUpper, hash)
+	require.NoError(t, cosmosutil.VerifyTxHash([]byte(tx), hash))
+	require.Error(t, cosmosutil.VerifyTxHash([]byte("other tx"), hash))
+}
+
+func TestFormatTxHash(t *testing.T) {
+	lower := "a1c6ba6c238f39e5e6fad32a09c20d6bdf26c1969299278420b6a4d83cd3f975"
+
+	tests := []struct {
+		name    string
+		hash    string
+		format  cosmosutil.TxHashFormat
+		want    string
+		wantErr bool
+	}{
+		{name: "upper to lower", hash: txHashUpper, format: cosmosutil.TxHashLower, want: lower},
+		{name: "lower to upper", hash: lower, format: cosmosutil.TxHashUpper, want: txHashUpper},
+		{name: "upper to prefixed", hash: txHashUpper, format: cosmosutil.TxHashPrefixed, want: "0x" + lower},
+		{name: "prefixed to upper", hash: "0x" + lower, format: cosmosutil.TxHashUpper, want: txHashUpper},
+		{name: "invalid hex", hash: "0xzz", format: cosmosutil.TxHashUpper, wantErr: true},
+		{name: "invalid size", hash: "abcd", format: cosmosutil.TxHashUpper, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := cosmosutil.FormatTxHash(tt.hash, tt.format)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,88 @@
+// Package cosmovisor stages the binaries of the upgrades of a chain for cosmovisor.
+package cosmovisor
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	// EnvDaemonHome is the env var of the home of the node run by cosmovisor.
+	EnvDaemonHome = "DAEMON_HOME"
+
+	// EnvDaemonName is the env var of the name of the binary of the chain.
+	EnvDaemonName = "DAEMON_NAME"
+)
+
+// UpgradeBinaryPath returns the path of the binary of an upgrade where cosmovisor switches to
+// when the chain reaches the upgrade height.
+func UpgradeBinaryPath(home, daemonName, upgradeName string) string {
+	return filepath.Join(home, "cosmovisor", "upgrades", upgradeName, "bin", daemonName)
+}
+
+// Stage downloads the binary of an upgrade, checks its sha256 checksum and installs it in the
+// upgrades of cosmovisor. It returns the path of the installed binary.
+// A binary is only installed when its checksum is valid.
+func Stage(ctx context.Context, home, daemonName, upgradeName, binaryURL, checksum string) (string, error) {
+	path := UpgradeBinaryPath(home, daemonName, upgradeName)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return "", err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), daemonName)
+	if err != nil {
+		return "", err
+	}
+	defer os.Remove(tmp.Name())
+
+	sum, err := download(ctx, binaryURL, tmp)
+	if cerr := tmp.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return "", err
+	}
+	if !strings.EqualFold(sum, checksum) {
+		return "", fmt.Errorf("checksum of %s is %s, expected %s", binaryURL, sum, checksum)
+	}
+
+	if err := os.Chmod(tmp.Name(), 0755); err != nil {
+		return "", err
+	}
+	return path, os.Rename(tmp.Name(), path)
+}
+
+// Checksum downloads the binary at binaryURL and returns its hex encoded sha256 checksum.
+func Checksum(ctx context.Context, binaryURL string) (string, error) {
+	return download(ctx, binaryURL, io.Discard)
+}
+
+// download writes the content at url in w and returns its hex encoded sha256 checksum.
+func download(ctx context.Context, url string, w io.Writer) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return "", err
+	}
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("cannot download %s: %s", url, res.Status)
+	}
+
+	h := sha256.New()
+	if _, err := io.Copy(io.MultiWriter(w, h), res.Body); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
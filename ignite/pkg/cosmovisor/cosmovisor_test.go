@@ -0,0 +1,44 @@
+package cosmovisor_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite-hq/cli/ignite/pkg/cosmovisor"
+)
+
+// binaryChecksum is a valid checksum that does not match the served binary.
+const binaryChecksum = "6b5bb3e1f7ae8c6a3f2a4c6bd4b5ae5e1e9bcb1d9a0df1ec2c6a1ba2b8a6a3b0"
+
+func TestStage(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("marsd"))
+	}))
+	defer srv.Close()
+
+	ctx := context.Background()
+	checksum, err := cosmovisor.Checksum(ctx, srv.URL)
+	require.NoError(t, err)
+
+	home := t.TempDir()
+	path, err := cosmovisor.Stage(ctx, home, "marsd", "v2", srv.URL, checksum)
+	require.NoError(t, err)
+	require.Equal(t, filepath.Join(home, "cosmovisor/upgrades/v2/bin/marsd"), path)
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, "marsd", string(data))
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	require.NotZero(t, info.Mode()&0100)
+
+	_, err = cosmovisor.Stage(ctx, home, "marsd", "v3", srv.URL, binaryChecksum)
+	require.Error(t, err)
+	require.NoFileExists(t, cosmovisor.UpgradeBinaryPath(home, "marsd", "v3"))
+}
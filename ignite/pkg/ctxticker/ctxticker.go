@@ -30,3 +30,28 @@ func DoNow(ctx context.Context, d time.Duration, fn func() error) error {
 	}
 	return Do(ctx, d, fn)
 }
+
+// DoNowOn is same as DoNow except it also calls fn each time trigger receives, without waiting
+// for the next tick.
+func DoNowOn(ctx context.Context, d time.Duration, trigger <-chan struct{}, fn func() error) error {
+	if err := fn(); err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(d)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+
+		case <-ticker.C:
+		case <-trigger:
+		}
+
+		if err := fn(); err != nil {
+			return err
+		}
+	}
+}
@@ -39,3 +39,24 @@ func TestDoNowError(t *testing.T) {
 
 	require.True(t, callCount >= 3)
 }
+
+func TestDoNowOn(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	trigger := make(chan struct{})
+	calls := make(chan struct{})
+	done := make(chan error)
+
+	go func() {
+		done <- DoNowOn(ctx, time.Hour, trigger, func() error {
+			calls <- struct{}{}
+			return nil
+		})
+	}()
+
+	<-calls
+	trigger <- struct{}{}
+	<-calls
+	cancel()
+
+	require.ErrorIs(t, <-done, context.Canceled)
+}
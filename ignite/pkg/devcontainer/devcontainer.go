@@ -0,0 +1,150 @@
+// Package devcontainer generates the configs of the development containers of a chain,
+// the devcontainer.json of VS Code Dev Containers and Codespaces and the .gitpod.yml of Gitpod.
+package devcontainer
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/goccy/go-yaml"
+)
+
+const (
+	// DevcontainerPath is the path of the devcontainer.json in the chain's repository.
+	DevcontainerPath = ".devcontainer/devcontainer.json"
+
+	// GitpodPath is the path of the Gitpod config in the chain's repository.
+	GitpodPath = ".gitpod.yml"
+)
+
+// Port is a port exposed by the container.
+type Port struct {
+	// Name describes the service listening on the port, e.g. rpc.
+	Name   string
+	Number int
+}
+
+// ParsePort returns the port of a host address, e.g. 0.0.0.0:26657 or :4500.
+func ParsePort(name, address string) (Port, error) {
+	_, port, err := net.SplitHostPort(strings.TrimPrefix(strings.TrimPrefix(address, "tcp://"), "http://"))
+	if err != nil {
+		return Port{}, fmt.Errorf("%s address %q: %w", name, address, err)
+	}
+	number, err := strconv.Atoi(port)
+	if err != nil {
+		return Port{}, fmt.Errorf("%s address %q: invalid port", name, address)
+	}
+	return Port{Name: name, Number: number}, nil
+}
+
+// Environment describes the tools installed in the container and its exposed ports.
+type Environment struct {
+	// Name is the name of the chain.
+	Name string
+
+	GoVersion     string
+	NodeVersion   string
+	IgniteVersion string
+	Ports         []Port
+}
+
+// installIgnite returns the command that installs Ignite CLI in the container.
+func (e Environment) installIgnite() string {
+	version := ""
+	if e.IgniteVersion != "" {
+		version = "@" + e.IgniteVersion
+	}
+	return fmt.Sprintf("curl https://get.ignite.com/cli%s! | bash", version)
+}
+
+type devcontainer struct {
+	Name              string                       `json:"name"`
+	Image             string                       `json:"image"`
+	Features          map[string]map[string]string `json:"features"`
+	ForwardPorts      []int                        `json:"forwardPorts"`
+	PortsAttributes   map[string]portAttributes    `json:"portsAttributes"`
+	PostCreateCommand string                       `json:"postCreateCommand"`
+}
+
+type portAttributes struct {
+	Label         string `json:"label"`
+	OnAutoForward string `json:"onAutoForward"`
+}
+
+// Devcontainer returns the devcontainer.json of the environment.
+func Devcontainer(e Environment) ([]byte, error) {
+	d := devcontainer{
+		Name:  e.Name,
+		Image: "mcr.microsoft.com/devcontainers/go:" + e.GoVersion,
+		Features: map[string]map[string]string{
+			"ghcr.io/devcontainers/features/node:1": {"version": e.NodeVersion},
+		},
+		ForwardPorts:      []int{},
+		PortsAttributes:   make(map[string]portAttributes),
+		PostCreateCommand: e.installIgnite(),
+	}
+	for _, port := range e.Ports {
+		d.ForwardPorts = append(d.ForwardPorts, port.Number)
+		d.PortsAttributes[strconv.Itoa(port.Number)] = portAttributes{
+			Label:         port.Name,
+			OnAutoForward: "silent",
+		}
+	}
+
+	data, err := json.MarshalIndent(d, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(data, '\n'), nil
+}
+
+type gitpod struct {
+	Image string       `yaml:"image"`
+	Tasks []gitpodTask `yaml:"tasks"`
+	Ports []gitpodPort `yaml:"ports"`
+}
+
+type gitpodTask struct {
+	Name    string `yaml:"name"`
+	Init    string `yaml:"init"`
+	Command string `yaml:"command"`
+}
+
+type gitpodPort struct {
+	Name       string `yaml:"name"`
+	Port       int    `yaml:"port"`
+	OnOpen     string `yaml:"onOpen"`
+	Visibility string `yaml:"visibility"`
+}
+
+// Gitpod returns the .gitpod.yml of the environment.
+func Gitpod(e Environment) ([]byte, error) {
+	g := gitpod{
+		Image: "gitpod/workspace-full",
+		Tasks: []gitpodTask{
+			{
+				Name: "install",
+				Init: strings.Join([]string{
+					fmt.Sprintf("go install golang.org/dl/go%[1]s@latest && go%[1]s download", e.GoVersion),
+					fmt.Sprintf("nvm install %[1]s && nvm alias default %[1]s", e.NodeVersion),
+					e.installIgnite(),
+				}, " && "),
+				// the Go version of the chain is installed in the SDK directory and is
+				// used instead of the Go of the image.
+				Command: fmt.Sprintf("export PATH=$HOME/sdk/go%s/bin:$PATH", e.GoVersion),
+			},
+		},
+	}
+	for _, port := range e.Ports {
+		g.Ports = append(g.Ports, gitpodPort{
+			Name:       port.Name,
+			Port:       port.Number,
+			OnOpen:     "ignore",
+			Visibility: "public",
+		})
+	}
+	return yaml.Marshal(g)
+}
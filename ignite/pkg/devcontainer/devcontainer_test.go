@@ -0,0 +1,81 @@
+package devcontainer_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/goccy/go-yaml"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite-hq/cli/ignite/pkg/devcontainer"
+)
+
+func TestParsePort(t *testing.T) {
+	cases := []struct {
+		address string
+		port    int
+		err     bool
+	}{
+		{address: "0.0.0.0:26657", port: 26657},
+		{address: ":4500", port: 4500},
+		{address: "tcp://0.0.0.0:26656", port: 26656},
+		{address: "localhost", err: true},
+		{address: "localhost:rpc", err: true},
+	}
+	for _, tt := range cases {
+		t.Run(tt.address, func(t *testing.T) {
+			port, err := devcontainer.ParsePort("rpc", tt.address)
+			if tt.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, devcontainer.Port{Name: "rpc", Number: tt.port}, port)
+		})
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	env := devcontainer.Environment{
+		Name:          "mars",
+		GoVersion:     "1.18",
+		NodeVersion:   "16",
+		IgniteVersion: "v0.22.0",
+		Ports: []devcontainer.Port{
+			{Name: "rpc", Number: 26657},
+			{Name: "faucet", Number: 4500},
+		},
+	}
+
+	data, err := devcontainer.Devcontainer(env)
+	require.NoError(t, err)
+	var d struct {
+		Image             string                       `json:"image"`
+		ForwardPorts      []int                        `json:"forwardPorts"`
+		PortsAttributes   map[string]map[string]string `json:"portsAttributes"`
+		PostCreateCommand string                       `json:"postCreateCommand"`
+	}
+	require.NoError(t, json.Unmarshal(data, &d))
+	require.Equal(t, "mcr.microsoft.com/devcontainers/go:1.18", d.Image)
+	require.Equal(t, []int{26657, 4500}, d.ForwardPorts)
+	require.Equal(t, "faucet", d.PortsAttributes["4500"]["label"])
+	require.Equal(t, "curl https://get.ignite.com/cli@v0.22.0! | bash", d.PostCreateCommand)
+
+	data, err = devcontainer.Gitpod(env)
+	require.NoError(t, err)
+	var g struct {
+		Tasks []struct {
+			Init string `yaml:"init"`
+		} `yaml:"tasks"`
+		Ports []struct {
+			Name string `yaml:"name"`
+			Port int    `yaml:"port"`
+		} `yaml:"ports"`
+	}
+	require.NoError(t, yaml.Unmarshal(data, &g))
+	require.Len(t, g.Ports, 2)
+	require.Equal(t, "rpc", g.Ports[0].Name)
+	require.Equal(t, 26657, g.Ports[0].Port)
+	require.Contains(t, g.Tasks[0].Init, "go1.18 download")
+	require.Contains(t, g.Tasks[0].Init, "nvm install 16")
+}
@@ -7,6 +7,8 @@ import (
 	"sync"
 
 	"github.com/gookit/color"
+
+	"github.com/ignite-hq/cli/ignite/pkg/i18n"
 )
 
 type (
@@ -83,7 +85,7 @@ func (e Event) IsOngoing() bool {
 
 // Text returns the text state of event.
 func (e Event) Text() string {
-	text := e.Description
+	text := i18n.T(e.Description)
 	if e.IsOngoing() {
 		text = fmt.Sprintf("%s...", e.Description)
 	}
@@ -3,6 +3,7 @@ package goanalysis
 
 import (
 	"errors"
+	"go/ast"
 	"go/parser"
 	"go/token"
 	"os"
@@ -96,3 +97,29 @@ func FindImportedPackages(name string) (map[string]string, error) {
 
 	return packages, nil
 }
+
+// FindInterfaces finds the exported interfaces declared in a Go file and returns their names
+// in the order of declaration.
+func FindInterfaces(name string) ([]string, error) {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, name, nil, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var interfaces []string
+	for _, decl := range f.Decls {
+		genDecl, ok := decl.(*ast.GenDecl)
+		if !ok || genDecl.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range genDecl.Specs {
+			typeSpec := spec.(*ast.TypeSpec)
+			if _, ok := typeSpec.Type.(*ast.InterfaceType); ok && typeSpec.Name.IsExported() {
+				interfaces = append(interfaces, typeSpec.Name.Name)
+			}
+		}
+	}
+
+	return interfaces, nil
+}
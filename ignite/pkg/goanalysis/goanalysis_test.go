@@ -181,3 +181,24 @@ func TestFindImportedPackages(t *testing.T) {
 		"queryonlymodmoduletypes":  "github.com/tendermint/testchain/x/queryonlymod/types",
 	})
 }
+
+func TestFindInterfaces(t *testing.T) {
+	tmpFile := filepath.Join(t.TempDir(), "expected_keepers.go")
+	err := os.WriteFile(tmpFile, []byte(`package types
+
+type AccountKeeper interface {
+	GetAccount(ctx sdk.Context, addr sdk.AccAddress) types.AccountI
+}
+
+type (
+	BankKeeper interface{}
+	hooks      interface{}
+	Params     struct{}
+)
+`), 0644)
+	require.NoError(t, err)
+
+	interfaces, err := goanalysis.FindInterfaces(tmpFile)
+	require.NoError(t, err)
+	require.Equal(t, []string{"AccountKeeper", "BankKeeper"}, interfaces)
+}
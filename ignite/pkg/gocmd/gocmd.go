@@ -20,6 +20,9 @@ const (
 	// CommandBuild represents go "build" command.
 	CommandBuild = "build"
 
+	// CommandTest represents go "test" command.
+	CommandTest = "test"
+
 	// CommandMod represents go "mod" command.
 	CommandMod = "mod"
 
@@ -34,12 +37,14 @@ const (
 	FlagMod              = "-mod"
 	FlagModValueReadOnly = "readonly"
 	FlagLdflags          = "-ldflags"
+	FlagTags             = "-tags"
 	FlagOut              = "-o"
 )
 
 const (
-	EnvGOOS   = "GOOS"
-	EnvGOARCH = "GOARCH"
+	EnvGOOS       = "GOOS"
+	EnvGOARCH     = "GOARCH"
+	EnvCGOEnabled = "CGO_ENABLED"
 )
 
 // Name returns the name of Go binary to use.
@@ -100,11 +105,27 @@ func InstallAll(ctx context.Context, path string, flags []string, options ...exe
 	return exec.Exec(ctx, command, append(options, exec.StepOption(step.Workdir(path)))...)
 }
 
+// Test runs go test on the packages of path with options.
+func Test(ctx context.Context, path string, flags, packages []string, options ...exec.Option) error {
+	command := []string{
+		Name(),
+		CommandTest,
+	}
+	command = append(command, flags...)
+	command = append(command, packages...)
+	return exec.Exec(ctx, command, append(options, exec.StepOption(step.Workdir(path)))...)
+}
+
 // Ldflags returns a combined ldflags set from flags.
 func Ldflags(flags ...string) string {
 	return strings.Join(flags, " ")
 }
 
+// Tags returns a combined build tags set from tags.
+func Tags(tags ...string) string {
+	return strings.Join(tags, ",")
+}
+
 // BuildTarget builds a GOOS:GOARCH pair.
 func BuildTarget(goos, goarch string) string {
 	return fmt.Sprintf("%s:%s", goos, goarch)
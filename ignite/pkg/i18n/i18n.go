@@ -0,0 +1,91 @@
+// Package i18n translates the messages of Ignite CLI to the language set in IGNITE_LANG.
+//
+// Messages are translated by their English text, messages without a translation are kept
+// in English:
+//
+//	fmt.Println(i18n.T("Initializing the app..."))
+//	fmt.Println(i18n.T("Tendermint node: %s", address))
+package i18n
+
+import (
+	"embed"
+	"fmt"
+	"os"
+	"path"
+	"strings"
+	"sync"
+
+	"github.com/goccy/go-yaml"
+)
+
+// EnvLang is the environment variable that sets the language of the messages, e.g. zh or
+// zh_CN.UTF-8.
+const EnvLang = "IGNITE_LANG"
+
+// LangEnglish is the language of the messages without catalog.
+const LangEnglish = "en"
+
+//go:embed catalogs/*.yml
+var catalogs embed.FS
+
+var (
+	loadOnce sync.Once
+	catalog  map[string]string
+)
+
+// Languages returns the languages with a catalog of translations, English excepted.
+func Languages() []string {
+	entries, _ := catalogs.ReadDir("catalogs")
+	var langs []string
+	for _, entry := range entries {
+		langs = append(langs, strings.TrimSuffix(entry.Name(), path.Ext(entry.Name())))
+	}
+	return langs
+}
+
+// Lang returns the language of the messages, it is English when IGNITE_LANG is not set or
+// when the language has no catalog.
+func Lang() string {
+	lang := strings.ToLower(os.Getenv(EnvLang))
+	if i := strings.IndexAny(lang, "_-."); i >= 0 {
+		lang = lang[:i]
+	}
+	for _, l := range Languages() {
+		if l == lang {
+			return lang
+		}
+	}
+	return LangEnglish
+}
+
+// Catalog returns the translations of the messages in lang by their English text.
+func Catalog(lang string) (map[string]string, error) {
+	messages := make(map[string]string)
+	if lang == LangEnglish {
+		return messages, nil
+	}
+	data, err := catalogs.ReadFile(path.Join("catalogs", lang+".yml"))
+	if err != nil {
+		return nil, err
+	}
+	if err := yaml.Unmarshal(data, &messages); err != nil {
+		return nil, fmt.Errorf("catalog %s: %w", lang, err)
+	}
+	return messages, nil
+}
+
+// T returns the translation of msg in the language of the messages, msg is returned as is
+// when it has no translation. msg is formatted with args like fmt.Sprintf when there are args.
+func T(msg string, args ...interface{}) string {
+	loadOnce.Do(func() {
+		// a catalog that cannot be read keeps the messages in English.
+		catalog, _ = Catalog(Lang())
+	})
+	if translation, ok := catalog[msg]; ok && translation != "" {
+		msg = translation
+	}
+	if len(args) == 0 {
+		return msg
+	}
+	return fmt.Sprintf(msg, args...)
+}
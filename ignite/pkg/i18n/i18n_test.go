@@ -0,0 +1,58 @@
+package i18n
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLang(t *testing.T) {
+	tests := []struct {
+		env  string
+		want string
+	}{
+		{"", LangEnglish},
+		{"zh", "zh"},
+		{"zh_CN.UTF-8", "zh"},
+		{"ES-ar", "es"},
+		{"ko.UTF-8", "ko"},
+		{"fr_FR", LangEnglish},
+	}
+	for _, tt := range tests {
+		t.Run(tt.env, func(t *testing.T) {
+			t.Setenv(EnvLang, tt.env)
+			require.Equal(t, tt.want, Lang())
+		})
+	}
+}
+
+func TestCatalogs(t *testing.T) {
+	require.ElementsMatch(t, []string{"es", "ko", "zh"}, Languages())
+
+	for _, lang := range Languages() {
+		t.Run(lang, func(t *testing.T) {
+			messages, err := Catalog(lang)
+			require.NoError(t, err)
+			require.NotEmpty(t, messages)
+
+			// translations keep the verbs of the messages to be formatted the same way.
+			for msg, translation := range messages {
+				require.NotEmpty(t, translation, msg)
+				require.Equal(t, strings.Count(msg, "%"), strings.Count(translation, "%"), msg)
+			}
+		})
+	}
+}
+
+func TestT(t *testing.T) {
+	messages, err := Catalog("es")
+	require.NoError(t, err)
+	catalog = messages
+	loadOnce.Do(func() {})
+
+	require.Equal(t, "Inicializa tu cadena", T("Initialize your chain"))
+	require.Equal(t, "Nodo de Tendermint: localhost:26657", T("Tendermint node: %s", "localhost:26657"))
+	require.Equal(t, "Untranslated localhost", T("Untranslated %s", "localhost"))
+	require.Equal(t, "Untranslated %s", T("Untranslated %s"))
+}
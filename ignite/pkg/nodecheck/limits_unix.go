@@ -0,0 +1,14 @@
+//go:build !windows
+
+package nodecheck
+
+import "syscall"
+
+// OpenFilesLimit returns the maximum number of open files of the process.
+func OpenFilesLimit() (uint64, error) {
+	var limit syscall.Rlimit
+	if err := syscall.Getrlimit(syscall.RLIMIT_NOFILE, &limit); err != nil {
+		return 0, err
+	}
+	return uint64(limit.Cur), nil
+}
@@ -0,0 +1,6 @@
+package nodecheck
+
+// OpenFilesLimit returns the maximum number of open files of the process.
+func OpenFilesLimit() (uint64, error) {
+	return 0, ErrNotSupported
+}
@@ -0,0 +1,182 @@
+// Package nodecheck checks that a machine keeps up with running a validator node.
+package nodecheck
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+)
+
+const (
+	// iopsBlockSize is the size of the blocks written to measure the disk IOPS.
+	iopsBlockSize = 4096
+
+	// iopsDuration is the time spent writing blocks to measure the disk IOPS.
+	iopsDuration = 2 * time.Second
+
+	// PeerTimeout is the time to connect to a peer.
+	PeerTimeout = 5 * time.Second
+
+	gib = 1 << 30
+)
+
+// ErrNotSupported is returned when a check is not supported on the OS.
+var ErrNotSupported = errors.New("not supported on this system")
+
+// Requirements are the minimum resources of a machine running a validator node.
+type Requirements struct {
+	// RAM is the available memory in bytes.
+	RAM uint64
+
+	// DiskIOPS is the number of synced writes per second of the disk of the node data.
+	DiskIOPS float64
+
+	// OpenFiles is the maximum number of open files of a process.
+	OpenFiles uint64
+}
+
+// DefaultRequirements are the requirements of a testnet validator node.
+var DefaultRequirements = Requirements{
+	RAM:       4 * gib,
+	DiskIOPS:  500,
+	OpenFiles: 65535,
+}
+
+// Result is the result of a check.
+type Result struct {
+	// Name of the check.
+	Name string
+
+	// Value is the measured value.
+	Value string
+
+	// Err is not nil when the check fails or the requirement is not met.
+	Err error
+}
+
+// OK returns true when the requirement is met.
+func (r Result) OK() bool {
+	return r.Err == nil
+}
+
+// CheckRAM checks the available memory.
+func CheckRAM(req Requirements) Result {
+	r := Result{Name: "Available RAM"}
+	available, err := AvailableRAM()
+	if err != nil {
+		r.Err = err
+		return r
+	}
+	r.Value = formatBytes(available)
+	if available < req.RAM {
+		r.Err = fmt.Errorf("at least %s is required", formatBytes(req.RAM))
+	}
+	return r
+}
+
+// CheckOpenFiles checks the limit of open files.
+func CheckOpenFiles(req Requirements) Result {
+	r := Result{Name: "Open files limit"}
+	limit, err := OpenFilesLimit()
+	if err != nil {
+		r.Err = err
+		return r
+	}
+	r.Value = fmt.Sprint(limit)
+	if limit < req.OpenFiles {
+		r.Err = fmt.Errorf("at least %d is required, raise it with ulimit -n", req.OpenFiles)
+	}
+	return r
+}
+
+// CheckDiskIOPS checks the IOPS of the disk of dir.
+func CheckDiskIOPS(req Requirements, dir string) Result {
+	r := Result{Name: "Disk IOPS"}
+	iops, err := DiskIOPS(dir, iopsDuration)
+	if err != nil {
+		r.Err = err
+		return r
+	}
+	r.Value = fmt.Sprintf("%.0f", iops)
+	if iops < req.DiskIOPS {
+		r.Err = fmt.Errorf("at least %.0f is required, use an SSD", req.DiskIOPS)
+	}
+	return r
+}
+
+// CheckPeer checks that the peer is reachable.
+func CheckPeer(ctx context.Context, peer string) Result {
+	r := Result{Name: "Peer " + peer}
+	start := time.Now()
+	if err := DialPeer(ctx, peer, PeerTimeout); err != nil {
+		r.Err = err
+		return r
+	}
+	r.Value = time.Since(start).Round(time.Millisecond).String()
+	return r
+}
+
+// DiskIOPS measures the number of synced writes of blocks per second in dir during d.
+func DiskIOPS(dir string, d time.Duration) (float64, error) {
+	f, err := os.CreateTemp(dir, "iops")
+	if err != nil {
+		return 0, err
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+
+	var (
+		block = make([]byte, iopsBlockSize)
+		ops   int
+		start = time.Now()
+	)
+	for time.Since(start) < d {
+		if _, err := f.Write(block); err != nil {
+			return 0, err
+		}
+		if err := f.Sync(); err != nil {
+			return 0, err
+		}
+		ops++
+	}
+	return float64(ops) / time.Since(start).Seconds(), nil
+}
+
+// DialPeer connects to a peer address formatted as id@host:port, the host of peers behind an
+// HTTP tunnel is an HTTP URL.
+func DialPeer(ctx context.Context, peer string, timeout time.Duration) error {
+	address := peer
+	if i := strings.Index(peer, "@"); i != -1 {
+		address = peer[i+1:]
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	if strings.HasPrefix(address, "http://") || strings.HasPrefix(address, "https://") {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, address, nil)
+		if err != nil {
+			return err
+		}
+		res, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return err
+		}
+		return res.Body.Close()
+	}
+
+	conn, err := (&net.Dialer{}).DialContext(ctx, "tcp", address)
+	if err != nil {
+		return err
+	}
+	return conn.Close()
+}
+
+func formatBytes(b uint64) string {
+	return fmt.Sprintf("%.1f GiB", float64(b)/gib)
+}
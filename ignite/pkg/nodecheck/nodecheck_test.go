@@ -0,0 +1,51 @@
+package nodecheck
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiskIOPS(t *testing.T) {
+	iops, err := DiskIOPS(t.TempDir(), 100*time.Millisecond)
+	require.NoError(t, err)
+	require.Greater(t, iops, float64(0))
+}
+
+func TestDialPeer(t *testing.T) {
+	ctx := context.Background()
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			conn.Close()
+		}
+	}()
+	require.NoError(t, DialPeer(ctx, "nodeid@"+l.Addr().String(), time.Second))
+
+	addr := l.Addr().String()
+	require.NoError(t, l.Close())
+	require.Error(t, DialPeer(ctx, "nodeid@"+addr, time.Second))
+
+	srv := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+	defer srv.Close()
+	require.NoError(t, DialPeer(ctx, "nodeid@"+srv.URL, time.Second))
+}
+
+func TestCheckOpenFiles(t *testing.T) {
+	r := CheckOpenFiles(Requirements{OpenFiles: 1})
+	require.True(t, r.OK(), r.Err)
+
+	r = CheckOpenFiles(Requirements{OpenFiles: 1 << 62})
+	require.False(t, r.OK())
+}
@@ -0,0 +1,35 @@
+package nodecheck
+
+import (
+	"bufio"
+	"errors"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// AvailableRAM returns the memory available for new processes in bytes.
+func AvailableRAM() (uint64, error) {
+	f, err := os.Open("/proc/meminfo")
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+
+	return parseMemInfo(bufio.NewScanner(f))
+}
+
+// parseMemInfo returns the available memory of /proc/meminfo, its values are in KiB.
+func parseMemInfo(s *bufio.Scanner) (uint64, error) {
+	for s.Scan() {
+		fields := strings.Fields(s.Text())
+		if len(fields) >= 2 && fields[0] == "MemAvailable:" {
+			kib, err := strconv.ParseUint(fields[1], 10, 64)
+			return kib * 1024, err
+		}
+	}
+	if err := s.Err(); err != nil {
+		return 0, err
+	}
+	return 0, errors.New("available memory not found in /proc/meminfo")
+}
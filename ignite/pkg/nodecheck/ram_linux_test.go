@@ -0,0 +1,21 @@
+package nodecheck
+
+import (
+	"bufio"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseMemInfo(t *testing.T) {
+	available, err := parseMemInfo(bufio.NewScanner(strings.NewReader(`MemTotal:       16303200 kB
+MemFree:          884964 kB
+MemAvailable:    8388608 kB
+`)))
+	require.NoError(t, err)
+	require.Equal(t, uint64(8*gib), available)
+
+	_, err = parseMemInfo(bufio.NewScanner(strings.NewReader("MemTotal: 16303200 kB\n")))
+	require.Error(t, err)
+}
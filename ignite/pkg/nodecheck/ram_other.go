@@ -0,0 +1,8 @@
+//go:build !linux
+
+package nodecheck
+
+// AvailableRAM returns the memory available for new processes in bytes.
+func AvailableRAM() (uint64, error) {
+	return 0, ErrNotSupported
+}
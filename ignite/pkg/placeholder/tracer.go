@@ -1,18 +1,23 @@
 package placeholder
 
 import (
+	"sort"
 	"strings"
 )
 
 type iterableStringSet map[string]struct{}
 
+// Iterate calls f for each element of the set in lexical order until it returns false.
 func (set iterableStringSet) Iterate(f func(i int, element string) bool) {
-	i := 0
+	keys := make([]string, 0, len(set))
 	for key := range set {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for i, key := range keys {
 		if !f(i, key) {
 			return
 		}
-		i++
 	}
 }
 
@@ -97,3 +97,11 @@ func TestReplaceAll(t *testing.T) {
 		})
 	}
 }
+
+func TestErrSorted(t *testing.T) {
+	tr := New()
+	for _, placeholder := range []string{"#three", "#one", "#two"} {
+		tr.Replace("", placeholder, "")
+	}
+	require.EqualError(t, tr.Err(), "missing placeholders: #one, #three, #two")
+}
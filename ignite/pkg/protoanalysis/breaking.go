@@ -0,0 +1,374 @@
+package protoanalysis
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/emicklei/proto"
+)
+
+// BreakingChangeKind describes the kind of compatibility that a breaking change affects.
+type BreakingChangeKind string
+
+const (
+	// BreakingChangeWire is a change that breaks the binary encoding of messages
+	// or the signature of RPC funcs.
+	BreakingChangeWire BreakingChangeKind = "wire"
+
+	// BreakingChangeJSON is a change that breaks the JSON encoding of messages.
+	BreakingChangeJSON BreakingChangeKind = "json"
+)
+
+// BreakingChange is a backward incompatible change made between two versions of proto files.
+type BreakingChange struct {
+	// Kind of the compatibility broken by the change.
+	Kind BreakingChangeKind
+
+	// Path of the proto file relative to the proto dir. When the breaking change is
+	// caused by a removal, path points to the file in the previous version.
+	Path string
+
+	// Description explains the change in a human readable way.
+	Description string
+}
+
+func (c BreakingChange) String() string {
+	return fmt.Sprintf("%s: %s (%s)", c.Path, c.Description, c.Kind)
+}
+
+// DetectBreakingChanges compares the proto files under previousPath with the ones under
+// currentPath and returns the list of changes that break wire or JSON compatibility.
+func DetectBreakingChanges(ctx context.Context, previousPath, currentPath string) ([]BreakingChange, error) {
+	previous, err := parseDefinitions(ctx, previousPath)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := parseDefinitions(ctx, currentPath)
+	if err != nil {
+		return nil, err
+	}
+
+	var changes []BreakingChange
+
+	for name, pm := range previous.messages {
+		cm, ok := current.messages[name]
+		if !ok {
+			changes = append(changes, BreakingChange{
+				Kind:        BreakingChangeWire,
+				Path:        pm.path,
+				Description: fmt.Sprintf("message %q was removed", name),
+			})
+			continue
+		}
+		changes = append(changes, compareMessages(name, pm, cm)...)
+	}
+
+	for name, pe := range previous.enums {
+		ce, ok := current.enums[name]
+		if !ok {
+			changes = append(changes, BreakingChange{
+				Kind:        BreakingChangeWire,
+				Path:        pe.path,
+				Description: fmt.Sprintf("enum %q was removed", name),
+			})
+			continue
+		}
+		changes = append(changes, compareEnums(name, pe, ce)...)
+	}
+
+	for name, ps := range previous.services {
+		cs, ok := current.services[name]
+		if !ok {
+			changes = append(changes, BreakingChange{
+				Kind:        BreakingChangeWire,
+				Path:        ps.path,
+				Description: fmt.Sprintf("service %q was removed", name),
+			})
+			continue
+		}
+		changes = append(changes, compareServices(name, ps, cs)...)
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		if changes[i].Path != changes[j].Path {
+			return changes[i].Path < changes[j].Path
+		}
+		return changes[i].Description < changes[j].Description
+	})
+
+	return changes, nil
+}
+
+func compareMessages(name string, previous, current messageDefinition) (changes []BreakingChange) {
+	for fieldName, pf := range previous.fields {
+		cf, ok := current.fields[fieldName]
+		if !ok {
+			// a field with a new name but the same number is a rename.
+			if renamed, ok := current.fieldByNumber(pf.number); ok {
+				changes = append(changes, BreakingChange{
+					Kind:        BreakingChangeJSON,
+					Path:        current.path,
+					Description: fmt.Sprintf("field %d of message %q was renamed from %q to %q", pf.number, name, fieldName, renamed.name),
+				})
+				if renamed.typ != pf.typ {
+					changes = append(changes, fieldTypeChange(name, current.path, renamed.name, pf, renamed))
+				}
+				continue
+			}
+
+			kind := BreakingChangeJSON
+			description := fmt.Sprintf("field %q of message %q was removed", fieldName, name)
+			if !current.isReserved(pf.number) {
+				kind = BreakingChangeWire
+				description += fmt.Sprintf(" without reserving its number %d", pf.number)
+			}
+			changes = append(changes, BreakingChange{
+				Kind:        kind,
+				Path:        current.path,
+				Description: description,
+			})
+			continue
+		}
+
+		if pf.number != cf.number {
+			changes = append(changes, BreakingChange{
+				Kind:        BreakingChangeWire,
+				Path:        current.path,
+				Description: fmt.Sprintf("field %q of message %q changed its number from %d to %d", fieldName, name, pf.number, cf.number),
+			})
+		}
+		if pf.typ != cf.typ {
+			changes = append(changes, fieldTypeChange(name, current.path, fieldName, pf, cf))
+		}
+	}
+
+	return changes
+}
+
+func fieldTypeChange(message, path, fieldName string, previous, current fieldDefinition) BreakingChange {
+	return BreakingChange{
+		Kind:        BreakingChangeWire,
+		Path:        path,
+		Description: fmt.Sprintf("field %q of message %q changed its type from %q to %q", fieldName, message, previous.typ, current.typ),
+	}
+}
+
+func compareEnums(name string, previous, current enumDefinition) (changes []BreakingChange) {
+	for valueName, pv := range previous.values {
+		cv, ok := current.values[valueName]
+		if !ok {
+			changes = append(changes, BreakingChange{
+				Kind:        BreakingChangeJSON,
+				Path:        current.path,
+				Description: fmt.Sprintf("value %q of enum %q was removed", valueName, name),
+			})
+			continue
+		}
+		if pv != cv {
+			changes = append(changes, BreakingChange{
+				Kind:        BreakingChangeWire,
+				Path:        current.path,
+				Description: fmt.Sprintf("value %q of enum %q changed its number from %d to %d", valueName, name, pv, cv),
+			})
+		}
+	}
+
+	return changes
+}
+
+func compareServices(name string, previous, current serviceDefinition) (changes []BreakingChange) {
+	for rpcName, pr := range previous.rpcs {
+		cr, ok := current.rpcs[rpcName]
+		if !ok {
+			changes = append(changes, BreakingChange{
+				Kind:        BreakingChangeWire,
+				Path:        current.path,
+				Description: fmt.Sprintf("rpc %q of service %q was removed", rpcName, name),
+			})
+			continue
+		}
+		if pr != cr {
+			changes = append(changes, BreakingChange{
+				Kind:        BreakingChangeWire,
+				Path:        current.path,
+				Description: fmt.Sprintf("rpc %q of service %q changed its signature from %q to %q", rpcName, name, pr, cr),
+			})
+		}
+	}
+
+	return changes
+}
+
+// definitions holds the proto definitions that are relevant to detect breaking changes,
+// indexed by their fully qualified names.
+type definitions struct {
+	messages map[string]messageDefinition
+	enums    map[string]enumDefinition
+	services map[string]serviceDefinition
+}
+
+type messageDefinition struct {
+	path          string
+	fields        map[string]fieldDefinition
+	reservedRange []proto.Range
+}
+
+type fieldDefinition struct {
+	name   string
+	number int
+	typ    string
+}
+
+type enumDefinition struct {
+	path   string
+	values map[string]int
+}
+
+type serviceDefinition struct {
+	path string
+	rpcs map[string]string
+}
+
+func (m messageDefinition) fieldByNumber(number int) (fieldDefinition, bool) {
+	for _, f := range m.fields {
+		if f.number == number {
+			return f, true
+		}
+	}
+	return fieldDefinition{}, false
+}
+
+func (m messageDefinition) isReserved(number int) bool {
+	for _, r := range m.reservedRange {
+		if number >= r.From && (r.Max || number <= r.To) {
+			return true
+		}
+	}
+	return false
+}
+
+func parseDefinitions(ctx context.Context, path string) (definitions, error) {
+	defs := definitions{
+		messages: make(map[string]messageDefinition),
+		enums:    make(map[string]enumDefinition),
+		services: make(map[string]serviceDefinition),
+	}
+
+	pkgs, err := parse(ctx, path, protoFilePattern)
+	if err != nil {
+		return definitions{}, err
+	}
+
+	for _, p := range pkgs {
+		for _, f := range p.files {
+			relPath, err := filepath.Rel(path, f.path)
+			if err != nil {
+				return definitions{}, err
+			}
+
+			for _, m := range f.messages {
+				defs.messages[qualifiedName(p.name, m, m.Name)] = buildMessageDefinition(relPath, m)
+			}
+
+			for _, e := range f.enums {
+				ed := enumDefinition{
+					path:   relPath,
+					values: make(map[string]int),
+				}
+				for _, elem := range e.Elements {
+					if value, ok := elem.(*proto.EnumField); ok {
+						ed.values[value.Name] = value.Integer
+					}
+				}
+				defs.enums[qualifiedName(p.name, e, e.Name)] = ed
+			}
+
+			for _, s := range f.services {
+				sd := serviceDefinition{
+					path: relPath,
+					rpcs: make(map[string]string),
+				}
+				for _, elem := range s.Elements {
+					if rpc, ok := elem.(*proto.RPC); ok {
+						sd.rpcs[rpc.Name] = rpcSignature(rpc)
+					}
+				}
+				defs.services[fmt.Sprintf("%s.%s", p.name, s.Name)] = sd
+			}
+		}
+	}
+
+	return defs, nil
+}
+
+func buildMessageDefinition(path string, m *proto.Message) messageDefinition {
+	md := messageDefinition{
+		path:   path,
+		fields: make(map[string]fieldDefinition),
+	}
+
+	var addElements func(elems []proto.Visitee)
+	addElements = func(elems []proto.Visitee) {
+		for _, elem := range elems {
+			switch e := elem.(type) {
+			case *proto.NormalField:
+				typ := e.Type
+				if e.Repeated {
+					typ = "repeated " + typ
+				}
+				md.fields[e.Name] = fieldDefinition{e.Name, e.Sequence, typ}
+			case *proto.MapField:
+				md.fields[e.Name] = fieldDefinition{e.Name, e.Sequence, fmt.Sprintf("map<%s, %s>", e.KeyType, e.Type)}
+			case *proto.OneOfField:
+				md.fields[e.Name] = fieldDefinition{e.Name, e.Sequence, e.Type}
+			case *proto.Oneof:
+				addElements(e.Elements)
+			case *proto.Reserved:
+				md.reservedRange = append(md.reservedRange, e.Ranges...)
+			}
+		}
+	}
+	addElements(m.Elements)
+
+	return md
+}
+
+// qualifiedName returns the fully qualified name of a proto type by prefixing
+// it with the names of its parent messages and the package name.
+func qualifiedName(pkgName string, v proto.Visitee, name string) string {
+	names := []string{name}
+
+	var parent proto.Visitee
+	switch t := v.(type) {
+	case *proto.Message:
+		parent = t.Parent
+	case *proto.Enum:
+		parent = t.Parent
+	}
+
+	for {
+		m, ok := parent.(*proto.Message)
+		if !ok {
+			break
+		}
+		names = append([]string{m.Name}, names...)
+		parent = m.Parent
+	}
+
+	return fmt.Sprintf("%s.%s", pkgName, strings.Join(names, "."))
+}
+
+func rpcSignature(rpc *proto.RPC) string {
+	request, response := rpc.RequestType, rpc.ReturnsType
+	if rpc.StreamsRequest {
+		request = "stream " + request
+	}
+	if rpc.StreamsReturns {
+		response = "stream " + response
+	}
+	return fmt.Sprintf("(%s) returns (%s)", request, response)
+}
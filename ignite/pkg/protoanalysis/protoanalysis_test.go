@@ -287,3 +287,26 @@ func TestLiquidity(t *testing.T) {
 
 	require.Equal(t, expected, packages)
 }
+
+func TestDetectBreakingChanges(t *testing.T) {
+	changes, err := DetectBreakingChanges(
+		context.Background(),
+		"testdata/breaking/previous",
+		"testdata/breaking/current",
+	)
+	require.NoError(t, err)
+
+	expected := []BreakingChange{
+		{Kind: BreakingChangeWire, Path: "mars.proto", Description: `field "author" of message "mars.mars.Post.Meta" changed its number from 1 to 2`},
+		{Kind: BreakingChangeWire, Path: "mars.proto", Description: `field "body" of message "mars.mars.Post" was removed without reserving its number 3`},
+		{Kind: BreakingChangeJSON, Path: "mars.proto", Description: `field "legacy" of message "mars.mars.Post" was removed`},
+		{Kind: BreakingChangeWire, Path: "mars.proto", Description: `field "meta" of message "mars.mars.Post" changed its type from "Meta" to "string"`},
+		{Kind: BreakingChangeJSON, Path: "mars.proto", Description: `field 2 of message "mars.mars.Post" was renamed from "title" to "name"`},
+		{Kind: BreakingChangeWire, Path: "mars.proto", Description: `message "mars.mars.MsgDeletePost" was removed`},
+		{Kind: BreakingChangeWire, Path: "mars.proto", Description: `message "mars.mars.MsgDeletePostResponse" was removed`},
+		{Kind: BreakingChangeWire, Path: "mars.proto", Description: `rpc "DeletePost" of service "mars.mars.Msg" was removed`},
+		{Kind: BreakingChangeWire, Path: "mars.proto", Description: `value "STATUS_ACTIVE" of enum "mars.mars.Status" changed its number from 1 to 2`},
+		{Kind: BreakingChangeJSON, Path: "mars.proto", Description: `value "STATUS_ARCHIVED" of enum "mars.mars.Status" was removed`},
+	}
+	require.Equal(t, expected, changes)
+}
@@ -51,6 +51,16 @@ func (c Config) UpdatePath(path Path) error {
 	return errors.Wrap(ErrPathCannotBeFound, path.ID)
 }
 
+func (c Config) UpdateChain(chain Chain) error {
+	for i, ch := range c.Chains {
+		if ch.ID == chain.ID {
+			c.Chains[i] = chain
+			return nil
+		}
+	}
+	return errors.Wrap(ErrChainCannotBeFound, chain.ID)
+}
+
 type Chain struct {
 	ID            string `json:"id" yaml:"id"`
 	Account       string `json:"account" yaml:"account"`
@@ -78,6 +88,11 @@ type PathEnd struct {
 	AckHeight    int64  `json:"ack_height" yaml:"ack_height,omitempty"`
 }
 
+// SetPath sets the path of the relayer config, e.g. to use a config apart from the one of the user.
+func SetPath(path string) {
+	configPath = path
+}
+
 func Get() (Config, error) {
 	c := Config{}
 	if err := confile.New(confile.DefaultYAMLEncodingCreator, configPath).Load(&c); err != nil {
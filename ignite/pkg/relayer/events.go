@@ -0,0 +1,92 @@
+package relayer
+
+import (
+	"context"
+	"fmt"
+
+	rpchttp "github.com/tendermint/tendermint/rpc/client/http"
+
+	"github.com/ignite-hq/cli/ignite/pkg/cosmosclient/query"
+	relayerconf "github.com/ignite-hq/cli/ignite/pkg/relayer/config"
+)
+
+// subscribePacketEvents subscribes to the events of the chains of path that need a relay: the
+// packets sent and the acknowledgements written on its channels. The returned channel receives
+// until ctx is canceled when such events happen, the events that happen during a relay round
+// are coalesced into a single receive. On error, the clients of the chains already subscribed
+// to are stopped.
+func subscribePacketEvents(ctx context.Context, conf relayerconf.Config, path relayerconf.Path) (
+	_ <-chan struct{}, err error) {
+	var (
+		trigger = make(chan struct{}, 1)
+		clients []*rpchttp.HTTP
+	)
+	stop := func() {
+		for _, client := range clients {
+			_ = client.Stop()
+		}
+	}
+	defer func() {
+		if err != nil {
+			stop()
+		}
+	}()
+
+	for _, end := range []relayerconf.PathEnd{path.Src, path.Dst} {
+		if end.ChannelID == "" {
+			return nil, fmt.Errorf("path %q is not linked", path.ID)
+		}
+
+		chain, err := conf.ChainByID(end.ChainID)
+		if err != nil {
+			return nil, err
+		}
+
+		client, err := rpchttp.New(fixRPCAddress(chain.RPCAddress), "/websocket")
+		if err != nil {
+			return nil, err
+		}
+		if err := client.Start(); err != nil {
+			return nil, err
+		}
+		clients = append(clients, client)
+
+		queries := []query.Query{
+			query.Tx().And(
+				query.EventType("send_packet").AttrEq("packet_src_port", end.PortID),
+				query.EventType("send_packet").AttrEq("packet_src_channel", end.ChannelID),
+			),
+			query.Tx().And(
+				query.EventType("write_acknowledgement").AttrEq("packet_dst_port", end.PortID),
+				query.EventType("write_acknowledgement").AttrEq("packet_dst_channel", end.ChannelID),
+			),
+		}
+		for _, q := range queries {
+			s, err := q.Build()
+			if err != nil {
+				return nil, err
+			}
+
+			events, err := client.Subscribe(ctx, "ignite-relayer-"+path.ID, s)
+			if err != nil {
+				return nil, err
+			}
+
+			go func() {
+				for range events {
+					select {
+					case trigger <- struct{}{}:
+					default:
+					}
+				}
+			}()
+		}
+	}
+
+	go func() {
+		<-ctx.Done()
+		stop()
+	}()
+
+	return trigger, nil
+}
@@ -0,0 +1,95 @@
+package relayer
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	rpcserver "github.com/tendermint/tendermint/rpc/jsonrpc/server"
+	rpctypes "github.com/tendermint/tendermint/rpc/jsonrpc/types"
+
+	relayerconf "github.com/ignite-hq/cli/ignite/pkg/relayer/config"
+)
+
+// newEventsNode starts a node that accepts the subscriptions to its events, connections
+// receives when a client connects to its websocket and disconnections when it disconnects.
+func newEventsNode(t *testing.T) (addr string, connections, disconnections <-chan struct{}) {
+	var (
+		connected    = make(chan struct{}, 10)
+		disconnected = make(chan struct{}, 10)
+	)
+	subscribe := func(*rpctypes.Context, string) (*ctypes.ResultSubscribe, error) {
+		return &ctypes.ResultSubscribe{}, nil
+	}
+	wm := rpcserver.NewWebsocketManager(
+		map[string]*rpcserver.RPCFunc{"subscribe": rpcserver.NewWSRPCFunc(subscribe, "query")},
+		rpcserver.OnDisconnect(func(string) { disconnected <- struct{}{} }),
+	)
+	node := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		connected <- struct{}{}
+		wm.WebsocketHandler(w, r)
+	}))
+	t.Cleanup(node.Close)
+	return node.URL, connected, disconnected
+}
+
+func TestSubscribePacketEvents(t *testing.T) {
+	var (
+		marsAddr, marsConnections, marsDisconnections    = newEventsNode(t)
+		venusAddr, venusConnections, venusDisconnections = newEventsNode(t)
+		conf                                             = relayerconf.Config{
+			Chains: []relayerconf.Chain{
+				{ID: "mars", RPCAddress: marsAddr},
+				{ID: "venus", RPCAddress: venusAddr},
+			},
+		}
+		path = relayerconf.Path{
+			ID:  "mars-venus",
+			Src: relayerconf.PathEnd{ChainID: "mars", ChannelID: "channel-0", PortID: "transfer"},
+			Dst: relayerconf.PathEnd{ChainID: "venus", ChannelID: "channel-1", PortID: "transfer"},
+		}
+	)
+	received := func(t *testing.T, c <-chan struct{}) {
+		t.Helper()
+		select {
+		case <-c:
+		case <-time.After(5 * time.Second):
+			t.Fatal("node not called")
+		}
+	}
+
+	t.Run("not linked", func(t *testing.T) {
+		notLinked := path
+		notLinked.Dst.ChannelID = ""
+		_, err := subscribePacketEvents(context.Background(), conf, notLinked)
+		require.EqualError(t, err, `path "mars-venus" is not linked`)
+	})
+
+	t.Run("clients stopped on error", func(t *testing.T) {
+		// the client of mars is started before the chain of venus is found missing.
+		missingDst := path
+		missingDst.Dst.ChainID = "jupiter"
+		_, err := subscribePacketEvents(context.Background(), conf, missingDst)
+		require.ErrorIs(t, err, relayerconf.ErrChainCannotBeFound)
+
+		received(t, marsConnections)
+		received(t, marsDisconnections)
+	})
+
+	t.Run("clients stopped with ctx", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		trigger, err := subscribePacketEvents(ctx, conf, path)
+		require.NoError(t, err)
+		require.NotNil(t, trigger)
+
+		received(t, marsConnections)
+		received(t, venusConnections)
+		cancel()
+		received(t, marsDisconnections)
+		received(t, venusDisconnections)
+	})
+}
@@ -0,0 +1,251 @@
+package relayer
+
+import (
+	"context"
+	"time"
+
+	clienttypes "github.com/cosmos/ibc-go/v2/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v2/modules/core/03-connection/types"
+	ibctmtypes "github.com/cosmos/ibc-go/v2/modules/light-clients/07-tendermint/types"
+	"github.com/gogo/protobuf/proto"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/ignite-hq/cli/ignite/pkg/cosmosclient"
+	relayerconf "github.com/ignite-hq/cli/ignite/pkg/relayer/config"
+)
+
+// validatorsPerPage is the max. number of validators returned per page by Tendermint RPC.
+const validatorsPerPage = 100
+
+// ClientRefresh holds the result of a client refresh.
+type ClientRefresh struct {
+	PathID string
+
+	// ChainID is the id of the chain that hosts the client.
+	ChainID string
+
+	ClientID string
+
+	// ExpiresAt is the time the client expires at if it is not updated.
+	ExpiresAt time.Time
+
+	// Updated is true when the client is updated by the refresh.
+	Updated bool
+
+	// Error is set when the client cannot be checked or updated.
+	Error error
+}
+
+// RefreshClients updates the clients of linked paths that expire in less than threshold,
+// so channels on the paths don't stop working when no packets are relayed for a long time.
+// All paths are refreshed when no ids are given. A client that cannot be refreshed doesn't
+// cause an error, instead its refresh holds the error.
+func (r Relayer) RefreshClients(ctx context.Context, threshold time.Duration, pathIDs ...string) (
+	[]ClientRefresh, error) {
+	conf, err := relayerconf.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	paths := conf.Paths
+	if len(pathIDs) > 0 {
+		paths = nil
+		for _, id := range pathIDs {
+			path, err := conf.PathByID(id)
+			if err != nil {
+				return nil, err
+			}
+			paths = append(paths, path)
+		}
+	}
+
+	var refreshes []ClientRefresh
+	for _, path := range paths {
+		// the path is not linked yet.
+		if path.Src.ConnectionID == "" || path.Dst.ConnectionID == "" {
+			continue
+		}
+
+		for _, ends := range [][2]relayerconf.PathEnd{{path.Src, path.Dst}, {path.Dst, path.Src}} {
+			refresh := ClientRefresh{
+				PathID:  path.ID,
+				ChainID: ends[0].ChainID,
+			}
+			refresh.Error = r.refreshPathEndClient(ctx, conf, ends[0], ends[1], threshold, &refresh)
+
+			refreshes = append(refreshes, refresh)
+		}
+	}
+
+	return refreshes, nil
+}
+
+// refreshPathEndClient updates the client used by the end of a path when it expires in less
+// than threshold, the counterparty end is the one of the chain tracked by the client.
+func (r Relayer) refreshPathEndClient(
+	ctx context.Context,
+	conf relayerconf.Config,
+	end,
+	counterpartyEnd relayerconf.PathEnd,
+	threshold time.Duration,
+	refresh *ClientRefresh,
+) error {
+	host, err := conf.ChainByID(end.ChainID)
+	if err != nil {
+		return err
+	}
+
+	counterparty, err := conf.ChainByID(counterpartyEnd.ChainID)
+	if err != nil {
+		return err
+	}
+
+	return r.refreshClient(ctx, host, counterparty, end.ConnectionID, threshold, refresh)
+}
+
+// refreshClient updates the client used by the connection on the host chain with a header
+// from the counterparty chain when the client expires in less than threshold.
+func (r Relayer) refreshClient(
+	ctx context.Context,
+	host,
+	counterparty relayerconf.Chain,
+	connectionID string,
+	threshold time.Duration,
+	refresh *ClientRefresh,
+) error {
+	options := []cosmosclient.Option{
+		cosmosclient.WithNodeAddress(host.RPCAddress),
+		cosmosclient.WithAddressPrefix(host.AddressPrefix),
+		cosmosclient.WithAccountRegistry(r.ca),
+		cosmosclient.WithGasPrices(host.GasPrice),
+	}
+	// the gas of the update is estimated when the chain has no gas limit.
+	if host.GasLimit > 0 {
+		options = append(options, cosmosclient.WithMaxGas(uint64(host.GasLimit)))
+	}
+
+	hostClient, err := cosmosclient.New(ctx, options...)
+	if err != nil {
+		return err
+	}
+
+	connection, err := connectiontypes.NewQueryClient(hostClient.Context()).Connection(ctx,
+		&connectiontypes.QueryConnectionRequest{ConnectionId: connectionID},
+	)
+	if err != nil {
+		return err
+	}
+	refresh.ClientID = connection.Connection.ClientId
+
+	clientQuery := clienttypes.NewQueryClient(hostClient.Context())
+
+	clientStateRes, err := clientQuery.ClientState(ctx, &clienttypes.QueryClientStateRequest{
+		ClientId: refresh.ClientID,
+	})
+	if err != nil {
+		return err
+	}
+
+	var clientState ibctmtypes.ClientState
+	if err := proto.Unmarshal(clientStateRes.ClientState.Value, &clientState); err != nil {
+		return err
+	}
+
+	consensusStateRes, err := clientQuery.ConsensusState(ctx, &clienttypes.QueryConsensusStateRequest{
+		ClientId:       refresh.ClientID,
+		RevisionNumber: clientState.LatestHeight.RevisionNumber,
+		RevisionHeight: clientState.LatestHeight.RevisionHeight,
+	})
+	if err != nil {
+		return err
+	}
+
+	var consensusState ibctmtypes.ConsensusState
+	if err := proto.Unmarshal(consensusStateRes.ConsensusState.Value, &consensusState); err != nil {
+		return err
+	}
+
+	refresh.ExpiresAt = consensusState.Timestamp.Add(clientState.TrustingPeriod)
+	if time.Until(refresh.ExpiresAt) > threshold {
+		return nil
+	}
+
+	header, err := r.header(ctx, counterparty, clientState.LatestHeight)
+	if err != nil {
+		return err
+	}
+
+	signer, err := hostClient.Account(host.Account)
+	if err != nil {
+		return err
+	}
+
+	msg, err := clienttypes.NewMsgUpdateClient(refresh.ClientID, header, signer.Address(host.AddressPrefix))
+	if err != nil {
+		return err
+	}
+
+	if _, err := hostClient.BroadcastTx(host.Account, msg); err != nil {
+		return err
+	}
+
+	refresh.Updated = true
+	refresh.ExpiresAt = header.GetTime().Add(clientState.TrustingPeriod)
+
+	return nil
+}
+
+// header builds a header of the latest block of the chain that can be verified by a client
+// trusting the chain at trustedHeight.
+func (r Relayer) header(ctx context.Context, chain relayerconf.Chain, trustedHeight clienttypes.Height) (
+	*ibctmtypes.Header, error) {
+	client, err := cosmosclient.New(ctx, cosmosclient.WithNodeAddress(chain.RPCAddress))
+	if err != nil {
+		return nil, err
+	}
+
+	commit, err := client.RPC.Commit(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	validators, err := validatorSet(ctx, client, commit.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	// the consensus state at the trusted height holds the hash of the next validators.
+	trustedValidators, err := validatorSet(ctx, client, int64(trustedHeight.RevisionHeight)+1)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ibctmtypes.Header{
+		SignedHeader:      commit.SignedHeader.ToProto(),
+		ValidatorSet:      validators,
+		TrustedHeight:     trustedHeight,
+		TrustedValidators: trustedValidators,
+	}, nil
+}
+
+func validatorSet(ctx context.Context, client cosmosclient.Client, height int64) (*tmproto.ValidatorSet, error) {
+	var (
+		validators []*tmtypes.Validator
+		perPage    = validatorsPerPage
+	)
+
+	for page := 1; ; page++ {
+		res, err := client.RPC.Validators(ctx, &height, &page, &perPage)
+		if err != nil {
+			return nil, err
+		}
+
+		validators = append(validators, res.Validators...)
+		if len(res.Validators) == 0 || len(validators) >= res.Total {
+			break
+		}
+	}
+
+	return tmtypes.NewValidatorSet(validators).ToProto()
+}
@@ -0,0 +1,71 @@
+package relayer
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	relayerconf "github.com/ignite-hq/cli/ignite/pkg/relayer/config"
+)
+
+func TestRefreshClients(t *testing.T) {
+	// the nodes of the chains fail every query.
+	node := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "node is down", http.StatusInternalServerError)
+	}))
+	defer node.Close()
+
+	relayerconf.SetPath(filepath.Join(t.TempDir(), "config.yml"))
+	require.NoError(t, relayerconf.Save(relayerconf.Config{
+		Chains: []relayerconf.Chain{
+			{ID: "mars", RPCAddress: node.URL},
+			{ID: "venus", RPCAddress: node.URL},
+		},
+		Paths: []relayerconf.Path{
+			{
+				ID:  "mars-venus",
+				Src: relayerconf.PathEnd{ChainID: "mars", ConnectionID: "connection-0"},
+				Dst: relayerconf.PathEnd{ChainID: "venus", ConnectionID: "connection-1"},
+			},
+			{
+				ID:  "mars-earth",
+				Src: relayerconf.PathEnd{ChainID: "mars", ConnectionID: "connection-2"},
+				Dst: relayerconf.PathEnd{ChainID: "earth", ConnectionID: "connection-0"},
+			},
+			{
+				ID:  "venus-mars",
+				Src: relayerconf.PathEnd{ChainID: "venus"},
+				Dst: relayerconf.PathEnd{ChainID: "mars"},
+			},
+		},
+	}))
+
+	var r Relayer
+
+	// the clients that cannot be refreshed don't stop the refresh of the others and paths
+	// that are not linked are skipped.
+	refreshes, err := r.RefreshClients(context.Background(), time.Hour)
+	require.NoError(t, err)
+	require.Len(t, refreshes, 4)
+	for _, refresh := range refreshes {
+		require.Error(t, refresh.Error)
+		require.False(t, refresh.Updated)
+	}
+	require.Equal(t, "mars-venus", refreshes[0].PathID)
+	require.Equal(t, "mars", refreshes[0].ChainID)
+	require.Equal(t, "venus", refreshes[1].ChainID)
+	require.Equal(t, "mars-earth", refreshes[2].PathID)
+	require.ErrorIs(t, refreshes[3].Error, relayerconf.ErrChainCannotBeFound)
+
+	refreshes, err = r.RefreshClients(context.Background(), time.Hour, "venus-mars")
+	require.NoError(t, err)
+	require.Empty(t, refreshes)
+
+	_, err = r.RefreshClients(context.Background(), time.Hour, "earth-mars")
+	require.ErrorIs(t, err, relayerconf.ErrPathCannotBeFound)
+}
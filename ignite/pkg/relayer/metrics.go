@@ -0,0 +1,73 @@
+package relayer
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	relayerconf "github.com/ignite-hq/cli/ignite/pkg/relayer/config"
+	"github.com/ignite-hq/cli/ignite/pkg/xhttp"
+)
+
+const metricsNamespace = "ignite_relayer"
+
+var (
+	metricsRegistry = prometheus.NewRegistry()
+
+	packetHeight = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Name:      "packet_height",
+		Help:      "Height of the last relayed packet sent from the chain.",
+	}, []string{"path", "chain_id"})
+
+	ackHeight = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Name:      "ack_height",
+		Help:      "Height of the last relayed acknowledgement sent from the chain.",
+	}, []string{"path", "chain_id"})
+
+	lastRelayTime = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Name:      "last_relay_timestamp_seconds",
+		Help:      "Unix time of the last successful relay round of the path.",
+	}, []string{"path"})
+
+	relayErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "errors_total",
+		Help:      "Number of failed relay rounds of the path.",
+	}, []string{"path"})
+)
+
+func init() {
+	metricsRegistry.MustRegister(packetHeight, ackHeight, lastRelayTime, relayErrors)
+}
+
+// ServeMetrics serves the metrics of the paths relayed by Start in Prometheus format
+// at the /metrics endpoint of address until ctx is canceled.
+func ServeMetrics(ctx context.Context, address string) error {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.HandlerFor(metricsRegistry, promhttp.HandlerOpts{}))
+
+	return xhttp.Serve(ctx, &http.Server{
+		Addr:    address,
+		Handler: mux,
+	})
+}
+
+// observeRelay records the result of a relay round of the path with id.
+func observeRelay(id string, path relayerconf.Path, err error) {
+	if err != nil {
+		relayErrors.WithLabelValues(id).Inc()
+		return
+	}
+
+	packetHeight.WithLabelValues(id, path.Src.ChainID).Set(float64(path.Src.PacketHeight))
+	packetHeight.WithLabelValues(id, path.Dst.ChainID).Set(float64(path.Dst.PacketHeight))
+	ackHeight.WithLabelValues(id, path.Src.ChainID).Set(float64(path.Src.AckHeight))
+	ackHeight.WithLabelValues(id, path.Dst.ChainID).Set(float64(path.Dst.AckHeight))
+	lastRelayTime.WithLabelValues(id).Set(float64(time.Now().Unix()))
+}
@@ -0,0 +1,34 @@
+package relayer
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+
+	relayerconf "github.com/ignite-hq/cli/ignite/pkg/relayer/config"
+)
+
+func TestObserveRelay(t *testing.T) {
+	path := relayerconf.Path{
+		ID:  "mars-venus",
+		Src: relayerconf.PathEnd{ChainID: "mars", PacketHeight: 10, AckHeight: 8},
+		Dst: relayerconf.PathEnd{ChainID: "venus", PacketHeight: 12, AckHeight: 11},
+	}
+
+	observeRelay(path.ID, path, nil)
+	require.Equal(t, float64(10), testutil.ToFloat64(packetHeight.WithLabelValues(path.ID, "mars")))
+	require.Equal(t, float64(11), testutil.ToFloat64(ackHeight.WithLabelValues(path.ID, "venus")))
+	require.NotZero(t, testutil.ToFloat64(lastRelayTime.WithLabelValues(path.ID)))
+
+	// errors of the rounds are accumulated per path.
+	observeRelay(path.ID, relayerconf.Path{}, errors.New("relay failed"))
+	observeRelay(path.ID, relayerconf.Path{}, errors.New("relay failed"))
+	observeRelay("earth-venus", relayerconf.Path{}, errors.New("relay failed"))
+	require.Equal(t, float64(2), testutil.ToFloat64(relayErrors.WithLabelValues(path.ID)))
+	require.Equal(t, float64(1), testutil.ToFloat64(relayErrors.WithLabelValues("earth-venus")))
+
+	// heights are kept when a round fails.
+	require.Equal(t, float64(10), testutil.ToFloat64(packetHeight.WithLabelValues(path.ID, "mars")))
+}
@@ -3,6 +3,7 @@ package relayer
 import (
 	"context"
 	"fmt"
+	"io"
 	"strings"
 	"sync"
 	"time"
@@ -22,18 +23,38 @@ import (
 const (
 	ibcSetupGas   int64 = 2256000
 	relayDuration       = time.Second * 5
+
+	// maxRelayFailures is the number of consecutive failed relay rounds of a path after which
+	// Start stops and returns the error of the last round.
+	maxRelayFailures = 5
 )
 
 // Relayer is an IBC relayer.
 type Relayer struct {
-	ca cosmosaccount.Registry
+	ca  cosmosaccount.Registry
+	out io.Writer
+}
+
+// RelayerOption configures the relayer.
+type RelayerOption func(*Relayer)
+
+// WithOutput sets the output of the logs of the relayer, they are discarded by default.
+func WithOutput(out io.Writer) RelayerOption {
+	return func(r *Relayer) {
+		r.out = out
+	}
 }
 
 // New creates a new IBC relayer and uses ca to access accounts.
-func New(ca cosmosaccount.Registry) Relayer {
-	return Relayer{
-		ca: ca,
+func New(ca cosmosaccount.Registry, options ...RelayerOption) Relayer {
+	r := Relayer{
+		ca:  ca,
+		out: io.Discard,
 	}
+	for _, apply := range options {
+		apply(&r)
+	}
+	return r
 }
 
 // Link links all chains that has a path to each other.
@@ -70,25 +91,42 @@ func (r Relayer) Link(ctx context.Context, pathIDs ...string) error {
 	return nil
 }
 
-// Start relays packets for linked paths until ctx is canceled.
+// Start relays packets for linked paths until ctx is canceled. Packets are relayed when
+// the chains emit the events of sent packets and written acknowledgements.
 func (r Relayer) Start(ctx context.Context, pathIDs ...string) error {
 	conf, err := relayerconf.Get()
 	if err != nil {
 		return err
 	}
 
+	// all the paths are resolved before relaying any of them.
+	paths := make([]relayerconf.Path, len(pathIDs))
+	for i, id := range pathIDs {
+		if paths[i], err = conf.PathByID(id); err != nil {
+			return err
+		}
+	}
+
 	wg, ctx := errgroup.WithContext(ctx)
 	var m sync.Mutex // protects relayerconf.Path.
 
-	start := func(id string) error {
+	start := func(id string, failures *int) error {
 		path, err := conf.PathByID(id)
 		if err != nil {
 			return err
 		}
 
-		if path, err = r.call(ctx, conf, path, "start"); err != nil {
+		path, err = r.call(ctx, conf, path, "start")
+		observeRelay(id, path, err)
+		if err != nil {
+			// a failed round is retried by the next one.
+			*failures++
+			if *failures < maxRelayFailures {
+				return nil
+			}
 			return err
 		}
+		*failures = 0
 
 		m.Lock()
 		defer m.Unlock()
@@ -105,11 +143,21 @@ func (r Relayer) Start(ctx context.Context, pathIDs ...string) error {
 		return relayerconf.Save(conf)
 	}
 
-	for _, id := range pathIDs {
-		id := id
+	for _, path := range paths {
+		id := path.ID
+
+		// packets are relayed as soon as they are sent, the relay rounds on an interval
+		// catch up with the missed events. When the events of the chains cannot be
+		// subscribed to, trigger is nil and the path is only relayed on an interval.
+		trigger, err := subscribePacketEvents(ctx, conf, path)
+		if err != nil {
+			fmt.Fprintf(r.out, "⚠️  Relaying packets of path %q every %s, cannot subscribe to its events: %s\n",
+				id, relayDuration, err)
+		}
 
 		wg.Go(func() error {
-			return ctxticker.DoNow(ctx, relayDuration, func() error { return start(id) })
+			var failures int
+			return ctxticker.DoNowOn(ctx, relayDuration, trigger, func() error { return start(id, &failures) })
 		})
 	}
 
@@ -210,6 +258,43 @@ func (r Relayer) balance(ctx context.Context, rpcAddress, account, addressPrefix
 	return res.Balances, nil
 }
 
+// ResetPaths clears the connections and channels of the paths that relay packets of the chain
+// with chainID so they are linked again on the next Link with new clients. It is used when the
+// state of the chain is reset since its IBC state doesn't exist anymore. Only the generated ids
+// are cleared, the client ids configured for the chains are kept.
+func (r Relayer) ResetPaths(_ context.Context, chainID string, pathIDs ...string) error {
+	conf, err := relayerconf.Get()
+	if err != nil {
+		return err
+	}
+
+	for _, id := range pathIDs {
+		path, err := conf.PathByID(id)
+		if err != nil {
+			return err
+		}
+		if path.Src.ChainID != chainID && path.Dst.ChainID != chainID {
+			return fmt.Errorf("path %q does not relay packets of chain %q", id, chainID)
+		}
+
+		path.Src = resetPathEnd(path.Src)
+		path.Dst = resetPathEnd(path.Dst)
+		if err := conf.UpdatePath(path); err != nil {
+			return err
+		}
+	}
+
+	return relayerconf.Save(conf)
+}
+
+func resetPathEnd(end relayerconf.PathEnd) relayerconf.PathEnd {
+	return relayerconf.PathEnd{
+		ChainID: end.ChainID,
+		PortID:  end.PortID,
+		Version: end.Version,
+	}
+}
+
 // GetPath returns a path by its id.
 func (r Relayer) GetPath(_ context.Context, id string) (relayerconf.Path, error) {
 	conf, err := relayerconf.Get()
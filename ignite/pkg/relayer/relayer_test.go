@@ -0,0 +1,114 @@
+package relayer
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	relayerconf "github.com/ignite-hq/cli/ignite/pkg/relayer/config"
+)
+
+func TestResetPaths(t *testing.T) {
+	linked := func(id, src, dst string) relayerconf.Path {
+		return relayerconf.Path{
+			ID:       id,
+			Ordering: "UNORDERED",
+			Src: relayerconf.PathEnd{
+				ChainID:      src,
+				ConnectionID: "connection-0",
+				ChannelID:    "channel-0",
+				PortID:       "transfer",
+				Version:      "ics20-1",
+				PacketHeight: 10,
+				AckHeight:    12,
+			},
+			Dst: relayerconf.PathEnd{
+				ChainID:      dst,
+				ConnectionID: "connection-1",
+				ChannelID:    "channel-1",
+				PortID:       "transfer",
+				Version:      "ics20-1",
+				PacketHeight: 11,
+				AckHeight:    13,
+			},
+		}
+	}
+
+	relayerconf.SetPath(filepath.Join(t.TempDir(), "config.yml"))
+	require.NoError(t, relayerconf.Save(relayerconf.Config{
+		Chains: []relayerconf.Chain{
+			{ID: "mars", Account: "alice", ClientID: "07-tendermint-5"},
+			{ID: "venus", Account: "bob"},
+			{ID: "earth", Account: "carol"},
+		},
+		Paths: []relayerconf.Path{
+			linked("mars-venus", "mars", "venus"),
+			linked("venus-earth", "venus", "earth"),
+		},
+	}))
+
+	var r Relayer
+
+	require.NoError(t, r.ResetPaths(context.Background(), "mars", "mars-venus"))
+
+	conf, err := relayerconf.Get()
+	require.NoError(t, err)
+
+	path, err := conf.PathByID("mars-venus")
+	require.NoError(t, err)
+	require.Equal(t, relayerconf.Path{
+		ID:       "mars-venus",
+		Ordering: "UNORDERED",
+		Src:      relayerconf.PathEnd{ChainID: "mars", PortID: "transfer", Version: "ics20-1"},
+		Dst:      relayerconf.PathEnd{ChainID: "venus", PortID: "transfer", Version: "ics20-1"},
+	}, path)
+
+	// the other paths and the client id configured for the chain are kept.
+	path, err = conf.PathByID("venus-earth")
+	require.NoError(t, err)
+	require.Equal(t, linked("venus-earth", "venus", "earth"), path)
+
+	chain, err := conf.ChainByID("mars")
+	require.NoError(t, err)
+	require.Equal(t, "07-tendermint-5", chain.ClientID)
+
+	err = r.ResetPaths(context.Background(), "mars", "venus-earth")
+	require.EqualError(t, err, `path "venus-earth" does not relay packets of chain "mars"`)
+
+	err = r.ResetPaths(context.Background(), "mars", "unknown")
+	require.ErrorIs(t, err, relayerconf.ErrPathCannotBeFound)
+}
+
+func TestStartUnknownPath(t *testing.T) {
+	var requests int32
+	node := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		http.Error(w, "node is down", http.StatusInternalServerError)
+	}))
+	defer node.Close()
+
+	end := func(chainID string) relayerconf.PathEnd {
+		return relayerconf.PathEnd{ChainID: chainID, ChannelID: "channel-0", PortID: "transfer"}
+	}
+	relayerconf.SetPath(filepath.Join(t.TempDir(), "config.yml"))
+	require.NoError(t, relayerconf.Save(relayerconf.Config{
+		Chains: []relayerconf.Chain{
+			{ID: "mars", RPCAddress: node.URL},
+			{ID: "venus", RPCAddress: node.URL},
+		},
+		Paths: []relayerconf.Path{
+			{ID: "mars-venus", Src: end("mars"), Dst: end("venus")},
+		},
+	}))
+
+	// no path is relayed when one of them doesn't exist.
+	var r Relayer
+	err := r.Start(context.Background(), "mars-venus", "unknown")
+	require.ErrorIs(t, err, relayerconf.ErrPathCannotBeFound)
+	require.Zero(t, atomic.LoadInt32(&requests))
+}
@@ -0,0 +1,120 @@
+package relayer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/ignite-hq/cli/ignite/pkg/cosmosaccount"
+	"github.com/ignite-hq/cli/ignite/pkg/cosmosclient"
+	"github.com/ignite-hq/cli/ignite/pkg/cosmosutil"
+	relayerconf "github.com/ignite-hq/cli/ignite/pkg/relayer/config"
+)
+
+// RotateKey creates a new account with accountName, updates the relayer config to use the new
+// account and transfers all the balances of the relayer account used for the chain, but the
+// fee of the transfer, to it. The config is saved before the funds are moved so they are never
+// on an account the config doesn't use, it is restored when the transfer fails. The previous
+// account is kept since it can be shared with other chains.
+// The mnemonic of the new account is returned.
+func (r Relayer) RotateKey(ctx context.Context, chainID, accountName string) (
+	cosmosaccount.Account, string, error) {
+	conf, err := relayerconf.Get()
+	if err != nil {
+		return cosmosaccount.Account{}, "", err
+	}
+
+	chain, err := conf.ChainByID(chainID)
+	if err != nil {
+		return cosmosaccount.Account{}, "", err
+	}
+
+	current, err := r.ca.GetByName(chain.Account)
+	if err != nil {
+		return cosmosaccount.Account{}, "", err
+	}
+
+	coins, err := r.balance(ctx, chain.RPCAddress, chain.Account, chain.AddressPrefix)
+	if err != nil {
+		return cosmosaccount.Account{}, "", err
+	}
+
+	// the fee of the transfer is at most the one of the gas limit at the gas price.
+	fee, err := cosmosutil.Fee(chain.GasPrice, rotateGasLimit(chain))
+	if err != nil {
+		return cosmosaccount.Account{}, "", err
+	}
+	if coins, err = cosmosutil.SubtractFee(coins, fee); err != nil {
+		return cosmosaccount.Account{}, "", err
+	}
+
+	account, mnemonic, err := r.ca.Create(accountName)
+	if err != nil {
+		return cosmosaccount.Account{}, "", err
+	}
+
+	// remove the new account when the rotation fails, so it can be retried with the same name.
+	if err := saveChainAccount(conf, chain, accountName); err != nil {
+		return cosmosaccount.Account{}, "", r.deleteAccount(accountName, err)
+	}
+
+	if !coins.IsZero() {
+		if err := r.transfer(ctx, chain, current, account, coins); err != nil {
+			if restoreErr := saveChainAccount(conf, chain, chain.Account); restoreErr != nil {
+				return cosmosaccount.Account{}, "", fmt.Errorf("%w, the relayer config cannot be restored to account %s: %v", err, chain.Account, restoreErr)
+			}
+			return cosmosaccount.Account{}, "", r.deleteAccount(accountName, err)
+		}
+	}
+
+	return account, mnemonic, nil
+}
+
+// saveChainAccount sets the account of the chain in conf and saves conf.
+func saveChainAccount(conf relayerconf.Config, chain relayerconf.Chain, account string) error {
+	chain.Account = account
+	if err := conf.UpdateChain(chain); err != nil {
+		return err
+	}
+	return relayerconf.Save(conf)
+}
+
+// deleteAccount deletes the account created by a rotation that failed with err.
+func (r Relayer) deleteAccount(name string, err error) error {
+	if deleteErr := r.ca.DeleteByName(name); deleteErr != nil {
+		return fmt.Errorf("%w, the account %s cannot be deleted: %v", err, name, deleteErr)
+	}
+	return err
+}
+
+func (r Relayer) transfer(ctx context.Context, chain relayerconf.Chain, from, to cosmosaccount.Account, coins sdk.Coins) error {
+	client, err := cosmosclient.New(ctx,
+		cosmosclient.WithNodeAddress(chain.RPCAddress),
+		cosmosclient.WithAddressPrefix(chain.AddressPrefix),
+		cosmosclient.WithAccountRegistry(r.ca),
+		cosmosclient.WithGasPrices(chain.GasPrice),
+		cosmosclient.WithMaxGas(rotateGasLimit(chain)),
+	)
+	if err != nil {
+		return err
+	}
+
+	msg := &banktypes.MsgSend{
+		FromAddress: from.Address(chain.AddressPrefix),
+		ToAddress:   to.Address(chain.AddressPrefix),
+		Amount:      coins,
+	}
+	_, err = client.BroadcastTx(from.Name, msg)
+	return err
+}
+
+// rotateGasLimit returns the gas limit of the transfer of the balances of the relayer account.
+func rotateGasLimit(chain relayerconf.Chain) uint64 {
+	if chain.GasLimit > 0 {
+		return uint64(chain.GasLimit)
+	}
+	return flags.DefaultGasLimit
+}
@@ -0,0 +1,59 @@
+package relayer
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite-hq/cli/ignite/pkg/cosmosaccount"
+	relayerconf "github.com/ignite-hq/cli/ignite/pkg/relayer/config"
+)
+
+func TestSaveChainAccount(t *testing.T) {
+	relayerconf.SetPath(filepath.Join(t.TempDir(), "config.yml"))
+	require.NoError(t, relayerconf.Save(relayerconf.Config{
+		Chains: []relayerconf.Chain{
+			{ID: "mars", Account: "relayer"},
+			{ID: "venus", Account: "relayer"},
+		},
+	}))
+	conf, err := relayerconf.Get()
+	require.NoError(t, err)
+	chain, err := conf.ChainByID("mars")
+	require.NoError(t, err)
+
+	require.NoError(t, saveChainAccount(conf, chain, "relayer2"))
+	require.Equal(t, "relayer", chain.Account)
+
+	saved, err := relayerconf.Get()
+	require.NoError(t, err)
+	require.Equal(t, "relayer2", saved.Chains[0].Account)
+	require.Equal(t, "relayer", saved.Chains[1].Account)
+
+	// the config is restored when the transfer fails.
+	require.NoError(t, saveChainAccount(conf, chain, chain.Account))
+	saved, err = relayerconf.Get()
+	require.NoError(t, err)
+	require.Equal(t, "relayer", saved.Chains[0].Account)
+
+	require.Error(t, saveChainAccount(conf, relayerconf.Chain{ID: "earth"}, "relayer2"))
+}
+
+func TestDeleteAccount(t *testing.T) {
+	ca, err := cosmosaccount.NewInMemory()
+	require.NoError(t, err)
+	_, _, err = ca.Create("relayer2")
+	require.NoError(t, err)
+	r := New(ca)
+
+	errTransfer := errors.New("cannot transfer")
+	require.Equal(t, errTransfer, r.deleteAccount("relayer2", errTransfer))
+	_, err = ca.GetByName("relayer2")
+	require.Error(t, err)
+
+	err = r.deleteAccount("relayer2", errTransfer)
+	require.ErrorIs(t, err, errTransfer)
+	require.ErrorContains(t, err, "the account relayer2 cannot be deleted")
+}
@@ -0,0 +1,184 @@
+package relayer
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	clienttypes "github.com/cosmos/ibc-go/v2/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v2/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v2/modules/core/04-channel/types"
+
+	"github.com/ignite-hq/cli/ignite/pkg/cosmosclient"
+	relayerconf "github.com/ignite-hq/cli/ignite/pkg/relayer/config"
+)
+
+// PathStatus holds the health of a path.
+type PathStatus struct {
+	ID  string
+	Src PathEndStatus
+	Dst PathEndStatus
+}
+
+// PathEndStatus holds the health of one end of a path.
+type PathEndStatus struct {
+	ChainID string
+
+	ClientID     string
+	ClientStatus string
+
+	ConnectionID    string
+	ConnectionState string
+
+	PortID       string
+	ChannelID    string
+	ChannelState string
+
+	// PacketHeight is the height of the last relayed packet sent from this end.
+	PacketHeight int64
+
+	// AckHeight is the height of the last relayed acknowledgement sent from this end.
+	AckHeight int64
+
+	// PendingPackets is the number of packets sent from this end and not acknowledged yet.
+	PendingPackets uint64
+
+	// Account is the name of the relayer account on the chain.
+	Account string
+
+	// Balances of the relayer account on the chain.
+	Balances sdk.Coins
+
+	// Error is set when the chain cannot be queried.
+	Error error
+}
+
+// Status returns the status of paths. All paths are returned when no ids are given.
+// Chains that cannot be reached don't cause an error, instead their status holds the error.
+func (r Relayer) Status(ctx context.Context, pathIDs ...string) ([]PathStatus, error) {
+	conf, err := relayerconf.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	paths := conf.Paths
+	if len(pathIDs) > 0 {
+		paths = nil
+		for _, id := range pathIDs {
+			path, err := conf.PathByID(id)
+			if err != nil {
+				return nil, err
+			}
+			paths = append(paths, path)
+		}
+	}
+
+	var statuses []PathStatus
+	for _, path := range paths {
+		src, err := r.pathEndStatus(ctx, conf, path.Src)
+		if err != nil {
+			return nil, err
+		}
+
+		dst, err := r.pathEndStatus(ctx, conf, path.Dst)
+		if err != nil {
+			return nil, err
+		}
+
+		statuses = append(statuses, PathStatus{
+			ID:  path.ID,
+			Src: src,
+			Dst: dst,
+		})
+	}
+
+	return statuses, nil
+}
+
+func (r Relayer) pathEndStatus(ctx context.Context, conf relayerconf.Config, end relayerconf.PathEnd) (PathEndStatus, error) {
+	chain, err := conf.ChainByID(end.ChainID)
+	if err != nil {
+		return PathEndStatus{}, err
+	}
+
+	status := PathEndStatus{
+		ChainID:      end.ChainID,
+		ClientID:     chain.ClientID,
+		ConnectionID: end.ConnectionID,
+		PortID:       end.PortID,
+		ChannelID:    end.ChannelID,
+		PacketHeight: end.PacketHeight,
+		AckHeight:    end.AckHeight,
+		Account:      chain.Account,
+	}
+
+	status.Error = r.queryPathEnd(ctx, chain, &status)
+
+	return status, nil
+}
+
+func (r Relayer) queryPathEnd(ctx context.Context, chain relayerconf.Chain, status *PathEndStatus) error {
+	balances, err := r.balance(ctx, chain.RPCAddress, chain.Account, chain.AddressPrefix)
+	if err != nil {
+		return err
+	}
+	status.Balances = balances
+
+	// the path is not linked yet.
+	if status.ConnectionID == "" {
+		return nil
+	}
+
+	client, err := cosmosclient.New(ctx, cosmosclient.WithNodeAddress(chain.RPCAddress))
+	if err != nil {
+		return err
+	}
+
+	connection, err := connectiontypes.NewQueryClient(client.Context()).Connection(ctx,
+		&connectiontypes.QueryConnectionRequest{ConnectionId: status.ConnectionID},
+	)
+	if err != nil {
+		return err
+	}
+	status.ConnectionState = connection.Connection.State.String()
+	status.ClientID = connection.Connection.ClientId
+
+	clientStatus, err := clienttypes.NewQueryClient(client.Context()).ClientStatus(ctx,
+		&clienttypes.QueryClientStatusRequest{ClientId: status.ClientID},
+	)
+	if err != nil {
+		return err
+	}
+	status.ClientStatus = clientStatus.Status
+
+	if status.ChannelID == "" {
+		return nil
+	}
+
+	channelQuery := channeltypes.NewQueryClient(client.Context())
+
+	channel, err := channelQuery.Channel(ctx, &channeltypes.QueryChannelRequest{
+		PortId:    status.PortID,
+		ChannelId: status.ChannelID,
+	})
+	if err != nil {
+		return err
+	}
+	status.ChannelState = channel.Channel.State.String()
+
+	// packet commitments are deleted once packets are acknowledged, the remaining
+	// ones are the packets waiting to be relayed.
+	commitments, err := channelQuery.PacketCommitments(ctx, &channeltypes.QueryPacketCommitmentsRequest{
+		PortId:     status.PortID,
+		ChannelId:  status.ChannelID,
+		Pagination: &query.PageRequest{CountTotal: true},
+	})
+	if err != nil {
+		return err
+	}
+	if commitments.Pagination != nil {
+		status.PendingPackets = commitments.Pagination.Total
+	}
+
+	return nil
+}
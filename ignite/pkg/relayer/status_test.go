@@ -0,0 +1,72 @@
+package relayer
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	relayerconf "github.com/ignite-hq/cli/ignite/pkg/relayer/config"
+)
+
+func TestStatus(t *testing.T) {
+	// the nodes of the chains fail every query.
+	node := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "node is down", http.StatusInternalServerError)
+	}))
+	defer node.Close()
+
+	relayerconf.SetPath(filepath.Join(t.TempDir(), "config.yml"))
+	require.NoError(t, relayerconf.Save(relayerconf.Config{
+		Chains: []relayerconf.Chain{
+			{ID: "mars", Account: "alice", RPCAddress: node.URL, ClientID: "07-tendermint-0"},
+			{ID: "venus", Account: "bob", RPCAddress: node.URL},
+		},
+		Paths: []relayerconf.Path{
+			{
+				ID:  "mars-venus",
+				Src: relayerconf.PathEnd{ChainID: "mars", PortID: "transfer", ChannelID: "channel-0", PacketHeight: 10},
+				Dst: relayerconf.PathEnd{ChainID: "venus", PortID: "transfer", ChannelID: "channel-1", AckHeight: 12},
+			},
+			{
+				ID:  "venus-mars",
+				Src: relayerconf.PathEnd{ChainID: "venus", PortID: "transfer"},
+				Dst: relayerconf.PathEnd{ChainID: "mars", PortID: "transfer"},
+			},
+		},
+	}))
+
+	var r Relayer
+
+	statuses, err := r.Status(context.Background())
+	require.NoError(t, err)
+	require.Len(t, statuses, 2)
+	require.Equal(t, "mars-venus", statuses[0].ID)
+	require.Equal(t, "venus-mars", statuses[1].ID)
+
+	// the status of chains that cannot be queried holds the error and the relayer config.
+	src := statuses[0].Src
+	src.Error = nil
+	require.Equal(t, PathEndStatus{
+		ChainID:      "mars",
+		ClientID:     "07-tendermint-0",
+		PortID:       "transfer",
+		ChannelID:    "channel-0",
+		PacketHeight: 10,
+		Account:      "alice",
+	}, src)
+	require.Error(t, statuses[0].Src.Error)
+	require.Equal(t, int64(12), statuses[0].Dst.AckHeight)
+	require.Error(t, statuses[0].Dst.Error)
+
+	statuses, err = r.Status(context.Background(), "venus-mars")
+	require.NoError(t, err)
+	require.Len(t, statuses, 1)
+	require.Equal(t, "venus", statuses[0].Src.ChainID)
+
+	_, err = r.Status(context.Background(), "earth-mars")
+	require.ErrorIs(t, err, relayerconf.ErrPathCannotBeFound)
+}
@@ -0,0 +1,160 @@
+// Package reverseproxy renders nginx and Caddy configs to expose the services of a chain
+// with TLS, each service on its own subdomain.
+package reverseproxy
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"text/template"
+)
+
+// Server is a reverse proxy server.
+type Server string
+
+const (
+	Nginx Server = "nginx"
+	Caddy Server = "caddy"
+)
+
+// Servers are the supported reverse proxy servers.
+var Servers = []Server{Nginx, Caddy}
+
+// Service is a service of the chain exposed on the subdomain of its name.
+type Service struct {
+	// Name of the service, used as the subdomain, e.g. rpc.
+	Name string
+
+	// Upstream is the local address of the service, e.g. 127.0.0.1:26657.
+	Upstream string
+
+	// WebSocket enables the upgrade of connections to websockets.
+	WebSocket bool
+
+	// CORS adds the CORS headers of the allowed origins, it is meant for services that
+	// don't support a list of origins.
+	CORS bool
+}
+
+// Config describes the exposed services.
+type Config struct {
+	// Domain is the parent domain of the services, e.g. mars.example.com.
+	Domain string
+
+	Services []Service
+
+	// AllowedOrigins are the origins allowed by the services with CORS, all origins
+	// are allowed when it contains "*".
+	AllowedOrigins []string
+}
+
+// Render renders the config of server.
+func Render(server Server, conf Config) (string, error) {
+	if conf.Domain == "" {
+		return "", fmt.Errorf("a domain is required")
+	}
+
+	var tpl *template.Template
+	switch server {
+	case Nginx:
+		tpl = nginxTemplate
+	case Caddy:
+		tpl = caddyTemplate
+	default:
+		return "", fmt.Errorf("unknown reverse proxy server %q, supported servers are %v", server, Servers)
+	}
+
+	var b strings.Builder
+	err := tpl.Execute(&b, struct {
+		Config
+		AllowAll     bool
+		OriginRegexp string
+	}{
+		Config:       conf,
+		AllowAll:     allowsAll(conf.AllowedOrigins),
+		OriginRegexp: originRegexp(conf.AllowedOrigins),
+	})
+	return b.String(), err
+}
+
+func allowsAll(origins []string) bool {
+	for _, origin := range origins {
+		if origin == "*" {
+			return true
+		}
+	}
+	return len(origins) == 0
+}
+
+func originRegexp(origins []string) string {
+	quoted := make([]string, len(origins))
+	for i, origin := range origins {
+		quoted[i] = regexp.QuoteMeta(strings.TrimSuffix(origin, "/"))
+	}
+	return "^(" + strings.Join(quoted, "|") + ")$"
+}
+
+var nginxTemplate = template.Must(template.New("nginx").Parse(`# nginx config of {{ .Domain }}, certificates are expected to be issued by certbot:
+#   certbot certonly --nginx --cert-name {{ .Domain }}{{ range .Services }} -d {{ .Name }}.{{ $.Domain }}{{ end }}
+{{ if not .AllowAll }}
+map $http_origin $cors_origin {
+    default "";
+    "~{{ .OriginRegexp }}" $http_origin;
+}
+{{ end }}
+server {
+    listen 80;
+    server_name{{ range .Services }} {{ .Name }}.{{ $.Domain }}{{ end }};
+    return 301 https://$host$request_uri;
+}
+{{ range .Services }}
+server {
+    listen 443 ssl http2;
+    server_name {{ .Name }}.{{ $.Domain }};
+
+    ssl_certificate /etc/letsencrypt/live/{{ $.Domain }}/fullchain.pem;
+    ssl_certificate_key /etc/letsencrypt/live/{{ $.Domain }}/privkey.pem;
+
+    location / {
+{{- if and .CORS (not $.AllowAll) }}
+        if ($request_method = OPTIONS) {
+            add_header Access-Control-Allow-Origin $cors_origin always;
+            add_header Access-Control-Allow-Methods "GET, POST, OPTIONS" always;
+            add_header Access-Control-Allow-Headers "*" always;
+            add_header Vary Origin always;
+            return 204;
+        }
+        add_header Access-Control-Allow-Origin $cors_origin always;
+        add_header Vary Origin always;
+{{- end }}
+        proxy_pass http://{{ .Upstream }};
+        proxy_set_header Host $host;
+        proxy_set_header X-Forwarded-For $proxy_add_x_forwarded_for;
+        proxy_set_header X-Forwarded-Proto $scheme;
+{{- if .WebSocket }}
+        proxy_http_version 1.1;
+        proxy_set_header Upgrade $http_upgrade;
+        proxy_set_header Connection "upgrade";
+{{- end }}
+    }
+}
+{{ end }}`))
+
+var caddyTemplate = template.Must(template.New("caddy").Parse(`# Caddyfile of {{ .Domain }}, certificates are issued automatically by Caddy.
+{{ range .Services }}
+{{ .Name }}.{{ $.Domain }} {
+{{- if and .CORS (not $.AllowAll) }}
+    @cors header_regexp Origin {{ $.OriginRegexp }}
+    @preflight {
+        method OPTIONS
+        header_regexp Origin {{ $.OriginRegexp }}
+    }
+    header @cors Access-Control-Allow-Origin {http.request.header.Origin}
+    header @cors Vary Origin
+    header @preflight Access-Control-Allow-Methods "GET, POST, OPTIONS"
+    header @preflight Access-Control-Allow-Headers "*"
+    respond @preflight 204
+{{- end }}
+    reverse_proxy {{ .Upstream }}
+}
+{{ end }}`))
@@ -0,0 +1,57 @@
+package reverseproxy_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite-hq/cli/ignite/pkg/reverseproxy"
+)
+
+var conf = reverseproxy.Config{
+	Domain: "mars.example.com",
+	Services: []reverseproxy.Service{
+		{Name: "rpc", Upstream: "127.0.0.1:26657", WebSocket: true},
+		{Name: "api", Upstream: "127.0.0.1:1317", CORS: true},
+	},
+	AllowedOrigins: []string{"https://app.example.com"},
+}
+
+func TestRenderNginx(t *testing.T) {
+	out, err := reverseproxy.Render(reverseproxy.Nginx, conf)
+	require.NoError(t, err)
+	require.Contains(t, out, "server_name rpc.mars.example.com api.mars.example.com;")
+	require.Contains(t, out, `"~^(https://app\.example\.com)$" $http_origin;`)
+	require.Contains(t, out, "proxy_pass http://127.0.0.1:26657;")
+	require.Contains(t, out, `proxy_set_header Connection "upgrade";`)
+	require.Contains(t, out, "ssl_certificate /etc/letsencrypt/live/mars.example.com/fullchain.pem;")
+	require.Contains(t, out, "add_header Access-Control-Allow-Origin $cors_origin always;")
+}
+
+func TestRenderCaddy(t *testing.T) {
+	out, err := reverseproxy.Render(reverseproxy.Caddy, conf)
+	require.NoError(t, err)
+	require.Contains(t, out, "rpc.mars.example.com {\n    reverse_proxy 127.0.0.1:26657\n}")
+	require.Contains(t, out, `@cors header_regexp Origin ^(https://app\.example\.com)$`)
+	require.Contains(t, out, "reverse_proxy 127.0.0.1:1317")
+}
+
+func TestRenderAllowAll(t *testing.T) {
+	c := conf
+	c.AllowedOrigins = []string{"*"}
+	for _, server := range reverseproxy.Servers {
+		out, err := reverseproxy.Render(server, c)
+		require.NoError(t, err)
+		require.NotContains(t, out, "Access-Control-Allow-Origin")
+	}
+}
+
+func TestRenderInvalid(t *testing.T) {
+	_, err := reverseproxy.Render("apache", conf)
+	require.Error(t, err)
+
+	c := conf
+	c.Domain = ""
+	_, err = reverseproxy.Render(reverseproxy.Nginx, c)
+	require.Error(t, err)
+}
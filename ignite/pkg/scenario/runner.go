@@ -0,0 +1,480 @@
+package scenario
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/ignite-hq/cli/ignite/pkg/cosmosclient"
+	"github.com/ignite-hq/cli/ignite/pkg/cosmosfaucet"
+)
+
+const (
+	// blockPollDelay is the delay between the checks of the height of the chain.
+	blockPollDelay = 500 * time.Millisecond
+
+	// txGasLimit is the gas limit of the txs broadcasted by the TxBroadcaster, their gas is
+	// not estimated.
+	txGasLimit = 400000
+)
+
+// variableRe matches the ${name} variables replaced by the addresses of the accounts.
+var variableRe = regexp.MustCompile(`\$\{([^}]+)\}`)
+
+// TxBroadcaster signs the unsigned tx encoded in JSON with the key of from, broadcasts it and
+// returns its result once it is included in a block.
+type TxBroadcaster func(ctx context.Context, from string, unsignedTx []byte) (sdktypes.TxResponse, error)
+
+// Runner runs scenarios against a chain.
+type Runner struct {
+	client      cosmosclient.Client
+	clients     map[string]cosmosclient.Client
+	broadcastTx TxBroadcaster
+	api         string
+	faucet      string
+	addresses   map[string]string
+	out         io.Writer
+}
+
+// Option configures the runner.
+type Option func(*Runner)
+
+// WithAPI sets the address of the API of the chain used by the queries.
+func WithAPI(address string) Option {
+	return func(r *Runner) {
+		r.api = strings.TrimSuffix(address, "/")
+	}
+}
+
+// WithFaucet sets the address of the faucet used to fund accounts.
+func WithFaucet(address string) Option {
+	return func(r *Runner) {
+		r.faucet = address
+	}
+}
+
+// WithAddresses sets the addresses by label used for the accounts of the scenarios, labels
+// take precedence over the names of the accounts of the keyring.
+func WithAddresses(addresses map[string]string) Option {
+	return func(r *Runner) {
+		r.addresses = addresses
+	}
+}
+
+// WithAccountClients sets the clients broadcasting the messages of accounts by name, e.g. to
+// use the gas settings of the accounts. The client of the runner broadcasts the messages of
+// the other accounts.
+func WithAccountClients(clients map[string]cosmosclient.Client) Option {
+	return func(r *Runner) {
+		r.clients = clients
+	}
+}
+
+// WithTxBroadcaster sets the broadcaster of the messages that the client cannot decode, like
+// the messages of the modules of the chain, e.g. one signing the txs with the binary of the chain.
+func WithTxBroadcaster(broadcast TxBroadcaster) Option {
+	return func(r *Runner) {
+		r.broadcastTx = broadcast
+	}
+}
+
+// WithOutput sets the writer of the results of the steps.
+func WithOutput(out io.Writer) Option {
+	return func(r *Runner) {
+		r.out = out
+	}
+}
+
+// New creates a runner that broadcasts the messages with client, the accounts of the scenarios
+// are the accounts of the keyring of the client.
+// Messages of the bank, staking, distribution and gov modules of the Cosmos SDK are supported,
+// the other messages require WithTxBroadcaster.
+func New(client cosmosclient.Client, options ...Option) Runner {
+	r := Runner{
+		client: client,
+		out:    io.Discard,
+	}
+	for _, apply := range options {
+		apply(&r)
+	}
+
+	registry := client.Context().InterfaceRegistry
+	banktypes.RegisterInterfaces(registry)
+	distrtypes.RegisterInterfaces(registry)
+	govtypes.RegisterInterfaces(registry)
+
+	return r
+}
+
+// Run runs the steps of the scenario in order and stops at the first failed step.
+func (r Runner) Run(ctx context.Context, s Scenario) error {
+	if s.Name != "" {
+		fmt.Fprintf(r.out, "🎬 %s\n", s.Name)
+	}
+	for i, step := range s.Steps {
+		var err error
+		switch {
+		case step.Fund != nil:
+			err = r.fund(ctx, *step.Fund)
+		case step.Msg != nil:
+			err = r.msg(ctx, *step.Msg)
+		case step.Wait != nil:
+			err = r.wait(ctx, *step.Wait)
+		case step.Query != nil:
+			err = r.query(ctx, *step.Query)
+		}
+		if err != nil {
+			fmt.Fprintf(r.out, "✘ %s\n", step.title())
+			return fmt.Errorf("step %d (%s): %w", i+1, step.title(), err)
+		}
+		fmt.Fprintf(r.out, "✔ %s\n", step.title())
+	}
+	return nil
+}
+
+func (r Runner) fund(ctx context.Context, f Fund) error {
+	address, err := r.address(f.Account)
+	if err != nil {
+		return err
+	}
+
+	if f.From == "" {
+		if r.faucet == "" {
+			return errors.New("funding from the faucet requires the faucet address")
+		}
+		res, err := cosmosfaucet.NewClient(r.faucet).Transfer(ctx, cosmosfaucet.NewTransferRequest(address, f.Coins))
+		if err != nil {
+			return err
+		}
+		if res.Error != "" {
+			return errors.New(res.Error)
+		}
+		return nil
+	}
+
+	from, err := r.client.Address(f.From)
+	if err != nil {
+		return err
+	}
+	coins, err := sdktypes.ParseCoinsNormalized(strings.Join(f.Coins, ","))
+	if err != nil {
+		return err
+	}
+	to, err := sdktypes.GetFromBech32(address, r.client.AddressPrefix())
+	if err != nil {
+		return err
+	}
+	_, err = r.accountClient(f.From).BroadcastTx(f.From, banktypes.NewMsgSend(from, to, coins))
+	return err
+}
+
+func (r Runner) msg(ctx context.Context, m Msg) error {
+	body, err := r.expandValue(m.Body)
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+
+	var (
+		msg  sdktypes.Msg
+		logs sdktypes.ABCIMessageLogs
+	)
+	decodeErr := r.client.Context().Codec.UnmarshalInterfaceJSON(data, &msg)
+	switch {
+	case decodeErr == nil:
+		var res cosmosclient.Response
+		if res, err = r.accountClient(m.From).BroadcastTx(m.From, msg); err == nil {
+			logs = res.Logs
+		}
+	case r.broadcastTx != nil:
+		// the messages of the modules of the chain are decoded by the broadcaster.
+		tx, txErr := unsignedTx(data)
+		if txErr != nil {
+			return txErr
+		}
+		var res sdktypes.TxResponse
+		if res, err = r.broadcastTx(ctx, m.From, tx); err == nil {
+			logs = res.Logs
+		}
+	default:
+		return fmt.Errorf("invalid message: %w", decodeErr)
+	}
+
+	if m.Expect.Error != "" {
+		if err == nil {
+			return fmt.Errorf("transaction succeeded, expected error %q", m.Expect.Error)
+		}
+		if !strings.Contains(err.Error(), m.Expect.Error) {
+			return fmt.Errorf("expected error %q, got: %w", m.Expect.Error, err)
+		}
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, expected := range m.Expect.Events {
+		if err := r.findEvent(logs, expected); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// unsignedTx returns the JSON of an unsigned tx of the message encoded in JSON, the tx has no
+// fees and the txGasLimit gas limit.
+func unsignedTx(msg []byte) ([]byte, error) {
+	return json.Marshal(map[string]interface{}{
+		"body": map[string]interface{}{
+			"messages":                       []json.RawMessage{msg},
+			"memo":                           "",
+			"timeout_height":                 "0",
+			"extension_options":              []interface{}{},
+			"non_critical_extension_options": []interface{}{},
+		},
+		"auth_info": map[string]interface{}{
+			"signer_infos": []interface{}{},
+			"fee": map[string]interface{}{
+				"amount":    []interface{}{},
+				"gas_limit": strconv.Itoa(txGasLimit),
+				"payer":     "",
+				"granter":   "",
+			},
+		},
+		"signatures": []interface{}{},
+	})
+}
+
+// findEvent checks that the logs have an event matching expected.
+func (r Runner) findEvent(logs sdktypes.ABCIMessageLogs, expected Event) error {
+	attributes := make(map[string]string)
+	for key, value := range expected.Attributes {
+		v, err := r.expand(value)
+		if err != nil {
+			return err
+		}
+		attributes[key] = v
+	}
+
+	for _, log := range logs {
+		for _, event := range log.Events {
+			if event.Type == expected.Type && hasAttributes(event, attributes) {
+				return nil
+			}
+		}
+	}
+	return fmt.Errorf("event %s with attributes %v not emitted", expected.Type, attributes)
+}
+
+func hasAttributes(event sdktypes.StringEvent, attributes map[string]string) bool {
+	for key, value := range attributes {
+		found := false
+		for _, attr := range event.Attributes {
+			if attr.Key == key && attr.Value == value {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+func (r Runner) wait(ctx context.Context, w Wait) error {
+	status, err := r.client.Status(ctx)
+	if err != nil {
+		return err
+	}
+	target := status.SyncInfo.LatestBlockHeight + w.Blocks
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(blockPollDelay):
+		}
+
+		status, err := r.client.Status(ctx)
+		if err != nil {
+			return err
+		}
+		if status.SyncInfo.LatestBlockHeight >= target {
+			return nil
+		}
+	}
+}
+
+func (r Runner) query(ctx context.Context, q Query) error {
+	if r.api == "" {
+		return errors.New("queries require the API address")
+	}
+	path, err := r.expand(q.Get)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, r.api+path, nil)
+	if err != nil {
+		return err
+	}
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	status := q.Expect.Status
+	if status == 0 {
+		status = http.StatusOK
+	}
+	if res.StatusCode != status {
+		return fmt.Errorf("expected status %d, got %d", status, res.StatusCode)
+	}
+
+	if len(q.Expect.JSON) == 0 {
+		return nil
+	}
+	var body interface{}
+	if err := json.NewDecoder(res.Body).Decode(&body); err != nil {
+		return err
+	}
+	for path, expected := range q.Expect.JSON {
+		if expected, err = r.expand(expected); err != nil {
+			return err
+		}
+		value, err := lookup(body, path)
+		if err != nil {
+			return err
+		}
+		if value != expected {
+			return fmt.Errorf("%s is %q, expected %q", path, value, expected)
+		}
+	}
+	return nil
+}
+
+// lookup returns the value at the dotted path of a decoded JSON, array elements are selected
+// by their index.
+func lookup(value interface{}, path string) (string, error) {
+	for _, key := range strings.Split(path, ".") {
+		switch v := value.(type) {
+		case map[string]interface{}:
+			var ok bool
+			if value, ok = v[key]; !ok {
+				return "", fmt.Errorf("%s not found", path)
+			}
+		case []interface{}:
+			i, err := strconv.Atoi(key)
+			if err != nil || i < 0 || i >= len(v) {
+				return "", fmt.Errorf("%s not found", path)
+			}
+			value = v[i]
+		default:
+			return "", fmt.Errorf("%s not found", path)
+		}
+	}
+
+	switch v := value.(type) {
+	case string:
+		return v, nil
+	case map[string]interface{}, []interface{}:
+		data, err := json.Marshal(v)
+		return string(data), err
+	default:
+		return fmt.Sprint(v), nil
+	}
+}
+
+// address returns the address of the account nameOrAddress, nameOrAddress is returned as is
+// when it is already an address.
+func (r Runner) address(nameOrAddress string) (string, error) {
+	nameOrAddress, err := r.expand(nameOrAddress)
+	if err != nil {
+		return "", err
+	}
+	if _, _, err := bech32.DecodeAndConvert(nameOrAddress); err == nil {
+		return nameOrAddress, nil
+	}
+	return r.accountAddress(nameOrAddress)
+}
+
+// accountAddress returns the address labeled name or the address of the account name of
+// the keyring.
+func (r Runner) accountAddress(name string) (string, error) {
+	if address, ok := r.addresses[name]; ok {
+		return address, nil
+	}
+	account, err := r.client.Account(name)
+	if err != nil {
+		return "", fmt.Errorf("account %s: %w", name, err)
+	}
+	return account.Address(r.client.AddressPrefix()), nil
+}
+
+// expand replaces the ${name} variables of s by the addresses of the accounts.
+func (r Runner) expand(s string) (string, error) {
+	var err error
+	expanded := variableRe.ReplaceAllStringFunc(s, func(variable string) string {
+		address, aerr := r.accountAddress(variableRe.FindStringSubmatch(variable)[1])
+		if aerr != nil {
+			err = aerr
+			return variable
+		}
+		return address
+	})
+	return expanded, err
+}
+
+// expandValue expands the strings of a decoded YAML value.
+func (r Runner) expandValue(value interface{}) (interface{}, error) {
+	switch v := value.(type) {
+	case string:
+		return r.expand(v)
+	case map[string]interface{}:
+		expanded := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			var err error
+			if expanded[key], err = r.expandValue(item); err != nil {
+				return nil, err
+			}
+		}
+		return expanded, nil
+	case []interface{}:
+		expanded := make([]interface{}, len(v))
+		for i, item := range v {
+			var err error
+			if expanded[i], err = r.expandValue(item); err != nil {
+				return nil, err
+			}
+		}
+		return expanded, nil
+	default:
+		return v, nil
+	}
+}
+
+// accountClient returns the client broadcasting the messages of the account name.
+func (r Runner) accountClient(name string) cosmosclient.Client {
+	if client, ok := r.clients[name]; ok {
+		return client
+	}
+	return r.client
+}
@@ -0,0 +1,193 @@
+// Package scenario runs black-box test scenarios written in YAML against a running chain.
+//
+// A scenario is a list of steps, each step funds an account, broadcasts a message, waits
+// for blocks or queries the API of the chain, and asserts on the result:
+//
+//	name: send tokens
+//	steps:
+//	  - fund:
+//	      account: bob
+//	      coins: ["10token"]
+//	  - msg:
+//	      from: alice
+//	      body:
+//	        "@type": /cosmos.bank.v1beta1.MsgSend
+//	        from_address: ${alice}
+//	        to_address: ${bob}
+//	        amount: [{denom: token, amount: "10"}]
+//	      expect:
+//	        events:
+//	          - type: transfer
+//	            attributes:
+//	              recipient: ${bob}
+//	  - wait:
+//	      blocks: 1
+//	  - query:
+//	      get: /cosmos/bank/v1beta1/balances/${bob}/by_denom?denom=token
+//	      expect:
+//	        json:
+//	          balance.amount: "20"
+//
+// ${name} is replaced by the address labeled name, see WithAddresses, or by the address of
+// the account name of the keyring.
+package scenario
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/goccy/go-yaml"
+)
+
+// Scenario is a list of steps run in order.
+type Scenario struct {
+	Name  string `yaml:"name"`
+	Steps []Step `yaml:"steps"`
+}
+
+// Step is a step of a scenario, only one of its actions is set.
+type Step struct {
+	// Name describes the step, it defaults to the action of the step.
+	Name string `yaml:"name"`
+
+	Fund  *Fund  `yaml:"fund"`
+	Msg   *Msg   `yaml:"msg"`
+	Wait  *Wait  `yaml:"wait"`
+	Query *Query `yaml:"query"`
+}
+
+// Fund sends coins to an account.
+type Fund struct {
+	// Account is the name or the address of the funded account.
+	Account string `yaml:"account"`
+
+	Coins []string `yaml:"coins"`
+
+	// From is the name of the account that sends the coins, the coins are requested from
+	// the faucet when empty.
+	From string `yaml:"from"`
+}
+
+// Msg broadcasts a message in a transaction.
+type Msg struct {
+	// From is the name of the account that signs the transaction.
+	From string `yaml:"from"`
+
+	// Body is the JSON of the message with its type in "@type".
+	Body map[string]interface{} `yaml:"body"`
+
+	Expect TxExpect `yaml:"expect"`
+}
+
+// TxExpect asserts on the result of a transaction.
+type TxExpect struct {
+	// Error is a part of the error of the transaction, the transaction is expected to succeed
+	// when empty.
+	Error string `yaml:"error"`
+
+	// Events are emitted by the transaction, only the listed attributes are compared.
+	Events []Event `yaml:"events"`
+}
+
+// Event is an event emitted by a transaction.
+type Event struct {
+	Type       string            `yaml:"type"`
+	Attributes map[string]string `yaml:"attributes"`
+}
+
+// Wait waits for new blocks.
+type Wait struct {
+	Blocks int64 `yaml:"blocks"`
+}
+
+// Query queries the API of the chain.
+type Query struct {
+	// Get is the path of the query, with its query string.
+	Get string `yaml:"get"`
+
+	Expect QueryExpect `yaml:"expect"`
+}
+
+// QueryExpect asserts on the response of a query.
+type QueryExpect struct {
+	// Status is the HTTP status of the response, 200 by default.
+	Status int `yaml:"status"`
+
+	// JSON are the values of the response by their dotted path, e.g. balances.0.amount.
+	JSON map[string]string `yaml:"json"`
+}
+
+// Parse parses a scenario.
+func Parse(r io.Reader) (Scenario, error) {
+	var s Scenario
+	if err := yaml.NewDecoder(r).Decode(&s); err != nil {
+		return s, err
+	}
+	return s, s.validate()
+}
+
+// ParseFile parses the scenario of a file.
+func ParseFile(path string) (Scenario, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return Scenario{}, err
+	}
+	defer file.Close()
+
+	return Parse(file)
+}
+
+func (s Scenario) validate() error {
+	if len(s.Steps) == 0 {
+		return errors.New("scenario has no steps")
+	}
+	for i, step := range s.Steps {
+		if err := step.validate(); err != nil {
+			return fmt.Errorf("step %d: %w", i+1, err)
+		}
+	}
+	return nil
+}
+
+func (s Step) validate() error {
+	actions := 0
+	for _, set := range []bool{s.Fund != nil, s.Msg != nil, s.Wait != nil, s.Query != nil} {
+		if set {
+			actions++
+		}
+	}
+	if actions != 1 {
+		return errors.New("a step requires exactly one of fund, msg, wait or query")
+	}
+
+	switch {
+	case s.Fund != nil && (s.Fund.Account == "" || len(s.Fund.Coins) == 0):
+		return errors.New("fund requires an account and coins")
+	case s.Msg != nil && (s.Msg.From == "" || s.Msg.Body["@type"] == nil):
+		return errors.New("msg requires from and the @type of the body")
+	case s.Wait != nil && s.Wait.Blocks <= 0:
+		return errors.New("wait requires a positive number of blocks")
+	case s.Query != nil && s.Query.Get == "":
+		return errors.New("query requires a path to get")
+	}
+	return nil
+}
+
+// title returns the name of the step, or its action.
+func (s Step) title() string {
+	if s.Name != "" {
+		return s.Name
+	}
+	switch {
+	case s.Fund != nil:
+		return fmt.Sprintf("fund %s", s.Fund.Account)
+	case s.Msg != nil:
+		return fmt.Sprintf("send %s", s.Msg.Body["@type"])
+	case s.Wait != nil:
+		return fmt.Sprintf("wait %d blocks", s.Wait.Blocks)
+	default:
+		return fmt.Sprintf("query %s", s.Query.Get)
+	}
+}
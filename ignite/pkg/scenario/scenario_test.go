@@ -0,0 +1,133 @@
+package scenario
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	s, err := Parse(strings.NewReader(`
+name: send tokens
+steps:
+  - fund:
+      account: bob
+      coins: ["10token"]
+  - name: send
+    msg:
+      from: alice
+      body:
+        "@type": /cosmos.bank.v1beta1.MsgSend
+        amount: [{denom: token, amount: "10"}]
+      expect:
+        events:
+          - type: transfer
+            attributes:
+              recipient: ${bob}
+  - wait:
+      blocks: 2
+  - query:
+      get: /cosmos/bank/v1beta1/balances/${bob}
+`))
+	require.NoError(t, err)
+	require.Equal(t, "send tokens", s.Name)
+	require.Len(t, s.Steps, 4)
+	require.Equal(t, "fund bob", s.Steps[0].title())
+	require.Equal(t, "send", s.Steps[1].title())
+	require.Equal(t, []interface{}{map[string]interface{}{"denom": "token", "amount": "10"}}, s.Steps[1].Msg.Body["amount"])
+	require.Equal(t, map[string]string{"recipient": "${bob}"}, s.Steps[1].Msg.Expect.Events[0].Attributes)
+	require.Equal(t, "wait 2 blocks", s.Steps[2].title())
+	require.Equal(t, "query /cosmos/bank/v1beta1/balances/${bob}", s.Steps[3].title())
+
+	for _, steps := range []string{
+		"",
+		"steps:\n  - name: nothing\n",
+		"steps:\n  - wait: {blocks: 1}\n    query: {get: /}\n",
+		"steps:\n  - fund: {account: bob}\n",
+		"steps:\n  - msg: {from: alice, body: {amount: 1}}\n",
+		"steps:\n  - wait: {blocks: 0}\n",
+	} {
+		_, err := Parse(strings.NewReader(steps))
+		require.Error(t, err, steps)
+	}
+}
+
+func TestLookup(t *testing.T) {
+	body := map[string]interface{}{
+		"balances": []interface{}{
+			map[string]interface{}{"denom": "token", "amount": "10"},
+		},
+		"pagination": map[string]interface{}{"total": float64(1)},
+	}
+
+	value, err := lookup(body, "balances.0.amount")
+	require.NoError(t, err)
+	require.Equal(t, "10", value)
+
+	value, err = lookup(body, "pagination.total")
+	require.NoError(t, err)
+	require.Equal(t, "1", value)
+
+	value, err = lookup(body, "balances.0")
+	require.NoError(t, err)
+	require.JSONEq(t, `{"denom":"token","amount":"10"}`, value)
+
+	for _, path := range []string{"balances.1.amount", "balances.x", "pagination.next", "pagination.total.value"} {
+		_, err := lookup(body, path)
+		require.Error(t, err, path)
+	}
+}
+
+func TestQuery(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/cosmos/bank/v1beta1/balances/cosmos1bob" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write([]byte(`{"balances":[{"denom":"token","amount":"10"}]}`))
+	}))
+	defer srv.Close()
+
+	r := Runner{api: srv.URL, addresses: map[string]string{"bob": "cosmos1bob"}}
+	ctx := context.Background()
+
+	require.NoError(t, r.query(ctx, Query{
+		Get:    "/cosmos/bank/v1beta1/balances/cosmos1bob",
+		Expect: QueryExpect{JSON: map[string]string{"balances.0.amount": "10"}},
+	}))
+	require.Error(t, r.query(ctx, Query{
+		Get:    "/cosmos/bank/v1beta1/balances/cosmos1bob",
+		Expect: QueryExpect{JSON: map[string]string{"balances.0.amount": "20"}},
+	}))
+	require.NoError(t, r.query(ctx, Query{
+		Get:    "/cosmos/bank/v1beta1/balances/${bob}",
+		Expect: QueryExpect{JSON: map[string]string{"balances.0.denom": "token"}},
+	}))
+	require.NoError(t, r.query(ctx, Query{Get: "/unknown", Expect: QueryExpect{Status: http.StatusNotFound}}))
+	require.Error(t, r.query(ctx, Query{Get: "/unknown"}))
+}
+
+func TestUnsignedTx(t *testing.T) {
+	registry := codectypes.NewInterfaceRegistry()
+	banktypes.RegisterInterfaces(registry)
+	txConfig := authtx.NewTxConfig(codec.NewProtoCodec(registry), authtx.DefaultSignModes)
+
+	msg := `{"@type":"/cosmos.bank.v1beta1.MsgSend","from_address":"cosmos1adn9gxjmrc3hrsdx5zpc9sj2ra7kgqkmphf8yw","to_address":"cosmos1wpj4fmm8r2wzx7cmnfj4l4jh2qfl3lzlxpg05e","amount":[{"denom":"token","amount":"10"}]}`
+	data, err := unsignedTx([]byte(msg))
+	require.NoError(t, err)
+
+	tx, err := txConfig.TxJSONDecoder()(data)
+	require.NoError(t, err)
+	require.Len(t, tx.GetMsgs(), 1)
+	require.Equal(t, "cosmos1wpj4fmm8r2wzx7cmnfj4l4jh2qfl3lzlxpg05e", tx.GetMsgs()[0].(*banktypes.MsgSend).ToAddress)
+	require.Equal(t, uint64(txGasLimit), tx.(sdktypes.FeeTx).GetGas())
+}
@@ -0,0 +1,72 @@
+// Package tendermintsigner runs a software remote signer for Tendermint validators.
+package tendermintsigner
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/privval"
+
+	"github.com/ignite-hq/cli/ignite/pkg/tendermintlogger"
+)
+
+const (
+	// connRetryWait is the duration between two attempts to connect to the node.
+	connRetryWait = 100 * time.Millisecond
+
+	// connRetries is the number of attempts to connect to the node, the signer gives up
+	// when the node is not reachable after two minutes.
+	connRetries = 1200
+
+	timeoutReadWrite = 3 * time.Second
+)
+
+// Run runs a remote signer that signs for the validator of the chain with chainID until ctx
+// is canceled. The signer connects to the node at address, the priv_validator_laddr of the
+// node, e.g. tcp://127.0.0.1:26659 or unix:///tmp/signer.sock. It signs with the validator
+// key at keyPath and keeps track of the last signed height in the state file at statePath
+// to prevent double signing.
+func Run(ctx context.Context, chainID, address, keyPath, statePath string) error {
+	var dialer privval.SocketDialer
+	switch {
+	case strings.HasPrefix(address, "tcp://"):
+		// the key only authenticates the connection, the node accepts any key.
+		dialer = privval.DialTCPFn(address, timeoutReadWrite, ed25519.GenPrivKey())
+	case strings.HasPrefix(address, "unix://"):
+		dialer = privval.DialUnixFn(strings.TrimPrefix(address, "unix://"))
+	default:
+		return fmt.Errorf("invalid signer address %q, the protocol must be tcp:// or unix://", address)
+	}
+
+	// LoadFilePV exits the process when the files cannot be read.
+	for _, path := range []string{keyPath, statePath} {
+		if _, err := os.Stat(path); err != nil {
+			return err
+		}
+	}
+	pv := privval.LoadFilePV(keyPath, statePath)
+
+	endpoint := privval.NewSignerDialerEndpoint(
+		tendermintlogger.DiscardLogger{},
+		dialer,
+		privval.SignerDialerEndpointConnRetries(connRetries),
+		privval.SignerDialerEndpointRetryWaitInterval(connRetryWait),
+		privval.SignerDialerEndpointTimeoutReadWrite(timeoutReadWrite),
+	)
+	server := privval.NewSignerServer(endpoint, chainID, pv)
+
+	if err := server.Start(); err != nil {
+		return err
+	}
+
+	<-ctx.Done()
+
+	if err := server.Stop(); err != nil {
+		return err
+	}
+	return ctx.Err()
+}
@@ -0,0 +1,53 @@
+package tendermintsigner
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/privval"
+
+	"github.com/ignite-hq/cli/ignite/pkg/tendermintlogger"
+)
+
+func TestRun(t *testing.T) {
+	var (
+		dir       = t.TempDir()
+		keyPath   = filepath.Join(dir, "priv_validator_key.json")
+		statePath = filepath.Join(dir, "priv_validator_state.json")
+		address   = "tcp://" + privval.GetFreeLocalhostAddrPort()
+	)
+	pv := privval.GenFilePV(keyPath, statePath)
+	pv.Save()
+
+	// the listener plays the role of the node.
+	listener, err := privval.NewSignerListener(address, tendermintlogger.DiscardLogger{})
+	require.NoError(t, err)
+	client, err := privval.NewSignerClient(listener, "mars")
+	require.NoError(t, err)
+	defer client.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	errC := make(chan error)
+	go func() { errC <- Run(ctx, "mars", address, keyPath, statePath) }()
+
+	require.NoError(t, client.WaitForConnection(timeoutReadWrite*3))
+	pubKey, err := client.GetPubKey()
+	require.NoError(t, err)
+	require.Equal(t, pv.Key.PubKey, pubKey)
+
+	cancel()
+	require.ErrorIs(t, <-errC, context.Canceled)
+}
+
+func TestRunInvalid(t *testing.T) {
+	dir := t.TempDir()
+	ctx := context.Background()
+
+	require.Error(t, Run(ctx, "mars", "127.0.0.1:26659", "", ""))
+	require.Error(t, Run(ctx, "mars", "tcp://127.0.0.1:26659",
+		filepath.Join(dir, "priv_validator_key.json"),
+		filepath.Join(dir, "priv_validator_state.json"),
+	))
+}
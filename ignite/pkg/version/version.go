@@ -0,0 +1,77 @@
+// Package version exposes the build metadata of a chain built with Ignite CLI.
+//
+// The metadata is injected at build time by `ignite chain build` and `ignite chain serve`.
+// Chains scaffolded by Ignite CLI generate their own copy of this package in their app
+// package, other chains can import it to serve the metadata in JSON on the /ignite/version
+// route of their API and with the custom/version ABCI query:
+//
+//	apiSvr.Router.HandleFunc(version.Route, version.Handler())
+//	app.QueryRouter().AddRoute(version.QueryRoute, version.Querier)
+package version
+
+import (
+	"encoding/json"
+	"net/http"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkversion "github.com/cosmos/cosmos-sdk/version"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+const (
+	// Route is the route of the API of the chain that serves the build metadata.
+	Route = "/ignite/version"
+
+	// QueryRoute is the route of the querier of the build metadata, the metadata is queried
+	// with the custom/version ABCI query path.
+	QueryRoute = "version"
+)
+
+var (
+	// IgniteVersion is the version of Ignite CLI used to build the chain.
+	IgniteVersion = ""
+
+	// CommitDate is the date of the commit the chain is built from in RFC 3339.
+	CommitDate = ""
+)
+
+// Info is the build metadata of a chain.
+type Info struct {
+	Name          string `json:"name"`
+	AppName       string `json:"app_name"`
+	Version       string `json:"version"`
+	Commit        string `json:"commit"`
+	IgniteVersion string `json:"ignite_version"`
+	SDKVersion    string `json:"cosmos_sdk_version"`
+	GoVersion     string `json:"go_version"`
+	CommitDate    string `json:"commit_date"`
+}
+
+// Get returns the build metadata of the chain, the name, the version and the commit of the
+// chain are the ones of the version package of the Cosmos SDK.
+func Get() Info {
+	info := sdkversion.NewInfo()
+	return Info{
+		Name:          info.Name,
+		AppName:       info.AppName,
+		Version:       info.Version,
+		Commit:        info.GitCommit,
+		IgniteVersion: IgniteVersion,
+		SDKVersion:    info.CosmosSdkVersion,
+		GoVersion:     info.GoVersion,
+		CommitDate:    CommitDate,
+	}
+}
+
+// Handler returns an http handler that serves the build metadata of the chain in JSON.
+func Handler() http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(Get())
+	}
+}
+
+// Querier returns the build metadata of the chain in JSON.
+func Querier(sdk.Context, []string, abci.RequestQuery) ([]byte, error) {
+	return json.Marshal(Get())
+}
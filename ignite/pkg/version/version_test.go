@@ -0,0 +1,47 @@
+package version_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkversion "github.com/cosmos/cosmos-sdk/version"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/ignite-hq/cli/ignite/pkg/version"
+)
+
+func TestHandler(t *testing.T) {
+	sdkversion.Name = "Mars"
+	sdkversion.Version = "0.2"
+	version.IgniteVersion = "v0.22.0"
+	version.CommitDate = "2022-06-01T10:00:00Z"
+
+	res := httptest.NewRecorder()
+	version.Handler()(res, httptest.NewRequest(http.MethodGet, version.Route, nil))
+
+	require.Equal(t, http.StatusOK, res.Code)
+	require.Equal(t, "application/json", res.Header().Get("Content-Type"))
+
+	var info version.Info
+	require.NoError(t, json.NewDecoder(res.Body).Decode(&info))
+	require.Equal(t, "Mars", info.Name)
+	require.Equal(t, "0.2", info.Version)
+	require.Equal(t, "v0.22.0", info.IgniteVersion)
+	require.Equal(t, "2022-06-01T10:00:00Z", info.CommitDate)
+	require.NotEmpty(t, info.GoVersion)
+}
+
+func TestQuerier(t *testing.T) {
+	version.IgniteVersion = "v0.22.0"
+
+	res, err := version.Querier(sdk.Context{}, nil, abci.RequestQuery{})
+	require.NoError(t, err)
+
+	var info version.Info
+	require.NoError(t, json.Unmarshal(res, &info))
+	require.Equal(t, version.Get(), info)
+}
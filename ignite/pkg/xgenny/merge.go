@@ -0,0 +1,168 @@
+package xgenny
+
+import (
+	"bytes"
+)
+
+// Markers of the conflicts of a merge.
+const (
+	ConflictMarkerLocal    = "<<<<<<< local"
+	ConflictMarkerSep      = "======="
+	ConflictMarkerTemplate = ">>>>>>> template"
+)
+
+// Merge merges the changes made by a template to a file, from base to theirs, into the
+// local version of the file, ours. It's a three-way merge by lines: the changes of both
+// sides are kept and the lines changed differently by both sides are conflicts.
+// Conflicts are presented in place between the conflict markers with the lines of ours
+// first, conflict reports if there is at least one.
+func Merge(base, theirs, ours []byte) (merged []byte, conflict bool) {
+	var (
+		b = splitLines(base)
+		t = splitLines(theirs)
+		o = splitLines(ours)
+
+		// the lines of base matched in ours and theirs, -1 when the line is changed.
+		matchesO = matchLines(b, o)
+		matchesT = matchLines(b, t)
+
+		buf     bytes.Buffer
+		i, j, k int
+	)
+	for {
+		// lines unchanged on both sides.
+		for i < len(b) && matchesO[i] == j && matchesT[i] == k {
+			buf.Write(b[i])
+			i, j, k = i+1, j+1, k+1
+		}
+		if i == len(b) && j == len(o) && k == len(t) {
+			break
+		}
+
+		// the changed chunk ends at the next line of base that is unchanged on both sides.
+		end := i
+		for end < len(b) && (matchesO[end] < 0 || matchesT[end] < 0) {
+			end++
+		}
+		endO, endT := len(o), len(t)
+		if end < len(b) {
+			endO, endT = matchesO[end], matchesT[end]
+		}
+
+		chunkB, chunkO, chunkT := b[i:end], o[j:endO], t[k:endT]
+		switch {
+		case equalLines(chunkO, chunkB):
+			writeLines(&buf, chunkT)
+		case equalLines(chunkT, chunkB), equalLines(chunkO, chunkT):
+			writeLines(&buf, chunkO)
+		default:
+			conflict = true
+			buf.WriteString(ConflictMarkerLocal + "\n")
+			writeTerminatedLines(&buf, chunkO)
+			buf.WriteString(ConflictMarkerSep + "\n")
+			writeTerminatedLines(&buf, chunkT)
+			buf.WriteString(ConflictMarkerTemplate + "\n")
+		}
+		i, j, k = end, endO, endT
+	}
+	return buf.Bytes(), conflict
+}
+
+// matchLines returns the index of the lines of a matched in b, -1 for the lines of a that
+// are not in the longest common subsequence of a and b.
+func matchLines(a, b [][]byte) []int {
+	matches := make([]int, len(a))
+	for i := range matches {
+		matches[i] = -1
+	}
+
+	// common prefix and suffix are matched without computing the subsequence.
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && bytes.Equal(a[prefix], b[prefix]) {
+		matches[prefix] = prefix
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix &&
+		bytes.Equal(a[len(a)-1-suffix], b[len(b)-1-suffix]) {
+		matches[len(a)-1-suffix] = len(b) - 1 - suffix
+		suffix++
+	}
+
+	ma, mb := a[prefix:len(a)-suffix], b[prefix:len(b)-suffix]
+	if len(ma) == 0 || len(mb) == 0 {
+		return matches
+	}
+
+	// lengths[x][y] is the length of the longest common subsequence of ma[x:] and mb[y:].
+	lengths := make([][]int32, len(ma)+1)
+	for x := range lengths {
+		lengths[x] = make([]int32, len(mb)+1)
+	}
+	for x := len(ma) - 1; x >= 0; x-- {
+		for y := len(mb) - 1; y >= 0; y-- {
+			switch {
+			case bytes.Equal(ma[x], mb[y]):
+				lengths[x][y] = lengths[x+1][y+1] + 1
+			case lengths[x+1][y] >= lengths[x][y+1]:
+				lengths[x][y] = lengths[x+1][y]
+			default:
+				lengths[x][y] = lengths[x][y+1]
+			}
+		}
+	}
+	for x, y := 0, 0; x < len(ma) && y < len(mb); {
+		switch {
+		case bytes.Equal(ma[x], mb[y]):
+			matches[prefix+x] = prefix + y
+			x++
+			y++
+		case lengths[x+1][y] >= lengths[x][y+1]:
+			x++
+		default:
+			y++
+		}
+	}
+	return matches
+}
+
+// splitLines splits data in lines, keeping their line feed.
+func splitLines(data []byte) [][]byte {
+	var lines [][]byte
+	for len(data) > 0 {
+		i := bytes.IndexByte(data, '\n') + 1
+		if i == 0 {
+			i = len(data)
+		}
+		lines = append(lines, data[:i])
+		data = data[i:]
+	}
+	return lines
+}
+
+func equalLines(a, b [][]byte) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !bytes.Equal(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func writeLines(buf *bytes.Buffer, lines [][]byte) {
+	for _, line := range lines {
+		buf.Write(line)
+	}
+}
+
+// writeTerminatedLines writes lines with a line feed after the last line so the following
+// conflict marker starts a new line.
+func writeTerminatedLines(buf *bytes.Buffer, lines [][]byte) {
+	writeLines(buf, lines)
+	if len(lines) > 0 && !bytes.HasSuffix(lines[len(lines)-1], []byte("\n")) {
+		buf.WriteByte('\n')
+	}
+}
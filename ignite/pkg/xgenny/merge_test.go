@@ -0,0 +1,86 @@
+package xgenny_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite-hq/cli/ignite/pkg/xgenny"
+)
+
+func TestMergeFiles(t *testing.T) {
+	tests := []struct {
+		name         string
+		base         string
+		theirs       string
+		ours         string
+		want         string
+		wantConflict bool
+	}{
+		{
+			name:   "unchanged",
+			base:   "a\nb\nc\n",
+			theirs: "a\nb\nc\n",
+			ours:   "a\nb\nc\n",
+			want:   "a\nb\nc\n",
+		},
+		{
+			name:   "changed by the template",
+			base:   "a\nb\nc\n",
+			theirs: "a\nb\nc\nd\n",
+			ours:   "a\nb\nc\n",
+			want:   "a\nb\nc\nd\n",
+		},
+		{
+			name:   "changed locally",
+			base:   "a\nb\nc\n",
+			theirs: "a\nb\nc\n",
+			ours:   "a\nB\nc\n",
+			want:   "a\nB\nc\n",
+		},
+		{
+			name:   "changed by both",
+			base:   "a\nb\nc\nd\ne\n",
+			theirs: "a\nb\nc\nd\ne\nf\n",
+			ours:   "x\na\nB\nc\nd\ne\n",
+			want:   "x\na\nB\nc\nd\ne\nf\n",
+		},
+		{
+			name:   "same change by both",
+			base:   "a\nb\nc\n",
+			theirs: "a\nB\nc\n",
+			ours:   "a\nB\nc\n",
+			want:   "a\nB\nc\n",
+		},
+		{
+			name:   "removed locally",
+			base:   "a\nb\nc\n",
+			theirs: "a\nb\nc\nd\n",
+			ours:   "a\nc\n",
+			want:   "a\nc\nd\n",
+		},
+		{
+			name:         "conflict",
+			base:         "a\nb\nc\n",
+			theirs:       "a\nT\nc\n",
+			ours:         "a\nO\nc\n",
+			want:         "a\n<<<<<<< local\nO\n=======\nT\n>>>>>>> template\nc\n",
+			wantConflict: true,
+		},
+		{
+			name:         "conflict without line feed",
+			base:         "a\nb",
+			theirs:       "a\nT",
+			ours:         "a\nO",
+			want:         "a\n<<<<<<< local\nO\n=======\nT\n>>>>>>> template\n",
+			wantConflict: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			merged, conflict := xgenny.Merge([]byte(tt.base), []byte(tt.theirs), []byte(tt.ours))
+			require.Equal(t, tt.want, string(merged))
+			require.Equal(t, tt.wantConflict, conflict)
+		})
+	}
+}
@@ -1,15 +1,19 @@
 package xgenny
 
 import (
+	"bytes"
 	"context"
 	"errors"
+	"go/format"
 	"os"
+	"path/filepath"
 	"strings"
 
 	"github.com/gobuffalo/genny"
 	"github.com/gobuffalo/logger"
 	"github.com/gobuffalo/packd"
 
+	"github.com/ignite-hq/cli/ignite/pkg/cache"
 	"github.com/ignite-hq/cli/ignite/pkg/placeholder"
 	"github.com/ignite-hq/cli/ignite/pkg/validation"
 )
@@ -32,10 +36,37 @@ func DryRunner(ctx context.Context) *genny.Runner {
 	return runner
 }
 
+// basesCacheNamespace is the namespace of the cache of the files generated by the templates.
+const basesCacheNamespace = "xgenny.bases"
+
 // RunWithValidation checks the generators with a dry run and then execute the wet runner to the generators
 func RunWithValidation(
 	tracer *placeholder.Tracer,
 	gens ...*genny.Generator,
+) (sm SourceModification, err error) {
+	return runWithValidation(tracer, nil, gens...)
+}
+
+// RunWithMerge is RunWithValidation for files that may have been modified since they were
+// generated. The content generated from the templates for each file is cached, it is the
+// base of a three-way merge of the changes of the templates into the files modified since
+// they were generated. The files modified by a RunFn, e.g. to replace a placeholder, are
+// not merged since their modifications are made to their local content.
+// Conflicts are presented in place with markers and the conflicted files are reported in
+// the source modification.
+func RunWithMerge(
+	cacheStorage cache.Storage,
+	tracer *placeholder.Tracer,
+	gens ...*genny.Generator,
+) (sm SourceModification, err error) {
+	bases := cache.New[[]byte](cacheStorage, basesCacheNamespace)
+	return runWithValidation(tracer, &bases, gens...)
+}
+
+func runWithValidation(
+	tracer *placeholder.Tracer,
+	bases *cache.Cache[[]byte],
+	gens ...*genny.Generator,
 ) (sm SourceModification, err error) {
 	// run executes the provided runner with the provided generator
 	run := func(runner *genny.Runner, gen *genny.Generator) error {
@@ -46,8 +77,16 @@ func RunWithValidation(
 		return runner.Run()
 	}
 	for _, gen := range gens {
-		// check with a dry runner the generators
+		// check with a dry runner the generators, the files read from the disk before they
+		// are generated are modified by a RunFn rather than generated from a template.
 		dryRunner := DryRunner(context.Background())
+		modified := make(map[string]bool)
+		dryRunner.FileFn = func(f genny.File) (genny.File, error) {
+			if isOnDisk(dryRunner.Disk, f.Name()) {
+				modified[f.Name()] = true
+			}
+			return f, nil
+		}
 		if err := run(dryRunner, gen); err != nil {
 			if errors.Is(err, os.ErrNotExist) {
 				return sm, &dryRunError{err}
@@ -60,9 +99,13 @@ func RunWithValidation(
 
 		// fetch the source modification
 		sm = NewSourceModification()
+		var (
+			generated = make(map[string][]byte)
+			merged    = make(map[string][]byte)
+		)
 		for _, file := range dryRunner.Results().Files {
 			fileName := file.Name()
-			_, err := os.Stat(fileName)
+			local, err := os.ReadFile(fileName)
 
 			// nolint:gocritic
 			if os.IsNotExist(err) {
@@ -70,20 +113,101 @@ func RunWithValidation(
 				sm.AppendCreatedFiles(fileName)
 			} else if err != nil {
 				return sm, err
+			} else if modified[fileName] {
+				// the modifications of a file already include its local changes.
+				sm.AppendModifiedFiles(fileName)
+				continue
+			} else if content, conflict, ok, err := merge(bases, fileName, file.String(), local); err != nil {
+				return sm, err
+			} else if ok {
+				// the file has been modified since it was generated
+				merged[fileName] = content
+				if conflict {
+					sm.AppendConflictedFiles(fileName)
+				} else {
+					sm.AppendModifiedFiles(fileName)
+				}
 			} else {
 				// the file has been modified by the runner
 				sm.AppendModifiedFiles(fileName)
 			}
+			generated[fileName] = []byte(file.String())
 		}
 
 		// execute the modification with a wet runner
 		if err := run(genny.WetRunner(context.Background()), gen); err != nil {
 			return sm, err
 		}
+
+		for fileName, content := range merged {
+			if err := os.WriteFile(fileName, content, 0o644); err != nil {
+				return sm, err
+			}
+		}
+		if bases != nil {
+			for fileName, content := range generated {
+				if err := bases.Put(baseKey(fileName), content); err != nil {
+					return sm, err
+				}
+			}
+		}
 	}
 	return sm, nil
 }
 
+// isOnDisk checks if the file named name is on the virtual disk d.
+func isOnDisk(d *genny.Disk, name string) bool {
+	for _, f := range d.Files() {
+		if f.Name() == name {
+			return true
+		}
+	}
+	return false
+}
+
+// merge merges the content generated for a file into its local content when the file has
+// been modified since it was last generated, ok is false when no merge is needed.
+func merge(bases *cache.Cache[[]byte], fileName, generated string, local []byte) (merged []byte, conflict, ok bool, err error) {
+	if bases == nil {
+		return nil, false, false, nil
+	}
+	base, err := bases.Get(baseKey(fileName))
+	if errors.Is(err, cache.ErrorNotFound) {
+		return nil, false, false, nil
+	}
+	if err != nil {
+		return nil, false, false, err
+	}
+
+	// the scaffolded app is formatted after it is generated.
+	formattedBase := formatted(fileName, string(base))
+	if bytes.Equal(base, local) || bytes.Equal(formattedBase, local) {
+		return nil, false, false, nil
+	}
+	merged, conflict = Merge(formattedBase, formatted(fileName, generated), local)
+	return merged, conflict, true, nil
+}
+
+// formatted returns the content generated for a file as it is after the scaffolded app is
+// formatted, Go files that cannot be formatted are kept as is.
+func formatted(fileName, content string) []byte {
+	if filepath.Ext(fileName) != ".go" {
+		return []byte(content)
+	}
+	if src, err := format.Source([]byte(content)); err == nil {
+		return src
+	}
+	return []byte(content)
+}
+
+// baseKey returns the cache key of the base of a file.
+func baseKey(fileName string) string {
+	if path, err := filepath.Abs(fileName); err == nil {
+		return path
+	}
+	return fileName
+}
+
 // Box will mount each file in the Box and wrap it, already existing files are ignored
 func Box(g *genny.Generator, box packd.Walker) error {
 	return box.Walk(func(path string, bf packd.File) error {
@@ -0,0 +1,96 @@
+package xgenny_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/gobuffalo/genny"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite-hq/cli/ignite/pkg/cache"
+	"github.com/ignite-hq/cli/ignite/pkg/placeholder"
+	"github.com/ignite-hq/cli/ignite/pkg/xgenny"
+)
+
+func TestRunWithMerge(t *testing.T) {
+	var (
+		dir  = t.TempDir()
+		path = filepath.Join(dir, "list.txt")
+	)
+	cacheStorage, err := cache.NewStorage(filepath.Join(dir, "cache.db"))
+	require.NoError(t, err)
+
+	generate := func(content string) xgenny.SourceModification {
+		g := genny.New()
+		g.File(genny.NewFileS(path, content))
+		sm, err := xgenny.RunWithMerge(cacheStorage, placeholder.New(), g)
+		require.NoError(t, err)
+		return sm
+	}
+	read := func() string {
+		data, err := os.ReadFile(path)
+		require.NoError(t, err)
+		return string(data)
+	}
+
+	sm := generate("a\nb\nc\n")
+	require.Equal(t, []string{path}, sm.CreatedFiles())
+
+	// the local changes are kept when the template changes.
+	require.NoError(t, os.WriteFile(path, []byte("A\nb\nc\n"), 0o644))
+	sm = generate("a\nb\nc\nd\n")
+	require.Equal(t, "A\nb\nc\nd\n", read())
+	require.Equal(t, []string{path}, sm.ModifiedFiles())
+	require.Empty(t, sm.ConflictedFiles())
+
+	// conflicts are presented in place.
+	sm = generate("aa\nb\nc\nd\n")
+	require.Equal(t, "<<<<<<< local\nA\n=======\naa\n>>>>>>> template\nb\nc\nd\n", read())
+	require.Equal(t, []string{path}, sm.ConflictedFiles())
+
+	// files that are not modified locally are replaced.
+	require.NoError(t, os.WriteFile(path, []byte("aa\nb\nc\nd\n"), 0o644))
+	generate("aa\nbb\n")
+	require.Equal(t, "aa\nbb\n", read())
+}
+
+func TestRunWithMergeModifier(t *testing.T) {
+	var (
+		dir  = t.TempDir()
+		path = filepath.Join(dir, "app.go")
+	)
+	cacheStorage, err := cache.NewStorage(filepath.Join(dir, "cache.db"))
+	require.NoError(t, err)
+
+	// modify adds a line before the placeholder of the local file, like the app modifiers.
+	modify := func(line string) {
+		g := genny.New()
+		g.RunFn(func(r *genny.Runner) error {
+			f, err := r.Disk.Find(path)
+			if err != nil {
+				return err
+			}
+			content := strings.Replace(f.String(), "// placeholder", line+"\n// placeholder", 1)
+			return r.File(genny.NewFileS(path, content))
+		})
+		sm, err := xgenny.RunWithMerge(cacheStorage, placeholder.New(), g)
+		require.NoError(t, err)
+		require.Equal(t, []string{path}, sm.ModifiedFiles())
+		require.Empty(t, sm.ConflictedFiles())
+	}
+	read := func() string {
+		data, err := os.ReadFile(path)
+		require.NoError(t, err)
+		return string(data)
+	}
+
+	require.NoError(t, os.WriteFile(path, []byte("// placeholder\n"), 0o644))
+	modify("mars")
+
+	// the local changes next to the placeholder are kept without conflict.
+	require.NoError(t, os.WriteFile(path, []byte("mars\nuser\n// placeholder\n"), 0o644))
+	modify("venus")
+	require.Equal(t, "mars\nuser\nvenus\n// placeholder\n", read())
+}
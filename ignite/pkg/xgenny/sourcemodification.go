@@ -1,31 +1,47 @@
 package xgenny
 
+import "sort"
+
 // SourceModification describes modified and created files in the source code after a run
 type SourceModification struct {
-	modified map[string]struct{}
-	created  map[string]struct{}
+	modified   map[string]struct{}
+	created    map[string]struct{}
+	conflicted map[string]struct{}
 }
 
 func NewSourceModification() SourceModification {
 	return SourceModification{
 		make(map[string]struct{}),
 		make(map[string]struct{}),
+		make(map[string]struct{}),
 	}
 }
 
-// ModifiedFiles returns the modified files of the source modification
+// ModifiedFiles returns the modified files of the source modification sorted by path
 func (sm SourceModification) ModifiedFiles() (modifiedFiles []string) {
 	for modified := range sm.modified {
 		modifiedFiles = append(modifiedFiles, modified)
 	}
+	sort.Strings(modifiedFiles)
 	return
 }
 
-// CreatedFiles returns the created files of the source modification
+// CreatedFiles returns the created files of the source modification sorted by path
 func (sm SourceModification) CreatedFiles() (createdFiles []string) {
 	for created := range sm.created {
 		createdFiles = append(createdFiles, created)
 	}
+	sort.Strings(createdFiles)
+	return
+}
+
+// ConflictedFiles returns the modified files of the source modification with merge conflicts
+// sorted by path
+func (sm SourceModification) ConflictedFiles() (conflictedFiles []string) {
+	for conflicted := range sm.conflicted {
+		conflictedFiles = append(conflictedFiles, conflicted)
+	}
+	sort.Strings(conflictedFiles)
 	return
 }
 
@@ -51,8 +67,17 @@ func (sm *SourceModification) AppendCreatedFiles(createdFiles ...string) {
 	}
 }
 
+// AppendConflictedFiles appends modified files with merge conflicts in the source modification
+func (sm *SourceModification) AppendConflictedFiles(conflictedFiles ...string) {
+	sm.AppendModifiedFiles(conflictedFiles...)
+	for _, conflictedFile := range conflictedFiles {
+		sm.conflicted[conflictedFile] = struct{}{}
+	}
+}
+
 // Merge merges new source modification to an existing one
 func (sm *SourceModification) Merge(newSm SourceModification) {
 	sm.AppendModifiedFiles(newSm.ModifiedFiles()...)
 	sm.AppendCreatedFiles(newSm.CreatedFiles()...)
+	sm.AppendConflictedFiles(newSm.ConflictedFiles()...)
 }
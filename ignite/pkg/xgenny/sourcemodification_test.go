@@ -81,3 +81,9 @@ func TestMerge(t *testing.T) {
 	require.Subset(t, sm1.ModifiedFiles(), []string{"foo1", "foo2", "foo3", "foo4", "foo5"})
 	require.Subset(t, sm1.CreatedFiles(), []string{"bar1", "bar2", "bar3"})
 }
+
+func TestSourceModificationSorted(t *testing.T) {
+	sm := sourceModificationExample()
+	require.Equal(t, []string{"mbar", "mfoo", "mfoobar"}, sm.ModifiedFiles())
+	require.Equal(t, []string{"cbar", "cfoo", "cfoobar"}, sm.CreatedFiles())
+}
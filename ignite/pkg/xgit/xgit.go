@@ -1,11 +1,19 @@
 package xgit
 
 import (
+	"errors"
+	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
 )
 
+// ErrNoTags is returned when a repository does not have any tags.
+var ErrNoTags = errors.New("repository has no tags")
+
 func AreChangesCommitted(appPath string) (bool, error) {
 	appPath, err := filepath.Abs(appPath)
 	if err != nil {
@@ -31,3 +39,93 @@ func AreChangesCommitted(appPath string) (bool, error) {
 	}
 	return ws.IsClean(), nil
 }
+
+// LatestTag returns the name of the most recently created tag in the repository at path.
+func LatestTag(path string) (string, error) {
+	repository, err := git.PlainOpen(path)
+	if err != nil {
+		return "", err
+	}
+
+	tags, err := repository.Tags()
+	if err != nil {
+		return "", err
+	}
+
+	var (
+		latest     string
+		latestTime time.Time
+	)
+
+	err = tags.ForEach(func(ref *plumbing.Reference) error {
+		var when time.Time
+
+		// annotated tags keep their own creation time, lightweight tags
+		// point directly to a commit.
+		if tag, err := repository.TagObject(ref.Hash()); err == nil {
+			when = tag.Tagger.When
+		} else {
+			commit, err := repository.CommitObject(ref.Hash())
+			if err != nil {
+				return err
+			}
+			when = commit.Committer.When
+		}
+
+		if latest == "" || when.After(latestTime) {
+			latest = ref.Name().Short()
+			latestTime = when
+		}
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	if latest == "" {
+		return "", ErrNoTags
+	}
+	return latest, nil
+}
+
+// ExtractDir writes the contents of dir as it is at the given revision of the repository
+// at path into the dst directory. revision can be a tag, branch or commit hash.
+func ExtractDir(path, revision, dir, dst string) error {
+	repository, err := git.PlainOpen(path)
+	if err != nil {
+		return err
+	}
+
+	hash, err := repository.ResolveRevision(plumbing.Revision(revision))
+	if err != nil {
+		return err
+	}
+
+	commit, err := repository.CommitObject(*hash)
+	if err != nil {
+		return err
+	}
+
+	tree, err := commit.Tree()
+	if err != nil {
+		return err
+	}
+
+	dirTree, err := tree.Tree(filepath.ToSlash(dir))
+	if err != nil {
+		return err
+	}
+
+	return dirTree.Files().ForEach(func(f *object.File) error {
+		content, err := f.Contents()
+		if err != nil {
+			return err
+		}
+
+		filePath := filepath.Join(dst, filepath.FromSlash(f.Name))
+		if err := os.MkdirAll(filepath.Dir(filePath), 0755); err != nil {
+			return err
+		}
+		return os.WriteFile(filePath, []byte(content), 0644)
+	})
+}
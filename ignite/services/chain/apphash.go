@@ -0,0 +1,139 @@
+package chain
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ignite-hq/cli/ignite/pkg/cache"
+)
+
+// maxReportedChanges is the max number of changed files listed when the app hash mismatches.
+const maxReportedChanges = 10
+
+// appHashMismatchLogs are parts of the logs of a node whose app hash differs from the app
+// hash of its saved state, e.g. when a change of the logic of the app breaks determinism.
+var appHashMismatchLogs = []string{
+	"wrong Block.Header.AppHash",
+	"AppHash does not match",
+	"app hash mismatch",
+}
+
+// IsAppHashMismatch checks if the app failed to start because its app hash differs from the
+// app hash of the saved state.
+func (e *CannotStartAppError) IsAppHashMismatch() bool {
+	errorLogs := e.Err.Error()
+	for _, log := range appHashMismatchLogs {
+		if strings.Contains(errorLogs, log) {
+			return true
+		}
+	}
+	return false
+}
+
+// reportAppHashMismatch explains an app hash mismatch and lists the source files changed
+// since the build of the app that produced the saved state, they likely broke the determinism
+// of the app.
+func (c *Chain) reportAppHashMismatch(out io.Writer, cacheStorage cache.Storage) {
+	fmt.Fprintf(out, "%s\n", errorColor("The app hash doesn't match the app hash of the saved state."))
+
+	changes, err := c.sourceChangesSinceState(cacheStorage)
+	if err != nil || len(changes) == 0 {
+		return
+	}
+	fmt.Fprintln(out, "Files changed since the app of the saved state was built, they likely break the determinism of the app:")
+	for i, path := range changes {
+		if i == maxReportedChanges {
+			fmt.Fprintf(out, "  ... and %d more\n", len(changes)-maxReportedChanges)
+			break
+		}
+		fmt.Fprintf(out, "  %s\n", path)
+	}
+}
+
+// saveStateSourceTime saves builtAt as the time the source of the app producing the saved
+// state was built at, once the node of the app answers.
+func (c *Chain) saveStateSourceTime(ctx context.Context, cacheStorage cache.Storage, builtAt time.Time) {
+	if err := c.WaitServed(ctx); err != nil {
+		return
+	}
+	stateSource := cache.New[time.Time](cacheStorage, serveStateSourceCacheNamespace)
+	_ = stateSource.Put(c.app.Path, builtAt)
+}
+
+// sourceChangesSinceState returns the source files of the app modified after the source of
+// the app producing the saved state was built, the most recent first.
+func (c *Chain) sourceChangesSinceState(cacheStorage cache.Storage) ([]string, error) {
+	stateSource := cache.New[time.Time](cacheStorage, serveStateSourceCacheNamespace)
+	builtAt, err := stateSource.Get(c.app.Path)
+	if err != nil {
+		return nil, err
+	}
+	return sourceChangesSince(c.app.Path, builtAt)
+}
+
+// sourceChangesSince returns the source files of the app at appPath modified after t, the
+// most recent first. Hidden files and generated files are ignored.
+func sourceChangesSince(appPath string, t time.Time) ([]string, error) {
+	var (
+		changes []string
+		modTime = make(map[string]time.Time)
+	)
+	for _, dir := range appBackendSourceWatchPaths {
+		err := filepath.WalkDir(filepath.Join(appPath, dir), func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				if os.IsNotExist(err) {
+					return nil
+				}
+				return err
+			}
+			if strings.HasPrefix(d.Name(), ".") {
+				if d.IsDir() {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+			if d.IsDir() || isIgnoredSource(d.Name()) {
+				return nil
+			}
+
+			info, err := d.Info()
+			if err != nil {
+				return err
+			}
+			if !info.ModTime().After(t) {
+				return nil
+			}
+			rel, err := filepath.Rel(appPath, path)
+			if err != nil {
+				return err
+			}
+			changes = append(changes, rel)
+			modTime[rel] = info.ModTime()
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	sort.SliceStable(changes, func(i, j int) bool {
+		return modTime[changes[i]].After(modTime[changes[j]])
+	})
+	return changes, nil
+}
+
+func isIgnoredSource(name string) bool {
+	for _, ext := range ignoredExts {
+		if strings.HasSuffix(name, "."+ext) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,100 @@
+package chain
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite-hq/cli/ignite/pkg/cache"
+)
+
+func TestIsAppHashMismatch(t *testing.T) {
+	tests := []struct {
+		name string
+		logs string
+		want bool
+	}{
+		{
+			name: "wrong app hash",
+			logs: "Error: error during handshake: error on replay: wrong Block.Header.AppHash.  Expected 4F2E, got 8A1C",
+			want: true,
+		},
+		{
+			name: "app hash after replay",
+			logs: "Error: error during handshake: error on replay: state.AppHash does not match AppHash after replay",
+			want: true,
+		},
+		{
+			name: "other error",
+			logs: "Error: listen tcp 0.0.0.0:26657: bind: address already in use",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := &CannotStartAppError{"mars", fmt.Errorf("exit status 1: %w", errors.New(tt.logs))}
+			require.Equal(t, tt.want, err.IsAppHashMismatch())
+		})
+	}
+}
+
+func TestSourceChangesSince(t *testing.T) {
+	appPath := t.TempDir()
+	write := func(path string, modTime time.Time) {
+		path = filepath.Join(appPath, path)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, nil, 0o644))
+		require.NoError(t, os.Chtimes(path, modTime, modTime))
+	}
+
+	now := time.Now()
+	write("x/mars/keeper/msg_server.go", now.Add(-time.Minute))
+	write("x/mars/keeper/keeper.go", now.Add(-time.Hour))
+	write("x/mars/types/tx.pb.go", now.Add(-time.Minute))
+	write("app/app.go", now.Add(-2*time.Minute))
+	write("app/.app.go.swp", now.Add(-time.Minute))
+	write("docs/openapi.yml", now.Add(-time.Minute))
+
+	changes, err := sourceChangesSince(appPath, now.Add(-30*time.Minute))
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		filepath.Join("x", "mars", "keeper", "msg_server.go"),
+		filepath.Join("app", "app.go"),
+	}, changes)
+}
+
+func TestSourceChangesSinceState(t *testing.T) {
+	var (
+		appPath = t.TempDir()
+		c       = &Chain{app: App{Path: appPath}}
+		now     = time.Now()
+	)
+	cacheStorage, err := cache.NewStorage(filepath.Join(t.TempDir(), "cache.db"))
+	require.NoError(t, err)
+
+	// the state source time is unknown until a build of the app is served.
+	_, err = c.sourceChangesSinceState(cacheStorage)
+	require.ErrorIs(t, err, cache.ErrorNotFound)
+
+	for path, modTime := range map[string]time.Time{
+		"x/mars/keeper/msg_server.go": now.Add(-time.Hour),
+		"x/mars/keeper/keeper.go":     now.Add(-2 * time.Hour),
+	} {
+		path = filepath.Join(appPath, path)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, nil, 0o644))
+		require.NoError(t, os.Chtimes(path, modTime, modTime))
+	}
+
+	// the edit of msg_server.go is reported even if blocks were committed after it.
+	stateSource := cache.New[time.Time](cacheStorage, serveStateSourceCacheNamespace)
+	require.NoError(t, stateSource.Put(appPath, now.Add(-90*time.Minute)))
+
+	changes, err := c.sourceChangesSinceState(cacheStorage)
+	require.NoError(t, err)
+	require.Equal(t, []string{filepath.Join("x", "mars", "keeper", "msg_server.go")}, changes)
+}
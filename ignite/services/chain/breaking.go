@@ -0,0 +1,40 @@
+package chain
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+
+	"github.com/ignite-hq/cli/ignite/pkg/protoanalysis"
+	"github.com/ignite-hq/cli/ignite/pkg/xgit"
+)
+
+// BreakingChanges compares the app's proto files with their version at the given git
+// revision and returns the changes that break wire or JSON compatibility.
+// When revision is empty, the latest tag of the app's repository is used.
+func (c *Chain) BreakingChanges(ctx context.Context, revision string) ([]protoanalysis.BreakingChange, error) {
+	conf, err := c.Config()
+	if err != nil {
+		return nil, err
+	}
+
+	if revision == "" {
+		if revision, err = xgit.LatestTag(c.app.Path); err != nil {
+			return nil, errors.Wrap(err, "cannot find a previous release to compare protos with")
+		}
+	}
+
+	previousPath, err := os.MkdirTemp("", "")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(previousPath)
+
+	if err := xgit.ExtractDir(c.app.Path, revision, conf.Build.Proto.Path, previousPath); err != nil {
+		return nil, errors.Wrapf(err, "cannot read protos at %s", revision)
+	}
+
+	return protoanalysis.DetectBreakingChanges(ctx, previousPath, filepath.Join(c.app.Path, conf.Build.Proto.Path))
+}
@@ -7,6 +7,7 @@ import (
 	"os"
 	"path/filepath"
 	"runtime"
+	"time"
 
 	"github.com/docker/docker/pkg/archive"
 	"github.com/pkg/errors"
@@ -20,6 +21,7 @@ import (
 	"github.com/ignite-hq/cli/ignite/pkg/goanalysis"
 	"github.com/ignite-hq/cli/ignite/pkg/gocmd"
 	"github.com/ignite-hq/cli/ignite/pkg/xstrings"
+	igniteversion "github.com/ignite-hq/cli/ignite/version"
 )
 
 const (
@@ -27,6 +29,10 @@ const (
 	releaseChecksumKey           = "release_checksum"
 	modChecksumKey               = "go_mod_checksum"
 	buildDirchangeCacheNamespace = "build.dirchange"
+
+	// chainVersionPkg is the package of the build metadata served by the chains importing it,
+	// scaffolded chains serve the build metadata of their app package.
+	chainVersionPkg = "github.com/ignite-hq/cli/ignite/pkg/version"
 )
 
 // Build builds and installs app binaries.
@@ -70,7 +76,12 @@ func (c *Chain) build(ctx context.Context, cacheStorage cache.Storage, output st
 		return err
 	}
 
-	return gocmd.BuildPath(ctx, output, binary, path, buildFlags)
+	env, err := c.buildEnv()
+	if err != nil {
+		return err
+	}
+
+	return gocmd.BuildPath(ctx, output, binary, path, buildFlags, exec.StepOption(step.Env(env...)))
 }
 
 // BuildRelease builds binaries for a release. targets is a list
@@ -104,6 +115,11 @@ func (c *Chain) BuildRelease(ctx context.Context, cacheStorage cache.Storage, ou
 		return "", err
 	}
 
+	buildEnv, err := c.buildEnv()
+	if err != nil {
+		return "", err
+	}
+
 	releasePath = output
 	if releasePath == "" {
 		releasePath = filepath.Join(c.app.Path, releaseDir)
@@ -130,11 +146,13 @@ func (c *Chain) BuildRelease(ctx context.Context, cacheStorage cache.Storage, ou
 		}
 		defer os.RemoveAll(out)
 
+		env := append([]string{
+			cmdrunner.Env(gocmd.EnvGOOS, goos),
+			cmdrunner.Env(gocmd.EnvGOARCH, goarch),
+		}, buildEnv...)
+
 		buildOptions := []exec.Option{
-			exec.StepOption(step.Env(
-				cmdrunner.Env(gocmd.EnvGOOS, goos),
-				cmdrunner.Env(gocmd.EnvGOARCH, goarch),
-			)),
+			exec.StepOption(step.Env(env...)),
 		}
 
 		if err := gocmd.BuildPath(ctx, out, binary, mainPath, buildFlags, buildOptions...); err != nil {
@@ -186,10 +204,25 @@ func (c *Chain) preBuild(ctx context.Context, cacheStorage cache.Storage) (build
 		fmt.Sprintf("-X github.com/cosmos/cosmos-sdk/version.Commit=%s", c.sourceVersion.hash),
 		fmt.Sprintf("-X %s/cmd/%s/cmd.ChainID=%s", c.app.ImportPath, c.app.D(), chainID),
 	)
+
+	// the commit date is used instead of the build date so release builds are reproducible.
+	var commitDate string
+	if !c.sourceVersion.time.IsZero() {
+		commitDate = c.sourceVersion.time.UTC().Format(time.RFC3339)
+	}
+	for _, pkg := range []string{chainVersionPkg, c.app.ImportPath + "/app"} {
+		ldFlags = append(ldFlags,
+			fmt.Sprintf("-X %s.IgniteVersion=%s", pkg, igniteversion.Version),
+			fmt.Sprintf("-X %s.CommitDate=%s", pkg, commitDate),
+		)
+	}
 	buildFlags = []string{
 		gocmd.FlagMod, gocmd.FlagModValueReadOnly,
 		gocmd.FlagLdflags, gocmd.Ldflags(ldFlags...),
 	}
+	if len(config.Build.Tags) > 0 {
+		buildFlags = append(buildFlags, gocmd.FlagTags, gocmd.Tags(config.Build.Tags...))
+	}
 
 	fmt.Fprintln(c.stdLog().out, "📦 Installing dependencies...")
 
@@ -220,6 +253,24 @@ func (c *Chain) preBuild(ctx context.Context, cacheStorage cache.Storage) (build
 	return buildFlags, nil
 }
 
+// buildEnv returns the environment variables to use when building the app's binary.
+func (c *Chain) buildEnv() (env []string, err error) {
+	conf, err := c.Config()
+	if err != nil {
+		return nil, err
+	}
+
+	if conf.Build.CGO != nil {
+		cgoEnabled := "0"
+		if *conf.Build.CGO {
+			cgoEnabled = "1"
+		}
+		env = append(env, cmdrunner.Env(gocmd.EnvCGOEnabled, cgoEnabled))
+	}
+
+	return env, nil
+}
+
 func (c *Chain) discoverMain(path string) (pkgPath string, err error) {
 	conf, err := c.Config()
 	if err != nil {
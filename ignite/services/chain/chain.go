@@ -4,10 +4,13 @@ import (
 	"context"
 	"io"
 	"os"
+	"os/exec"
 	"path/filepath"
+	"time"
 
 	"github.com/go-git/go-git/v5"
 	"github.com/gookit/color"
+	"github.com/pkg/errors"
 	"github.com/tendermint/spn/pkg/chainid"
 
 	"github.com/ignite-hq/cli/ignite/chainconfig"
@@ -36,6 +39,7 @@ var (
 type version struct {
 	tag  string
 	hash string
+	time time.Time
 }
 
 type LogLvl int
@@ -62,6 +66,9 @@ type Chain struct {
 	serveRefresher chan struct{}
 	served         bool
 
+	// sourceBuiltAt is the time the source of the app was read by its last build.
+	sourceBuiltAt time.Time
+
 	// protoBuiltAtLeastOnce indicates that app's proto generation at least made once.
 	protoBuiltAtLeastOnce bool
 
@@ -85,6 +92,19 @@ type chainOptions struct {
 
 	// path of a custom config file
 	ConfigFile string
+
+	// prebuiltBinary is the name or path of a prebuilt binary used instead of
+	// the one compiled from the source code.
+	prebuiltBinary string
+
+	// corsAllowedOrigins overwrites the allowed CORS origins of the config.
+	corsAllowedOrigins []string
+
+	// host overwrites the hosts of the config.
+	host *chainconfig.Host
+
+	// faucetHost overwrites the host of the faucet of the config.
+	faucetHost string
 }
 
 // Option configures Chain.
@@ -125,6 +145,36 @@ func ConfigFile(configFile string) Option {
 	}
 }
 
+// PrebuiltBinary sets the name or path of a prebuilt binary to use instead of
+// the one that is compiled from the app's source code.
+func PrebuiltBinary(binary string) Option {
+	return func(c *Chain) {
+		c.options.prebuiltBinary = binary
+	}
+}
+
+// CORSAllowedOrigins sets the origins allowed to query the node from browsers,
+// it overwrites the cors section of the config.
+func CORSAllowedOrigins(origins ...string) Option {
+	return func(c *Chain) {
+		c.options.corsAllowedOrigins = origins
+	}
+}
+
+// Host overwrites the addresses the node listens on, e.g. to serve chains side by side.
+func Host(host chainconfig.Host) Option {
+	return func(c *Chain) {
+		c.options.host = &host
+	}
+}
+
+// FaucetHost overwrites the address the faucet listens on.
+func FaucetHost(host string) Option {
+	return func(c *Chain) {
+		c.options.faucetHost = host
+	}
+}
+
 // EnableThirdPartyModuleCodegen enables code generation for third party modules,
 // including the SDK.
 func EnableThirdPartyModuleCodegen() Option {
@@ -187,6 +237,7 @@ func (c *Chain) appVersion() (v version, err error) {
 
 	v.hash = ver.Hash
 	v.tag = ver.Tag
+	v.time = ver.Time
 
 	return v, nil
 }
@@ -220,11 +271,27 @@ func (c *Chain) ConfigPath() string {
 
 // Config returns the config of the chain
 func (c *Chain) Config() (chainconfig.Config, error) {
-	configPath := c.ConfigPath()
-	if configPath == "" {
-		return chainconfig.DefaultConf, nil
+	conf := chainconfig.DefaultConf
+	if configPath := c.ConfigPath(); configPath != "" {
+		var err error
+		if conf, err = chainconfig.ParseFile(configPath); err != nil {
+			return chainconfig.Config{}, err
+		}
+	}
+	if len(c.options.corsAllowedOrigins) > 0 {
+		conf.CORS.AllowedOrigins = c.options.corsAllowedOrigins
+		if err := conf.CORS.Validate(); err != nil {
+			return chainconfig.Config{}, err
+		}
 	}
-	return chainconfig.ParseFile(configPath)
+	if c.options.host != nil {
+		conf.Host = *c.options.host
+	}
+	if c.options.faucetHost != "" {
+		conf.Faucet.Host = c.options.faucetHost
+		conf.Faucet.Port = 0
+	}
+	return conf, nil
 }
 
 // ID returns the chain's id.
@@ -264,6 +331,10 @@ func (c *Chain) Name() string {
 
 // Binary returns the name of app's default (appd) binary.
 func (c *Chain) Binary() (string, error) {
+	if c.options.prebuiltBinary != "" {
+		return c.options.prebuiltBinary, nil
+	}
+
 	conf, err := c.Config()
 	if err != nil {
 		return "", err
@@ -276,6 +347,20 @@ func (c *Chain) Binary() (string, error) {
 	return c.app.D(), nil
 }
 
+// CheckBinary makes sure that the app's binary can be found either
+// as a path or by looking it up in $PATH.
+func (c *Chain) CheckBinary() error {
+	binary, err := c.Binary()
+	if err != nil {
+		return err
+	}
+
+	if _, err := exec.LookPath(binary); err != nil {
+		return errors.Wrapf(err, "cannot find the %q binary, build it or provide the path of a prebuilt one", binary)
+	}
+	return nil
+}
+
 // SetHome sets the chain home directory.
 func (c *Chain) SetHome(home string) {
 	c.options.homePath = home
@@ -417,6 +502,19 @@ func (c *Chain) KeyringBackend() (chaincmd.KeyringBackend, error) {
 
 // Commands returns the runner execute commands on the chain's binary
 func (c *Chain) Commands(ctx context.Context) (chaincmdrunner.Runner, error) {
+	return c.commands(ctx)
+}
+
+// commandsWithGas returns the runner of the commands of the txs sent with the gas settings.
+func (c *Chain) commandsWithGas(ctx context.Context, gas chainconfig.Gas) (chaincmdrunner.Runner, error) {
+	return c.commands(ctx,
+		chaincmd.WithGasPrices(gas.GasPrice),
+		chaincmd.WithGasAdjustment(gas.GasAdjustment),
+		chaincmd.WithMaxGas(gas.MaxGas),
+	)
+}
+
+func (c *Chain) commands(ctx context.Context, options ...chaincmd.Option) (chaincmdrunner.Runner, error) {
 	id, err := c.ID()
 	if err != nil {
 		return chaincmdrunner.Runner{}, err
@@ -454,6 +552,7 @@ func (c *Chain) Commands(ctx context.Context) (chaincmdrunner.Runner, error) {
 		chaincmd.WithNodeAddress(nodeAddr),
 		chaincmd.WithKeyringBackend(backend),
 	}
+	chainCommandOptions = append(chainCommandOptions, options...)
 
 	cc := chaincmd.New(binary, chainCommandOptions...)
 
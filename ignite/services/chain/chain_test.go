@@ -17,6 +17,7 @@ func TestSourceVersion(t *testing.T) {
 
 		assert.Equal(t, "0.2", c.sourceVersion.tag)
 		assert.Equal(t, "503123b1ac552437c7db3d17f816fd4121ff400d", c.sourceVersion.hash)
+		assert.EqualValues(t, 1631344538, c.sourceVersion.time.Unix())
 	})
 
 	t.Run("tagged older commit", func(t *testing.T) {
@@ -25,6 +26,7 @@ func TestSourceVersion(t *testing.T) {
 
 		assert.Equal(t, "0.2-aae48b7f", c.sourceVersion.tag)
 		assert.Equal(t, "aae48b7ffa4991bbe229f0969db8fe8623bf1fd4", c.sourceVersion.hash)
+		assert.EqualValues(t, 1631344696, c.sourceVersion.time.Unix())
 	})
 }
 
@@ -0,0 +1,415 @@
+package chain
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ignite-hq/cli/ignite/chainconfig"
+	"github.com/ignite-hq/cli/ignite/pkg/cache"
+	chaincmdrunner "github.com/ignite-hq/cli/ignite/pkg/chaincmd/runner"
+	"github.com/ignite-hq/cli/ignite/pkg/cmdrunner/exec"
+	"github.com/ignite-hq/cli/ignite/pkg/cmdrunner/step"
+	"github.com/ignite-hq/cli/ignite/pkg/dirchange"
+	"github.com/ignite-hq/cli/ignite/pkg/xexec"
+)
+
+const (
+	// ContractsDir is the directory of the CosmWasm contracts of the app, one contract
+	// per subdirectory.
+	ContractsDir = "contracts"
+
+	// ArtifactsDir is the directory of the optimized wasm code of the contracts.
+	ArtifactsDir = "artifacts"
+
+	// DefaultWasmOptimizerImage is the Docker image of the rust-optimizer.
+	DefaultWasmOptimizerImage = "cosmwasm/rust-optimizer:0.12.6"
+
+	// contractsChecksumKey is the cache key for the checksum to detect contracts modification.
+	contractsChecksumKey = "contracts_checksum"
+
+	// contractsStoreAttempts is the number of attempts to reach the node while the chain starts.
+	contractsStoreAttempts = 20
+
+	contractsStoreRetryDelay = 3 * time.Second
+)
+
+// ErrNoContracts is returned when the app has no contracts to build.
+var ErrNoContracts = errors.New("no contracts found in " + ContractsDir)
+
+// Contract is the wasm code of a contract stored on the chain.
+type Contract struct {
+	Path   string
+	CodeID uint64
+}
+
+// BuildContracts builds the contracts of the app with the rust-optimizer in Docker and copies
+// their optimized wasm code in the artifacts directory of the app. It returns the paths of
+// the wasm files.
+// The build caches of the contracts are kept in Docker volumes to speed up the next builds.
+func (c *Chain) BuildContracts(ctx context.Context) ([]string, error) {
+	if !xexec.IsCommandAvailable("docker") {
+		return nil, errors.New("docker is required to build the contracts")
+	}
+
+	conf, err := c.Config()
+	if err != nil {
+		return nil, err
+	}
+	image := conf.Build.Wasm.Image
+	if image == "" {
+		image = DefaultWasmOptimizerImage
+	}
+
+	manifests, err := filepath.Glob(filepath.Join(c.app.Path, ContractsDir, "*", "Cargo.toml"))
+	if err != nil {
+		return nil, err
+	}
+	if len(manifests) == 0 {
+		return nil, ErrNoContracts
+	}
+
+	artifactsPath := filepath.Join(c.app.Path, ArtifactsDir)
+	if err := os.MkdirAll(artifactsPath, 0755); err != nil {
+		return nil, err
+	}
+
+	var artifacts []string
+	for _, manifest := range manifests {
+		contractPath, err := filepath.Abs(filepath.Dir(manifest))
+		if err != nil {
+			return nil, err
+		}
+		name := filepath.Base(contractPath)
+
+		fmt.Fprintf(c.stdLog().out, "🦀 Optimizing contract %s...\n", name)
+
+		if err := exec.Exec(ctx, []string{
+			"docker", "run", "--rm",
+			"-v", contractPath + ":/code",
+			"--mount", fmt.Sprintf("type=volume,source=%s_cache,target=/code/target", name),
+			"--mount", "type=volume,source=registry_cache,target=/usr/local/cargo/registry",
+			image,
+		},
+			exec.StepOption(step.Stdout(c.stdLog().out)),
+			exec.StepOption(step.Stderr(c.stdLog().err)),
+		); err != nil {
+			return nil, fmt.Errorf("cannot optimize contract %s: %w", name, err)
+		}
+
+		wasmFiles, err := filepath.Glob(filepath.Join(contractPath, ArtifactsDir, "*.wasm"))
+		if err != nil {
+			return nil, err
+		}
+		for _, wasmFile := range wasmFiles {
+			data, err := os.ReadFile(wasmFile)
+			if err != nil {
+				return nil, err
+			}
+			artifact := filepath.Join(artifactsPath, filepath.Base(wasmFile))
+			if err := os.WriteFile(artifact, data, 0644); err != nil {
+				return nil, err
+			}
+			artifacts = append(artifacts, artifact)
+		}
+	}
+
+	sort.Strings(artifacts)
+	return artifacts, nil
+}
+
+// Artifacts returns the paths of the wasm files in the artifacts directory of the app.
+func (c *Chain) Artifacts() ([]string, error) {
+	artifacts, err := filepath.Glob(filepath.Join(c.app.Path, ArtifactsDir, "*.wasm"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(artifacts)
+	return artifacts, nil
+}
+
+// StoreContracts stores the wasm files of the artifacts directory on the chain.
+// The contracts are stored by the from account of the wasm config, the first account
+// of the config by default.
+func (c *Chain) StoreContracts(ctx context.Context) ([]Contract, error) {
+	conf, err := c.Config()
+	if err != nil {
+		return nil, err
+	}
+
+	artifacts, err := c.Artifacts()
+	if err != nil {
+		return nil, err
+	}
+
+	from := contractsAccount(conf)
+	commands, err := c.accountCommands(ctx, conf, from)
+	if err != nil {
+		return nil, err
+	}
+
+	var contracts []Contract
+	for _, artifact := range artifacts {
+		codeID, err := commands.WasmStore(ctx, from, artifact)
+		if err != nil {
+			return nil, fmt.Errorf("cannot store %s: %w", filepath.Base(artifact), err)
+		}
+		contracts = append(contracts, Contract{Path: artifact, CodeID: codeID})
+	}
+	return contracts, nil
+}
+
+// DeployedContract is a contract of the config instantiated on the chain.
+type DeployedContract struct {
+	Name    string
+	Address string
+	CodeID  uint64
+}
+
+// DeployContracts instantiates the contracts declared in the config and applies their migrations
+// in order. The wasm code of the contracts is stored first, the code ids of stored are reused.
+func (c *Chain) DeployContracts(ctx context.Context, stored []Contract) ([]DeployedContract, error) {
+	conf, err := c.Config()
+	if err != nil {
+		return nil, err
+	}
+
+	codeIDs := make(map[string]uint64)
+	for _, contract := range stored {
+		codeIDs[contract.Path] = contract.CodeID
+	}
+	store := func(commands chaincmdrunner.Runner, from, code, wasm string) (uint64, error) {
+		path, err := c.contractWasm(code, wasm)
+		if err != nil {
+			return 0, err
+		}
+		if codeID, ok := codeIDs[path]; ok {
+			return codeID, nil
+		}
+		codeID, err := commands.WasmStore(ctx, from, path)
+		if err != nil {
+			return 0, fmt.Errorf("cannot store %s: %w", filepath.Base(path), err)
+		}
+		codeIDs[path] = codeID
+		return codeID, nil
+	}
+
+	var deployed []DeployedContract
+	for _, contract := range conf.Contracts {
+		from := contract.From
+		if from == "" {
+			from = contractsAccount(conf)
+		}
+		commands, err := c.accountCommands(ctx, conf, from)
+		if err != nil {
+			return nil, err
+		}
+
+		codeID, err := store(commands, from, contract.Code, contract.Wasm)
+		if err != nil {
+			return nil, fmt.Errorf("contract %s: %w", contract.Name, err)
+		}
+
+		// the deployer is the admin by default so the migrations can be applied.
+		admin := contract.Instantiate.Admin
+		if admin == "" {
+			admin = from
+		}
+		if admin, err = c.AccountAddress(ctx, admin); err != nil {
+			return nil, fmt.Errorf("contract %s: admin: %w", contract.Name, err)
+		}
+
+		msg, err := contractMsg(contract.Instantiate.Msg)
+		if err != nil {
+			return nil, fmt.Errorf("contract %s: instantiate msg: %w", contract.Name, err)
+		}
+		label := contract.Instantiate.Label
+		if label == "" {
+			label = contract.Name
+		}
+		funds := strings.Join(contract.Instantiate.Funds, ",")
+
+		address, err := commands.WasmInstantiate(ctx, from, codeID, msg, label, admin, funds)
+		if err != nil {
+			return nil, fmt.Errorf("cannot instantiate contract %s: %w", contract.Name, err)
+		}
+
+		for i, migration := range contract.Migrations {
+			if codeID, err = store(commands, from, migration.Code, migration.Wasm); err != nil {
+				return nil, fmt.Errorf("contract %s: migration %d: %w", contract.Name, i+1, err)
+			}
+			msg, err := contractMsg(migration.Msg)
+			if err != nil {
+				return nil, fmt.Errorf("contract %s: migration %d: %w", contract.Name, i+1, err)
+			}
+			if err := commands.WasmMigrate(ctx, from, address, codeID, msg); err != nil {
+				return nil, fmt.Errorf("cannot migrate contract %s (migration %d): %w", contract.Name, i+1, err)
+			}
+		}
+
+		deployed = append(deployed, DeployedContract{Name: contract.Name, Address: address, CodeID: codeID})
+	}
+	return deployed, nil
+}
+
+// runContracts stores the contracts and deploys the contracts of the config once the node of
+// the chain is up. Errors are printed and don't stop the chain.
+func (c *Chain) runContracts(ctx context.Context, store, deploy bool) error {
+	if err := c.storeAndDeployContracts(ctx, store, deploy); err != nil && !errors.Is(err, context.Canceled) {
+		fmt.Fprintf(c.stdLog().err, "%s\n", errorColor(fmt.Sprintf("contracts not deployed: %s", err)))
+	}
+	return nil
+}
+
+func (c *Chain) storeAndDeployContracts(ctx context.Context, store, deploy bool) error {
+	if err := c.waitForNode(ctx); err != nil {
+		return err
+	}
+
+	var stored []Contract
+	if store {
+		var err error
+		if stored, err = c.StoreContracts(ctx); err != nil {
+			return err
+		}
+		for _, contract := range stored {
+			fmt.Fprintf(c.stdLog().out, "📜 Contract %s stored with code id %d\n", filepath.Base(contract.Path), contract.CodeID)
+		}
+	}
+
+	if deploy {
+		deployed, err := c.DeployContracts(ctx, stored)
+		if err != nil {
+			return err
+		}
+		for _, contract := range deployed {
+			fmt.Fprintf(c.stdLog().out, "📜 Contract %s deployed at %s with code id %d\n", contract.Name, contract.Address, contract.CodeID)
+		}
+	}
+	return nil
+}
+
+// waitForNode waits until the node of the chain answers, it returns the last error when
+// the node is not up after the attempts.
+func (c *Chain) waitForNode(ctx context.Context) error {
+	commands, err := c.Commands(ctx)
+	if err != nil {
+		return err
+	}
+	for attempt := 1; ; attempt++ {
+		if _, err = commands.Status(ctx); err == nil || attempt == contractsStoreAttempts {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(contractsStoreRetryDelay):
+		}
+	}
+}
+
+// contractsSourcePaths returns the paths of the sources of the contracts relative to the app,
+// the artifacts of the contracts are excluded so building them doesn't change the sources.
+func (c *Chain) contractsSourcePaths() ([]string, error) {
+	var paths []string
+	for _, pattern := range []string{"src", "Cargo.toml", "Cargo.lock"} {
+		matches, err := filepath.Glob(filepath.Join(c.app.Path, ContractsDir, "*", pattern))
+		if err != nil {
+			return nil, err
+		}
+		for _, match := range matches {
+			path, err := filepath.Rel(c.app.Path, match)
+			if err != nil {
+				return nil, err
+			}
+			paths = append(paths, path)
+		}
+	}
+	sort.Strings(paths)
+	return paths, nil
+}
+
+// buildContractsIfChanged builds the contracts when their sources changed since the last
+// build, it returns true when they are built.
+func (c *Chain) buildContractsIfChanged(ctx context.Context, dirCache cache.Cache[[]byte]) (bool, error) {
+	paths, err := c.contractsSourcePaths()
+	if err != nil {
+		return false, err
+	}
+	changed, err := dirchange.HasDirChecksumChanged(dirCache, contractsChecksumKey, c.app.Path, paths...)
+	if err != nil || !changed {
+		return false, err
+	}
+	if _, err := c.BuildContracts(ctx); err != nil {
+		return false, err
+	}
+	return true, dirchange.SaveDirChecksum(dirCache, contractsChecksumKey, c.app.Path, paths...)
+}
+
+// contractWasm returns the path of the wasm code of a contract, wasm is used when set, the
+// artifact built by the optimizer from the source in code otherwise.
+func (c *Chain) contractWasm(code, wasm string) (string, error) {
+	path := wasm
+	if path == "" {
+		name, err := crateName(filepath.Join(c.app.Path, code))
+		if err != nil {
+			return "", err
+		}
+		path = filepath.Join(ArtifactsDir, name+".wasm")
+	}
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(c.app.Path, path)
+	}
+	if _, err := os.Stat(path); err != nil {
+		if os.IsNotExist(err) && wasm == "" {
+			return "", fmt.Errorf("wasm code of %s not found, build the contracts with the optimizer", code)
+		}
+		return "", err
+	}
+	return path, nil
+}
+
+// crateNameRe matches the name of the package of a Cargo manifest.
+var crateNameRe = regexp.MustCompile(`(?m)^\s*name\s*=\s*"([^"]+)"`)
+
+// crateName returns the name of the wasm artifact of the crate in dir, the optimizer names the
+// artifacts after the crates with dashes replaced by underscores.
+func crateName(dir string) (string, error) {
+	manifest, err := os.ReadFile(filepath.Join(dir, "Cargo.toml"))
+	if err != nil {
+		return "", err
+	}
+	name := filepath.Base(dir)
+	if m := crateNameRe.FindSubmatch(manifest); m != nil {
+		name = string(m[1])
+	}
+	return strings.ReplaceAll(name, "-", "_"), nil
+}
+
+// contractMsg returns the JSON of a contract message, an empty message is an empty object.
+func contractMsg(msg map[string]interface{}) (string, error) {
+	if msg == nil {
+		return "{}", nil
+	}
+	data, err := json.Marshal(msg)
+	return string(data), err
+}
+
+// contractsAccount returns the name of the account that stores the contracts.
+func contractsAccount(conf chainconfig.Config) string {
+	if conf.Build.Wasm.From != "" {
+		return conf.Build.Wasm.From
+	}
+	if len(conf.Accounts) > 0 {
+		return conf.Accounts[0].Name
+	}
+	return ""
+}
@@ -0,0 +1,81 @@
+package chain
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite-hq/cli/ignite/chainconfig"
+)
+
+func TestContractsSourcePaths(t *testing.T) {
+	app := t.TempDir()
+	for _, path := range []string{
+		"contracts/counter/Cargo.toml",
+		"contracts/counter/src/contract.rs",
+		"contracts/counter/artifacts/counter.wasm",
+		"contracts/escrow/Cargo.toml",
+		"contracts/escrow/Cargo.lock",
+	} {
+		path = filepath.Join(app, path)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, os.WriteFile(path, nil, 0644))
+	}
+
+	c := &Chain{app: App{Path: app}}
+	paths, err := c.contractsSourcePaths()
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"contracts/counter/Cargo.toml",
+		"contracts/counter/src",
+		"contracts/escrow/Cargo.lock",
+		"contracts/escrow/Cargo.toml",
+	}, paths)
+}
+
+func TestContractsAccount(t *testing.T) {
+	conf := chainconfig.Config{Accounts: []chainconfig.Account{{Name: "alice"}, {Name: "bob"}}}
+	require.Equal(t, "alice", contractsAccount(conf))
+
+	conf.Build.Wasm.From = "bob"
+	require.Equal(t, "bob", contractsAccount(conf))
+}
+
+func TestContractWasm(t *testing.T) {
+	app := t.TempDir()
+	for path, content := range map[string]string{
+		"contracts/cw-counter/Cargo.toml": "[package]\nname = \"cw-counter\"\nversion = \"0.1.0\"\n",
+		"artifacts/cw_counter.wasm":       "",
+		"wasm/escrow.wasm":                "",
+	} {
+		path = filepath.Join(app, path)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+	}
+
+	c := &Chain{app: App{Path: app}}
+
+	path, err := c.contractWasm("contracts/cw-counter", "")
+	require.NoError(t, err)
+	require.Equal(t, filepath.Join(app, "artifacts/cw_counter.wasm"), path)
+
+	path, err = c.contractWasm("", "wasm/escrow.wasm")
+	require.NoError(t, err)
+	require.Equal(t, filepath.Join(app, "wasm/escrow.wasm"), path)
+
+	require.NoError(t, os.Remove(filepath.Join(app, "artifacts/cw_counter.wasm")))
+	_, err = c.contractWasm("contracts/cw-counter", "")
+	require.Error(t, err)
+}
+
+func TestContractMsg(t *testing.T) {
+	msg, err := contractMsg(nil)
+	require.NoError(t, err)
+	require.Equal(t, "{}", msg)
+
+	msg, err = contractMsg(map[string]interface{}{"count": 1, "owner": map[string]interface{}{"name": "alice"}})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"count":1,"owner":{"name":"alice"}}`, msg)
+}
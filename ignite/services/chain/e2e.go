@@ -0,0 +1,165 @@
+package chain
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ignite-hq/cli/ignite/chainconfig"
+	"github.com/ignite-hq/cli/ignite/pkg/xurl"
+)
+
+// e2eWaitDelay is the delay between the checks of the node while the chain starts.
+const e2eWaitDelay = time.Second
+
+// Endpoints are the endpoints and the accounts of a served chain, they are passed to the
+// end-to-end tests of the chain.
+type Endpoints struct {
+	ChainID        string
+	Home           string
+	KeyringBackend string
+	RPC            string
+	API            string
+	GRPC           string
+
+	// Faucet is empty when the faucet is not enabled.
+	Faucet string
+
+	// Accounts are the addresses of the accounts of the config by name.
+	Accounts map[string]string
+
+	// Mnemonics are the mnemonics of the accounts by name, the mnemonics of the accounts
+	// imported by address are unknown.
+	Mnemonics map[string]string
+}
+
+// Endpoints returns the endpoints and the accounts of the chain, the chain must be served.
+func (c *Chain) Endpoints(ctx context.Context) (Endpoints, error) {
+	return c.endpoints(ctx, true)
+}
+
+// endpoints returns the endpoints of the chain, with the addresses of the accounts when
+// withAccounts is set.
+func (c *Chain) endpoints(ctx context.Context, withAccounts bool) (Endpoints, error) {
+	conf, err := c.Config()
+	if err != nil {
+		return Endpoints{}, err
+	}
+
+	e := Endpoints{
+		Accounts:  make(map[string]string),
+		Mnemonics: make(map[string]string),
+	}
+	if e.ChainID, err = c.ID(); err != nil {
+		return Endpoints{}, err
+	}
+	if e.Home, err = c.Home(); err != nil {
+		return Endpoints{}, err
+	}
+	backend, err := c.KeyringBackend()
+	if err != nil {
+		return Endpoints{}, err
+	}
+	e.KeyringBackend = string(backend)
+
+	if e.RPC, err = localURL(conf.Host.RPC); err != nil {
+		return Endpoints{}, err
+	}
+	if e.API, err = localURL(conf.Host.API); err != nil {
+		return Endpoints{}, err
+	}
+	if e.GRPC, err = localAddress(conf.Host.GRPC); err != nil {
+		return Endpoints{}, err
+	}
+	if conf.Faucet.Name != nil {
+		if e.Faucet, err = localURL(chainconfig.FaucetHost(conf)); err != nil {
+			return Endpoints{}, err
+		}
+	}
+
+	if !withAccounts {
+		return e, nil
+	}
+	generated, err := readAccountMnemonics(e.Home)
+	if err != nil {
+		return Endpoints{}, err
+	}
+	for _, account := range conf.Accounts {
+		if e.Accounts[account.Name], err = c.AccountAddress(ctx, account.Name); err != nil {
+			return Endpoints{}, err
+		}
+		if mnemonic := account.Mnemonic; mnemonic != "" {
+			e.Mnemonics[account.Name] = mnemonic
+		} else if mnemonic, ok := generated[account.Name]; ok {
+			e.Mnemonics[account.Name] = mnemonic
+		}
+	}
+	return e, nil
+}
+
+// Env returns the endpoints as environment variables prefixed by prefix, e.g. IGNITE_E2E_RPC.
+// The address of each account is set in <prefix>ACCOUNT_<NAME>, its mnemonic when it is known
+// in <prefix>ACCOUNT_<NAME>_MNEMONIC and the list of the names of the accounts in <prefix>ACCOUNTS.
+func (e Endpoints) Env(prefix string) []string {
+	env := []string{
+		prefix + "CHAIN_ID=" + e.ChainID,
+		prefix + "HOME=" + e.Home,
+		prefix + "KEYRING_BACKEND=" + e.KeyringBackend,
+		prefix + "RPC=" + e.RPC,
+		prefix + "API=" + e.API,
+		prefix + "GRPC=" + e.GRPC,
+	}
+	if e.Faucet != "" {
+		env = append(env, prefix+"FAUCET="+e.Faucet)
+	}
+
+	names := make([]string, 0, len(e.Accounts))
+	for name := range e.Accounts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		env = append(env, fmt.Sprintf("%sACCOUNT_%s=%s", prefix, envKey(name), e.Accounts[name]))
+		if mnemonic, ok := e.Mnemonics[name]; ok {
+			env = append(env, fmt.Sprintf("%sACCOUNT_%s_MNEMONIC=%s", prefix, envKey(name), mnemonic))
+		}
+	}
+	env = append(env, prefix+"ACCOUNTS="+strings.Join(names, ","))
+	return env
+}
+
+// WaitServed waits until the node of the served chain answers or ctx is canceled, the
+// chain may be built first.
+func (c *Chain) WaitServed(ctx context.Context) error {
+	commands, err := c.Commands(ctx)
+	if err != nil {
+		return err
+	}
+	for {
+		if _, err := commands.Status(ctx); err == nil {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(e2eWaitDelay):
+		}
+	}
+}
+
+// envKey returns the name of an account in the key of an env var.
+func envKey(name string) string {
+	return strings.ToUpper(strings.NewReplacer("-", "_", ".", "_").Replace(name))
+}
+
+// localURL returns the HTTP URL to reach host from the local machine.
+func localURL(host string) (string, error) {
+	address, err := localAddress(host)
+	if err != nil {
+		return "", err
+	}
+	return xurl.HTTP(address)
+}
@@ -0,0 +1,94 @@
+package chain
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pelletier/go-toml"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite-hq/cli/ignite/chainconfig"
+)
+
+func TestEndpointsEnv(t *testing.T) {
+	e := Endpoints{
+		ChainID:        "mars",
+		Home:           "/tmp/mars",
+		KeyringBackend: "test",
+		RPC:            "http://127.0.0.1:26657",
+		API:            "http://127.0.0.1:1317",
+		GRPC:           "127.0.0.1:9090",
+		Accounts: map[string]string{
+			"bob":        "cosmos1bob",
+			"alice-test": "cosmos1alice",
+		},
+	}
+	require.Equal(t, []string{
+		"E2E_CHAIN_ID=mars",
+		"E2E_HOME=/tmp/mars",
+		"E2E_KEYRING_BACKEND=test",
+		"E2E_RPC=http://127.0.0.1:26657",
+		"E2E_API=http://127.0.0.1:1317",
+		"E2E_GRPC=127.0.0.1:9090",
+		"E2E_ACCOUNT_ALICE_TEST=cosmos1alice",
+		"E2E_ACCOUNT_BOB=cosmos1bob",
+		"E2E_ACCOUNTS=alice-test,bob",
+	}, e.Env("E2E_"))
+
+	e.Faucet = "http://127.0.0.1:4500"
+	require.Contains(t, e.Env("E2E_"), "E2E_FAUCET=http://127.0.0.1:4500")
+
+	e.Mnemonics = map[string]string{"bob": "bob mnemonic"}
+	require.Contains(t, e.Env("E2E_"), "E2E_ACCOUNT_BOB_MNEMONIC=bob mnemonic")
+}
+
+func TestLocalURL(t *testing.T) {
+	url, err := localURL("0.0.0.0:26657")
+	require.NoError(t, err)
+	require.Equal(t, "http://127.0.0.1:26657", url)
+
+	url, err = localURL(":4500")
+	require.NoError(t, err)
+	require.Equal(t, "http://127.0.0.1:4500", url)
+}
+
+func TestPeerEndpoints(t *testing.T) {
+	e := Endpoints{
+		ChainID: "mars",
+		Home:    "/tmp/mars",
+		RPC:     "http://127.0.0.1:26657",
+		API:     "http://127.0.0.1:1317",
+		GRPC:    "127.0.0.1:9090",
+		Faucet:  "http://127.0.0.1:4500",
+	}
+	peer, err := e.PeerEndpoints(chainconfig.Host{
+		RPC:  "0.0.0.0:36657",
+		API:  "0.0.0.0:11317",
+		GRPC: "0.0.0.0:19090",
+	})
+	require.NoError(t, err)
+	require.Equal(t, Endpoints{
+		ChainID: "mars",
+		Home:    "/tmp/mars",
+		RPC:     "http://127.0.0.1:36657",
+		API:     "http://127.0.0.1:11317",
+		GRPC:    "127.0.0.1:19090",
+		Faucet:  "http://127.0.0.1:4500",
+	}, peer)
+}
+
+func TestConfigurePeerTOML(t *testing.T) {
+	home := t.TempDir()
+	path := filepath.Join(home, "config/config.toml")
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+	require.NoError(t, os.WriteFile(path, []byte("[p2p]\nladdr = \"tcp://0.0.0.0:26656\"\n"), 0644))
+
+	require.NoError(t, configurePeerTOML(home, "abc@127.0.0.1:26656"))
+
+	config, err := toml.LoadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, "abc@127.0.0.1:26656", config.Get("p2p.persistent_peers"))
+	require.Equal(t, true, config.Get("p2p.allow_duplicate_ip"))
+	require.Equal(t, "tcp://0.0.0.0:26656", config.Get("p2p.laddr"))
+}
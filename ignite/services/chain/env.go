@@ -0,0 +1,122 @@
+package chain
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ignite-hq/cli/ignite/pkg/cache"
+	"github.com/ignite-hq/cli/ignite/pkg/tendermintrpc"
+)
+
+// accountMnemonicsPath is the path, relative to the home, of the mnemonics of the accounts
+// generated when the chain is initialized.
+const accountMnemonicsPath = "config/mnemonics.json"
+
+// shellEnvKeys are the names of the variables of ShellEnv that differ from Endpoints.Env.
+var shellEnvKeys = map[string]string{
+	"HOME": "CHAIN_HOME",
+	"RPC":  "NODE",
+}
+
+// ShellEnv returns the environment variables of the chain for shells and CI scripts:
+//
+//	CHAIN_ID, CHAIN_HOME, KEYRING_BACKEND    chain
+//	NODE, API, GRPC, FAUCET                  endpoints, FAUCET when the faucet is enabled
+//	ACCOUNTS                                 names of the accounts of the config
+//	ACCOUNT_<NAME>                           address of an account
+//	ACCOUNT_<NAME>_MNEMONIC                  mnemonic of an account, set in the config or generated
+//
+// While the chain is served, the variables are the endpoints and the accounts of the served
+// chain. Otherwise the endpoints are read from the config and the accounts from the home of
+// the chain, so they are only set once the chain is initialized.
+func (c *Chain) ShellEnv(ctx context.Context, cacheStorage cache.Storage) ([]string, error) {
+	e, err := c.servedEndpoints(ctx, cacheStorage)
+	if errors.Is(err, cache.ErrorNotFound) {
+		var initialized bool
+		if initialized, err = c.IsInitialized(); err != nil {
+			return nil, err
+		}
+		e, err = c.endpoints(ctx, initialized)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return shellEnv(e), nil
+}
+
+// shellEnv returns the environment variables of e named like the ones of ShellEnv.
+func shellEnv(e Endpoints) []string {
+	env := e.Env("")
+	for i, v := range env {
+		key, value, _ := strings.Cut(v, "=")
+		if name, ok := shellEnvKeys[key]; ok {
+			env[i] = name + "=" + value
+		}
+	}
+	return env
+}
+
+// saveServedEndpoints saves the endpoints of the chain once its node answers and deletes
+// them when ctx is canceled, i.e. when the chain is not served anymore.
+func (c *Chain) saveServedEndpoints(ctx context.Context, cacheStorage cache.Storage) {
+	if err := c.WaitServed(ctx); err != nil {
+		return
+	}
+	e, err := c.Endpoints(ctx)
+	if err != nil {
+		return
+	}
+	served := cache.New[Endpoints](cacheStorage, serveEndpointsCacheNamespace)
+	key := cache.Key(c.app.Path, e.Home)
+	if err := served.Put(key, e); err != nil {
+		return
+	}
+	<-ctx.Done()
+	_ = served.Delete(key)
+}
+
+// servedEndpoints returns the endpoints saved by the serve of the chain. It returns
+// cache.ErrorNotFound when the chain is not served.
+func (c *Chain) servedEndpoints(ctx context.Context, cacheStorage cache.Storage) (Endpoints, error) {
+	home, err := c.Home()
+	if err != nil {
+		return Endpoints{}, err
+	}
+	served := cache.New[Endpoints](cacheStorage, serveEndpointsCacheNamespace)
+	e, err := served.Get(cache.Key(c.app.Path, home))
+	if err != nil {
+		return Endpoints{}, err
+	}
+
+	// endpoints of a serve that was killed are stale.
+	if status, err := tendermintrpc.New(e.RPC).Status(ctx); err != nil || status.Network != e.ChainID {
+		return Endpoints{}, cache.ErrorNotFound
+	}
+	return e, nil
+}
+
+// readAccountMnemonics returns the mnemonics of the accounts generated in home by name.
+func readAccountMnemonics(home string) (map[string]string, error) {
+	mnemonics := make(map[string]string)
+	data, err := os.ReadFile(filepath.Join(home, accountMnemonicsPath))
+	if os.IsNotExist(err) {
+		return mnemonics, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return mnemonics, json.Unmarshal(data, &mnemonics)
+}
+
+// writeAccountMnemonics writes the mnemonics of the accounts generated in home by name.
+func writeAccountMnemonics(home string, mnemonics map[string]string) error {
+	data, err := json.MarshalIndent(mnemonics, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(home, accountMnemonicsPath), data, 0600)
+}
@@ -0,0 +1,101 @@
+package chain
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite-hq/cli/ignite/pkg/cache"
+)
+
+func TestShellEnv(t *testing.T) {
+	e := Endpoints{
+		ChainID:        "mars",
+		Home:           "/tmp/mars",
+		KeyringBackend: "test",
+		RPC:            "http://127.0.0.1:26657",
+		API:            "http://127.0.0.1:1317",
+		GRPC:           "127.0.0.1:9090",
+		Faucet:         "http://127.0.0.1:4500",
+		Accounts: map[string]string{
+			"bob":   "cosmos1bob",
+			"alice": "cosmos1alice",
+		},
+		Mnemonics: map[string]string{
+			"alice": "alice mnemonic",
+		},
+	}
+	require.Equal(t, []string{
+		"CHAIN_ID=mars",
+		"CHAIN_HOME=/tmp/mars",
+		"KEYRING_BACKEND=test",
+		"NODE=http://127.0.0.1:26657",
+		"API=http://127.0.0.1:1317",
+		"GRPC=127.0.0.1:9090",
+		"FAUCET=http://127.0.0.1:4500",
+		"ACCOUNT_ALICE=cosmos1alice",
+		"ACCOUNT_ALICE_MNEMONIC=alice mnemonic",
+		"ACCOUNT_BOB=cosmos1bob",
+		"ACCOUNTS=alice,bob",
+	}, shellEnv(e))
+}
+
+func TestServedEndpoints(t *testing.T) {
+	var (
+		ctx     = context.Background()
+		appPath = t.TempDir()
+		home    = t.TempDir()
+		c       = &Chain{app: App{Path: appPath}, options: chainOptions{homePath: home}}
+		network = "mars"
+	)
+	cacheStorage, err := cache.NewStorage(filepath.Join(t.TempDir(), "cache.db"))
+	require.NoError(t, err)
+
+	node := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"result":{"node_info":{"network":%q}}}`, network)
+	}))
+	defer node.Close()
+
+	// the endpoints are unknown until the chain is served.
+	_, err = c.servedEndpoints(ctx, cacheStorage)
+	require.ErrorIs(t, err, cache.ErrorNotFound)
+
+	e := Endpoints{ChainID: "mars", Home: home, RPC: node.URL}
+	served := cache.New[Endpoints](cacheStorage, serveEndpointsCacheNamespace)
+	require.NoError(t, served.Put(cache.Key(appPath, home), e))
+
+	got, err := c.servedEndpoints(ctx, cacheStorage)
+	require.NoError(t, err)
+	require.Equal(t, e, got)
+
+	// the endpoints are stale when another chain answers or when the node is down.
+	network = "venus"
+	_, err = c.servedEndpoints(ctx, cacheStorage)
+	require.ErrorIs(t, err, cache.ErrorNotFound)
+
+	node.Close()
+	_, err = c.servedEndpoints(ctx, cacheStorage)
+	require.ErrorIs(t, err, cache.ErrorNotFound)
+}
+
+func TestAccountMnemonics(t *testing.T) {
+	home := t.TempDir()
+
+	// no account is generated in a new home.
+	mnemonics, err := readAccountMnemonics(home)
+	require.NoError(t, err)
+	require.Empty(t, mnemonics)
+
+	require.NoError(t, os.MkdirAll(filepath.Join(home, "config"), 0o755))
+	require.NoError(t, writeAccountMnemonics(home, map[string]string{"alice": "alice mnemonic"}))
+
+	mnemonics, err = readAccountMnemonics(home)
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"alice": "alice mnemonic"}, mnemonics)
+}
@@ -1,16 +1,24 @@
 package chain
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/json"
 	"fmt"
 	"os"
 	"time"
 
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/pkg/errors"
 
+	"github.com/ignite-hq/cli/ignite/chainconfig"
+	"github.com/ignite-hq/cli/ignite/pkg/cache"
 	chaincmdrunner "github.com/ignite-hq/cli/ignite/pkg/chaincmd/runner"
 	"github.com/ignite-hq/cli/ignite/pkg/cosmosfaucet"
+	"github.com/ignite-hq/cli/ignite/pkg/cosmosutil"
+	"github.com/ignite-hq/cli/ignite/pkg/i18n"
 	"github.com/ignite-hq/cli/ignite/pkg/xurl"
 )
 
@@ -39,7 +47,7 @@ func (c *Chain) Faucet(ctx context.Context) (cosmosfaucet.Faucet, error) {
 		return cosmosfaucet.Faucet{}, err
 	}
 
-	commands, err := c.Commands(ctx)
+	commands, err := c.commandsWithGas(ctx, conf.Faucet.Gas)
 	if err != nil {
 		return cosmosfaucet.Faucet{}, err
 	}
@@ -106,6 +114,156 @@ func (c *Chain) Faucet(ctx context.Context) (cosmosfaucet.Faucet, error) {
 		faucetOptions = append(faucetOptions, cosmosfaucet.RefreshWindow(rateLimitWindow))
 	}
 
+	apiKeys, err := c.faucetServerAPIKeys()
+	if err != nil {
+		return cosmosfaucet.Faucet{}, err
+	}
+	faucetOptions = append(faucetOptions, cosmosfaucet.APIKeys(apiKeys...))
+	if conf.Faucet.RequireAPIKey {
+		faucetOptions = append(faucetOptions, cosmosfaucet.RequireAPIKey())
+	}
+
 	// init the faucet with options and return.
 	return cosmosfaucet.New(ctx, commands, faucetOptions...)
 }
+
+// RotateFaucetKey creates a new faucet account with name in the chain's keyring, transfers
+// all the balances of the current faucet account, but the fee of the transfer, to it and
+// updates config.yml to use the new account. The previous key is kept in the keyring. The
+// rename of the faucet account doesn't reset the state of a served chain, its faucet is
+// restarted with the new account.
+func (c *Chain) RotateFaucetKey(ctx context.Context, name string) (chaincmdrunner.Account, error) {
+	conf, err := c.Config()
+	if err != nil {
+		return chaincmdrunner.Account{}, err
+	}
+
+	if conf.Faucet.Name == nil {
+		return chaincmdrunner.Account{}, ErrFaucetIsNotEnabled
+	}
+
+	// the transfer has a fixed gas limit so the fee kept for it is known.
+	gas := conf.Faucet.Gas
+	if gas.MaxGas == 0 {
+		gas.MaxGas = flags.DefaultGasLimit
+	}
+	gas.GasAdjustment = 0
+
+	commands, err := c.commandsWithGas(ctx, gas)
+	if err != nil {
+		return chaincmdrunner.Account{}, err
+	}
+
+	current, err := commands.ShowAccount(ctx, *conf.Faucet.Name)
+	if err != nil {
+		if err == chaincmdrunner.ErrAccountDoesNotExist {
+			return chaincmdrunner.Account{}, ErrFaucetAccountDoesNotExist
+		}
+		return chaincmdrunner.Account{}, err
+	}
+
+	balances, err := commands.BankBalances(ctx, current.Address)
+	if err != nil {
+		return chaincmdrunner.Account{}, err
+	}
+	fee, err := cosmosutil.Fee(gas.GasPrice, gas.MaxGas)
+	if err != nil {
+		return chaincmdrunner.Account{}, err
+	}
+	if balances, err = cosmosutil.SubtractFee(balances, fee); err != nil {
+		return chaincmdrunner.Account{}, err
+	}
+
+	var coinType string
+	if acc, found := conf.AccountByName(*conf.Faucet.Name); found {
+		coinType = acc.CoinType
+	}
+
+	account, err := commands.AddAccount(ctx, name, "", coinType)
+	if err != nil {
+		return chaincmdrunner.Account{}, err
+	}
+
+	if !balances.IsZero() {
+		if err := transferBalances(ctx, commands, current.Address, account.Address, balances); err != nil {
+			// remove the new key when the funds cannot be moved to it, so the rotation
+			// can be retried with the same name.
+			_ = commands.DeleteAccount(ctx, name)
+			return chaincmdrunner.Account{}, err
+		}
+	}
+
+	if err := chainconfig.UpdateFaucetName(c.ConfigPath(), name); err != nil {
+		return chaincmdrunner.Account{}, err
+	}
+
+	return account, nil
+}
+
+// transferBalances sends balances from an address to another and waits for the tx.
+func transferBalances(ctx context.Context, commands chaincmdrunner.Runner, from, to string, balances sdk.Coins) error {
+	txHash, err := commands.BankSend(ctx, from, to, balances.String())
+	if err != nil {
+		return err
+	}
+	return commands.WaitTx(ctx, txHash, time.Second, 30)
+}
+
+// configModifiedForReset checks if the modifications of the config since the chain was last
+// served reset its state. Renaming the faucet account, like RotateFaucetKey does, keeps the
+// state when the renamed account exists in the keyring.
+func (c *Chain) configModifiedForReset(ctx context.Context, dirCache cache.Cache[[]byte], conf chainconfig.Config) (bool, error) {
+	saved, err := dirCache.Get(configResetChecksumKey)
+	if errors.Is(err, cache.ErrorNotFound) {
+		return true, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	checksum, err := configResetChecksum(conf)
+	if err != nil {
+		return false, err
+	}
+	if !bytes.Equal(saved, checksum) {
+		return true, nil
+	}
+	if conf.Faucet.Name == nil {
+		return false, nil
+	}
+
+	commands, err := c.Commands(ctx)
+	if err != nil {
+		return false, err
+	}
+	_, err = commands.ShowAccount(ctx, *conf.Faucet.Name)
+	switch {
+	case err == chaincmdrunner.ErrAccountDoesNotExist:
+		return true, nil
+	case err != nil:
+		return false, err
+	}
+	fmt.Fprintln(c.stdLog().out, "🔑", i18n.T("Faucet account renamed, keeping the app state..."))
+	return false, nil
+}
+
+// configResetChecksum returns the checksum of conf without the name of the faucet account.
+func configResetChecksum(conf chainconfig.Config) ([]byte, error) {
+	if conf.Faucet.Name != nil {
+		accounts := make([]chainconfig.Account, len(conf.Accounts))
+		copy(accounts, conf.Accounts)
+		for i := range accounts {
+			if accounts[i].Name == *conf.Faucet.Name {
+				accounts[i].Name = ""
+			}
+		}
+		conf.Accounts = accounts
+		conf.Faucet.Name = new(string)
+	}
+
+	data, err := json.Marshal(conf)
+	if err != nil {
+		return nil, err
+	}
+	checksum := sha256.Sum256(data)
+	return checksum[:], nil
+}
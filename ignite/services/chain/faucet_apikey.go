@@ -0,0 +1,184 @@
+package chain
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/goccy/go-yaml"
+
+	"github.com/ignite-hq/cli/ignite/pkg/cosmosfaucet"
+	"github.com/ignite-hq/cli/ignite/pkg/ctxticker"
+)
+
+// FaucetAPIKeysFile is the file of the API keys of the faucet in the directory of the chain.
+// It only holds the hashes of the keys so it can be committed.
+const FaucetAPIKeysFile = "faucet_api_keys.yml"
+
+const faucetAPIKeysHeader = "# API keys of the faucet, managed with ignite chain faucet api-key.\n"
+
+// faucetAPIKeysReloadInterval is the interval of the checks of the changes of the API keys
+// file while the faucet serves.
+const faucetAPIKeysReloadInterval = time.Second
+
+// FaucetAPIKey is an API key of the faucet and its limits.
+type FaucetAPIKey struct {
+	// Name identifies the holder of the key.
+	Name string `yaml:"name"`
+
+	// KeyHash is the SHA-256 hash of the key.
+	KeyHash string `yaml:"key_hash"`
+
+	// Denoms are the denoms that can be requested with the key, all of them when empty.
+	Denoms []string `yaml:"denoms,omitempty"`
+
+	// CoinsMax holds the max amounts of the denoms that can be transferred to a single
+	// account with the key, they replace faucet.coins_max.
+	CoinsMax []string `yaml:"coins_max,omitempty"`
+
+	// Domains are the domains of the web pages allowed to use the key, all when empty.
+	Domains []string `yaml:"domains,omitempty"`
+}
+
+// FaucetAPIKeys returns the API keys of the faucet.
+func (c *Chain) FaucetAPIKeys() ([]FaucetAPIKey, error) {
+	data, err := os.ReadFile(c.faucetAPIKeysPath())
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var keys []FaucetAPIKey
+	if err := yaml.Unmarshal(data, &keys); err != nil {
+		return nil, fmt.Errorf("%s: %w", FaucetAPIKeysFile, err)
+	}
+	return keys, nil
+}
+
+// AddFaucetAPIKey creates a new API key for the faucet with the name and limits of k and
+// returns the key. Only the hash of the key is saved, the key cannot be shown again.
+func (c *Chain) AddFaucetAPIKey(k FaucetAPIKey) (key string, err error) {
+	if k.Name == "" {
+		return "", errors.New("name of the API key is required")
+	}
+	keys, err := c.FaucetAPIKeys()
+	if err != nil {
+		return "", err
+	}
+	for _, existing := range keys {
+		if existing.Name == k.Name {
+			return "", fmt.Errorf("API key %q already exists", k.Name)
+		}
+	}
+
+	if key, k.KeyHash, err = cosmosfaucet.NewAPIKey(); err != nil {
+		return "", err
+	}
+	if _, err := k.faucetAPIKey(); err != nil {
+		return "", err
+	}
+	return key, c.saveFaucetAPIKeys(append(keys, k))
+}
+
+// RevokeFaucetAPIKey removes the API key of the faucet named name.
+func (c *Chain) RevokeFaucetAPIKey(name string) error {
+	keys, err := c.FaucetAPIKeys()
+	if err != nil {
+		return err
+	}
+	for i, k := range keys {
+		if k.Name == name {
+			return c.saveFaucetAPIKeys(append(keys[:i], keys[i+1:]...))
+		}
+	}
+	return fmt.Errorf("API key %q does not exist", name)
+}
+
+func (c *Chain) saveFaucetAPIKeys(keys []FaucetAPIKey) error {
+	data, err := yaml.Marshal(keys)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(c.faucetAPIKeysPath(), append([]byte(faucetAPIKeysHeader), data...), 0o644)
+}
+
+func (c *Chain) faucetAPIKeysPath() string {
+	return filepath.Join(c.app.Path, FaucetAPIKeysFile)
+}
+
+// faucetServerAPIKeys returns the API keys of the faucet server.
+func (c *Chain) faucetServerAPIKeys() ([]cosmosfaucet.APIKey, error) {
+	keys, err := c.FaucetAPIKeys()
+	if err != nil {
+		return nil, err
+	}
+	serverKeys := make([]cosmosfaucet.APIKey, 0, len(keys))
+	for _, k := range keys {
+		key, err := k.faucetAPIKey()
+		if err != nil {
+			return nil, err
+		}
+		serverKeys = append(serverKeys, key)
+	}
+	return serverKeys, nil
+}
+
+// reloadFaucetAPIKeys replaces the API keys of faucet each time the API keys file changes
+// until ctx is canceled, so the keys added or revoked while the chain is served are applied
+// without restarting the faucet. The keys are kept when the file is invalid.
+func (c *Chain) reloadFaucetAPIKeys(ctx context.Context, faucet cosmosfaucet.Faucet) error {
+	// the keys are reloaded on the first check since the file may have changed after the
+	// faucet was created.
+	var (
+		last   []byte
+		loaded bool
+	)
+	err := ctxticker.Do(ctx, faucetAPIKeysReloadInterval, func() error {
+		data, err := os.ReadFile(c.faucetAPIKeysPath())
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		if loaded && bytes.Equal(data, last) {
+			return nil
+		}
+		last, loaded = data, true
+
+		keys, err := c.faucetServerAPIKeys()
+		if err != nil {
+			fmt.Fprintf(c.stdLog().err, "%s\n", errorColor(fmt.Sprintf("API keys of the faucet not reloaded: %s", err)))
+			return nil
+		}
+		faucet.SetAPIKeys(keys...)
+		return nil
+	})
+	if errors.Is(err, context.Canceled) {
+		return nil
+	}
+	return err
+}
+
+// faucetAPIKey returns the API key of the faucet server.
+func (k FaucetAPIKey) faucetAPIKey() (cosmosfaucet.APIKey, error) {
+	key := cosmosfaucet.APIKey{
+		Name:     k.Name,
+		Hash:     k.KeyHash,
+		Denoms:   k.Denoms,
+		CoinsMax: make(map[string]uint64),
+		Domains:  k.Domains,
+	}
+	for _, coin := range k.CoinsMax {
+		parsed, err := sdk.ParseCoinNormalized(coin)
+		if err != nil {
+			return cosmosfaucet.APIKey{}, fmt.Errorf("API key %q: %w: %s", k.Name, err, coin)
+		}
+		key.CoinsMax[parsed.Denom] = parsed.Amount.Uint64()
+	}
+	return key, nil
+}
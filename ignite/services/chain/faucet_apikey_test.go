@@ -0,0 +1,91 @@
+package chain
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	chaincmdrunner "github.com/ignite-hq/cli/ignite/pkg/chaincmd/runner"
+	"github.com/ignite-hq/cli/ignite/pkg/cosmosfaucet"
+)
+
+func TestFaucetAPIKeys(t *testing.T) {
+	c := &Chain{app: App{Path: t.TempDir()}}
+
+	keys, err := c.FaucetAPIKeys()
+	require.NoError(t, err)
+	require.Empty(t, keys)
+
+	key, err := c.AddFaucetAPIKey(FaucetAPIKey{
+		Name:     "partner",
+		Denoms:   []string{"token"},
+		CoinsMax: []string{"1000token"},
+		Domains:  []string{"app.partner.com"},
+	})
+	require.NoError(t, err)
+
+	_, err = c.AddFaucetAPIKey(FaucetAPIKey{Name: "partner"})
+	require.EqualError(t, err, `API key "partner" already exists`)
+	_, err = c.AddFaucetAPIKey(FaucetAPIKey{Name: "invalid", CoinsMax: []string{"token"}})
+	require.Error(t, err)
+
+	keys, err = c.FaucetAPIKeys()
+	require.NoError(t, err)
+	require.Len(t, keys, 1)
+	require.Equal(t, cosmosfaucet.HashAPIKey(key), keys[0].KeyHash)
+
+	faucetKey, err := keys[0].faucetAPIKey()
+	require.NoError(t, err)
+	require.Equal(t, map[string]uint64{"token": 1000}, faucetKey.CoinsMax)
+
+	require.NoError(t, c.RevokeFaucetAPIKey("partner"))
+	require.EqualError(t, c.RevokeFaucetAPIKey("partner"), `API key "partner" does not exist`)
+	keys, err = c.FaucetAPIKeys()
+	require.NoError(t, err)
+	require.Empty(t, keys)
+}
+
+func TestReloadFaucetAPIKeys(t *testing.T) {
+	var (
+		ctx, cancel = context.WithCancel(context.Background())
+		c           = &Chain{app: App{Path: t.TempDir()}, stdout: io.Discard, stderr: io.Discard}
+	)
+	defer cancel()
+
+	// the key is only valid on its domain, so the requests made with it fail before the
+	// transfer is made.
+	key, err := c.AddFaucetAPIKey(FaucetAPIKey{Name: "partner", Domains: []string{"app.partner.com"}})
+	require.NoError(t, err)
+
+	apiKeys, err := c.faucetServerAPIKeys()
+	require.NoError(t, err)
+	faucet, err := cosmosfaucet.New(ctx, chaincmdrunner.Runner{}, cosmosfaucet.ChainID("mars"), cosmosfaucet.APIKeys(apiKeys...))
+	require.NoError(t, err)
+
+	reloaded := make(chan error)
+	go func() { reloaded <- c.reloadFaucetAPIKeys(ctx, faucet) }()
+
+	status := func() int {
+		r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"address":"cosmos1"}`))
+		r.Header.Set(cosmosfaucet.APIKeyHeader, key)
+		w := httptest.NewRecorder()
+		faucet.ServeHTTP(w, r)
+		return w.Code
+	}
+	require.Equal(t, http.StatusForbidden, status())
+
+	// the revoked key is invalid without restarting the faucet.
+	require.NoError(t, c.RevokeFaucetAPIKey("partner"))
+	require.Eventually(t, func() bool {
+		return status() == http.StatusUnauthorized
+	}, 5*faucetAPIKeysReloadInterval, 100*time.Millisecond)
+
+	cancel()
+	require.NoError(t, <-reloaded)
+}
@@ -0,0 +1,120 @@
+package chain
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite-hq/cli/ignite/chainconfig"
+	"github.com/ignite-hq/cli/ignite/pkg/cache"
+	"github.com/ignite-hq/cli/ignite/pkg/cosmosver"
+)
+
+func TestConfigModifiedForReset(t *testing.T) {
+	confyml := `accounts:
+  - name: me
+    coins: ["1000token", "100000000stake"]
+  - name: faucet
+    coins: ["5000token"]
+validator:
+  name: me
+  staked: "100000000stake"
+faucet:
+  name: faucet
+  coins: ["5token"]
+`
+	var (
+		ctx     = context.Background()
+		appPath = t.TempDir()
+		path    = filepath.Join(appPath, "config.yml")
+		binary  = filepath.Join(t.TempDir(), "marsd")
+	)
+	require.NoError(t, os.WriteFile(path, []byte(confyml), 0644))
+
+	// the keyring of the binary only has the rotated faucet account.
+	script := `#!/bin/sh
+[ "$1 $2 $3" = "keys show faucet2" ] && echo cosmos1faucet2 && exit 0
+echo "$3.info: key not found: item could not be found" >&2
+exit 1
+`
+	require.NoError(t, os.WriteFile(binary, []byte(script), 0755))
+
+	c := &Chain{
+		app:     App{Path: appPath},
+		Version: cosmosver.Latest,
+		stdout:  io.Discard,
+		stderr:  io.Discard,
+		options: chainOptions{ConfigFile: path, chainID: "mars", homePath: t.TempDir(), prebuiltBinary: binary},
+	}
+	cacheStorage, err := cache.NewStorage(filepath.Join(t.TempDir(), "cache.db"))
+	require.NoError(t, err)
+	dirCache := cache.New[[]byte](cacheStorage, serveDirchangeCacheNamespace)
+
+	modified := func() bool {
+		conf, err := c.Config()
+		require.NoError(t, err)
+		modified, err := c.configModifiedForReset(ctx, dirCache, conf)
+		require.NoError(t, err)
+		return modified
+	}
+
+	// the state is reset when the chain was never served.
+	require.True(t, modified())
+
+	// serve saves the checksum of the config it initialized the chain with.
+	conf, err := c.Config()
+	require.NoError(t, err)
+	checksum, err := configResetChecksum(conf)
+	require.NoError(t, err)
+	require.NoError(t, dirCache.Put(configResetChecksumKey, checksum))
+
+	// rotating the faucet key while the chain is served keeps the state.
+	require.NoError(t, chainconfig.UpdateFaucetName(path, "faucet2"))
+	require.False(t, modified())
+
+	// the state is reset when the renamed faucet account is not in the keyring.
+	require.NoError(t, chainconfig.UpdateFaucetName(path, "faucet3"))
+	require.True(t, modified())
+
+	// or when the config has other changes.
+	require.NoError(t, chainconfig.UpdateFaucetName(path, "faucet2"))
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	data = bytes.Replace(data, []byte(`["5token"]`), []byte(`["10token"]`), 1)
+	require.NoError(t, os.WriteFile(path, data, 0644))
+	require.True(t, modified())
+}
+
+func TestConfigResetChecksum(t *testing.T) {
+	name := "faucet"
+	conf := chainconfig.Config{
+		Accounts: []chainconfig.Account{{Name: "me"}, {Name: "faucet"}},
+		Faucet:   chainconfig.Faucet{Name: &name},
+	}
+	checksum, err := configResetChecksum(conf)
+	require.NoError(t, err)
+
+	// the name of the faucet account is not part of the checksum.
+	renamed := "faucet2"
+	got, err := configResetChecksum(chainconfig.Config{
+		Accounts: []chainconfig.Account{{Name: "me"}, {Name: "faucet2"}},
+		Faucet:   chainconfig.Faucet{Name: &renamed},
+	})
+	require.NoError(t, err)
+	require.Equal(t, checksum, got)
+	require.Equal(t, "faucet", conf.Accounts[1].Name, "conf must not be modified")
+
+	// but the faucet account is.
+	me := "me"
+	got, err = configResetChecksum(chainconfig.Config{
+		Accounts: []chainconfig.Account{{Name: "me"}, {Name: "faucet"}},
+		Faucet:   chainconfig.Faucet{Name: &me},
+	})
+	require.NoError(t, err)
+	require.NotEqual(t, checksum, got)
+}
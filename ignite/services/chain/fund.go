@@ -0,0 +1,119 @@
+package chain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+
+	"github.com/ignite-hq/cli/ignite/chainconfig"
+	chaincmdrunner "github.com/ignite-hq/cli/ignite/pkg/chaincmd/runner"
+	"github.com/ignite-hq/cli/ignite/pkg/cosmosutil"
+)
+
+// FundFromFaucet is the source used to fund accounts with the faucet of the chain.
+const FundFromFaucet = "faucet"
+
+// AccountAddress returns the address of the account from the chain's keyring named
+// nameOrAddress, nameOrAddress is returned as is when it is already an address.
+func (c *Chain) AccountAddress(ctx context.Context, nameOrAddress string) (string, error) {
+	if _, _, err := bech32.DecodeAndConvert(nameOrAddress); err == nil {
+		return nameOrAddress, nil
+	}
+
+	commands, err := c.Commands(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	account, err := commands.ShowAccount(ctx, nameOrAddress)
+	if err != nil {
+		return "", err
+	}
+	return account.Address, nil
+}
+
+// AddressPrefix returns the account address prefix of the chain, detected from the address
+// of an account of the config.
+func (c *Chain) AddressPrefix(ctx context.Context) (string, error) {
+	conf, err := c.Config()
+	if err != nil {
+		return "", err
+	}
+
+	commands, err := c.Commands(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return addressPrefix(conf.Accounts, func(name string) (string, error) {
+		account, err := commands.ShowAccount(ctx, name)
+		return account.Address, err
+	})
+}
+
+// addressPrefix returns the prefix of the address of the first account that has one, the
+// address of an account without one in the config is looked up in the keyring with show.
+func addressPrefix(accounts []chainconfig.Account, show func(name string) (string, error)) (string, error) {
+	for _, account := range accounts {
+		address := account.Address
+		if address == "" {
+			var err error
+			address, err = show(account.Name)
+			if errors.Is(err, chaincmdrunner.ErrAccountDoesNotExist) {
+				continue
+			}
+			if err != nil {
+				return "", err
+			}
+		}
+		return cosmosutil.GetAddressPrefix(address)
+	}
+	return "", errors.New("cannot detect the address prefix of the chain, the accounts of the config are not in the keyring")
+}
+
+// Fund sends coins to address on the running chain. Coins are sent by the faucet when from
+// is FundFromFaucet, so the faucet limits apply, or by the account of the chain's keyring
+// named from otherwise.
+func (c *Chain) Fund(ctx context.Context, from, address string, coins sdk.Coins) error {
+	if from == FundFromFaucet {
+		faucet, err := c.Faucet(ctx)
+		if err != nil {
+			return err
+		}
+		return faucet.Transfer(ctx, address, coins)
+	}
+
+	conf, err := c.Config()
+	if err != nil {
+		return err
+	}
+	commands, err := c.accountCommands(ctx, conf, from)
+	if err != nil {
+		return err
+	}
+
+	account, err := commands.ShowAccount(ctx, from)
+	if err != nil {
+		if errors.Is(err, chaincmdrunner.ErrAccountDoesNotExist) {
+			return fmt.Errorf("account %q does not exist in the keyring of the chain", from)
+		}
+		return err
+	}
+
+	txHash, err := commands.BankSend(ctx, account.Address, address, coins.String())
+	if err != nil {
+		return err
+	}
+	return commands.WaitTx(ctx, txHash, time.Second, 30)
+}
+
+// accountCommands returns the runner of the commands of the txs sent by the account name,
+// with the gas settings of the account in the config.
+func (c *Chain) accountCommands(ctx context.Context, conf chainconfig.Config, name string) (chaincmdrunner.Runner, error) {
+	account, _ := conf.AccountByName(name)
+	return c.commandsWithGas(ctx, account.Gas)
+}
@@ -0,0 +1,67 @@
+package chain
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite-hq/cli/ignite/chainconfig"
+	chaincmdrunner "github.com/ignite-hq/cli/ignite/pkg/chaincmd/runner"
+)
+
+func TestAddressPrefix(t *testing.T) {
+	keyring := map[string]string{
+		"bob": "mars1adn9gxjmrc3hrsdx5zpc9sj2ra7kgqkmu2s734",
+	}
+	show := func(name string) (string, error) {
+		address, ok := keyring[name]
+		if !ok {
+			return "", chaincmdrunner.ErrAccountDoesNotExist
+		}
+		return address, nil
+	}
+
+	cases := []struct {
+		name     string
+		accounts []chainconfig.Account
+		prefix   string
+		err      string
+	}{
+		{
+			name:     "account in the keyring",
+			accounts: []chainconfig.Account{{Name: "alice"}, {Name: "bob"}},
+			prefix:   "mars",
+		},
+		{
+			name: "account with an address",
+			accounts: []chainconfig.Account{
+				{Name: "carol", Address: "venus1adn9gxjmrc3hrsdx5zpc9sj2ra7kgqkm3ev7h0"},
+				{Name: "bob"},
+			},
+			prefix: "venus",
+		},
+		{
+			name:     "no accounts in the keyring",
+			accounts: []chainconfig.Account{{Name: "alice"}},
+			err:      "cannot detect the address prefix",
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			prefix, err := addressPrefix(tt.accounts, show)
+			if tt.err != "" {
+				require.ErrorContains(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.prefix, prefix)
+		})
+	}
+
+	_, err := addressPrefix([]chainconfig.Account{{Name: "alice"}}, func(string) (string, error) {
+		return "", errors.New("keyring error")
+	})
+	require.EqualError(t, err, "keyring error")
+}
@@ -15,6 +15,7 @@ const (
 	defaultVuexPath    = "vue/src/store"
 	defaultDartPath    = "flutter/lib"
 	defaultOpenAPIPath = "docs/static/openapi.yml"
+	defaultMocksPath   = "testutil/mocks"
 )
 
 type generateOptions struct {
@@ -22,6 +23,7 @@ type generateOptions struct {
 	isVuexEnabled    bool
 	isDartEnabled    bool
 	isOpenAPIEnabled bool
+	isMocksEnabled   bool
 }
 
 // GenerateTarget is a target to generate code for from proto files.
@@ -55,6 +57,13 @@ func GenerateOpenAPI() GenerateTarget {
 	}
 }
 
+// GenerateMocks enables generating mocks for the expected keepers of the chain's modules.
+func GenerateMocks() GenerateTarget {
+	return func(o *generateOptions) {
+		o.isMocksEnabled = true
+	}
+}
+
 func (c *Chain) generateAll(ctx context.Context, cacheStorage cache.Storage) error {
 	conf, err := c.Config()
 	if err != nil {
@@ -75,6 +84,10 @@ func (c *Chain) generateAll(ctx context.Context, cacheStorage cache.Storage) err
 		additionalTargets = append(additionalTargets, GenerateOpenAPI())
 	}
 
+	if conf.Build.Mocks {
+		additionalTargets = append(additionalTargets, GenerateMocks())
+	}
+
 	return c.Generate(ctx, cacheStorage, GenerateGo(), additionalTargets...)
 }
 
@@ -91,6 +104,30 @@ func (c *Chain) Generate(
 		apply(&targetOptions)
 	}
 
+	if targetOptions.isProtoEnabled() {
+		if err := c.generateProto(ctx, cacheStorage, targetOptions); err != nil {
+			return err
+		}
+	}
+
+	// mocks are generated from Go source files and don't require building proto.
+	if targetOptions.isMocksEnabled {
+		fmt.Fprintln(c.stdLog().out, "🛠️  Generating mocks...")
+
+		if err := cosmosgen.GenerateMocks(ctx, c.app.Path, defaultMocksPath); err != nil {
+			return &CannotBuildAppError{err}
+		}
+	}
+
+	return nil
+}
+
+// isProtoEnabled returns true when one of the targets is generated from proto files.
+func (o generateOptions) isProtoEnabled() bool {
+	return o.isGoEnabled || o.isVuexEnabled || o.isDartEnabled || o.isOpenAPIEnabled
+}
+
+func (c *Chain) generateProto(ctx context.Context, cacheStorage cache.Storage, targetOptions generateOptions) error {
 	conf, err := c.Config()
 	if err != nil {
 		return err
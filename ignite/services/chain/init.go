@@ -2,12 +2,14 @@ package chain
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
 	"strings"
 
 	"github.com/imdario/mergo"
+	"github.com/otiai10/copy"
 
 	"github.com/ignite-hq/cli/ignite/chainconfig"
 	chaincmdrunner "github.com/ignite-hq/cli/ignite/pkg/chaincmd/runner"
@@ -18,6 +20,15 @@ const (
 	moniker = "mynode"
 )
 
+// keptHomePaths are the paths, relative to the home, of the keys kept by initKeepingKeys.
+// Paths with a trailing "*" match the keyring directories of all backends.
+var keptHomePaths = []string{
+	"keyring-*",
+	"config/node_key.json",
+	"config/priv_validator_key.json",
+	accountMnemonicsPath,
+}
+
 // Init initializes the chain and applies all optional configurations.
 func (c *Chain) Init(ctx context.Context, initAccounts bool) error {
 	conf, err := c.Config()
@@ -35,6 +46,70 @@ func (c *Chain) Init(ctx context.Context, initAccounts bool) error {
 	return nil
 }
 
+// initKeepingKeys initializes the chain like Init but keeps the keys of the accounts, the node
+// key and the validator key. The genesis is regenerated from the config and the accounts that
+// already exist in the keyring are reused, so their addresses and mnemonics stay valid.
+func (c *Chain) initKeepingKeys(ctx context.Context) error {
+	conf, err := c.Config()
+	if err != nil {
+		return &CannotBuildAppError{err}
+	}
+
+	home, err := c.Home()
+	if err != nil {
+		return err
+	}
+
+	if err := keepHomeKeys(home, func() error { return c.InitChain(ctx) }); err != nil {
+		return err
+	}
+
+	return c.initAccounts(ctx, conf, true)
+}
+
+// keepHomeKeys runs init that initializes the home again and restores the keys of the
+// previous home matching keptHomePaths.
+func keepHomeKeys(home string, init func() error) error {
+	backup, err := os.MkdirTemp("", "ignite-home-keys")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(backup)
+
+	var kept []string
+	for _, pattern := range keptHomePaths {
+		paths, err := filepath.Glob(filepath.Join(home, pattern))
+		if err != nil {
+			return err
+		}
+		for _, path := range paths {
+			relPath, err := filepath.Rel(home, path)
+			if err != nil {
+				return err
+			}
+			if err := copy.Copy(path, filepath.Join(backup, relPath)); err != nil {
+				return err
+			}
+			kept = append(kept, relPath)
+		}
+	}
+
+	if err := init(); err != nil {
+		return err
+	}
+
+	for _, relPath := range kept {
+		if err := os.RemoveAll(filepath.Join(home, relPath)); err != nil {
+			return err
+		}
+		if err := copy.Copy(filepath.Join(backup, relPath), filepath.Join(home, relPath)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // InitChain initializes the chain.
 func (c *Chain) InitChain(ctx context.Context) error {
 	chainID, err := c.ID()
@@ -96,15 +171,29 @@ func (c *Chain) InitChain(ctx context.Context) error {
 		return err
 	}
 
+	genesisChanges, err := conf.GenesisChanges()
+	if err != nil {
+		return err
+	}
+
+	// the node waits for the remote signer to connect instead of signing with its key.
+	configChanges := conf.Init.Config
+	if conf.Validator.Signer.Address != "" {
+		configChanges = map[string]interface{}{"priv_validator_laddr": conf.Validator.Signer.Address}
+		if err := mergo.Merge(&configChanges, conf.Init.Config, mergo.WithOverride); err != nil {
+			return err
+		}
+	}
+
 	appconfigs := []struct {
 		ec      confile.EncodingCreator
 		path    string
 		changes map[string]interface{}
 	}{
-		{confile.DefaultJSONEncodingCreator, genesisPath, conf.Genesis},
+		{confile.DefaultJSONEncodingCreator, genesisPath, genesisChanges},
 		{confile.DefaultTOMLEncodingCreator, appTOMLPath, conf.Init.App},
 		{confile.DefaultTOMLEncodingCreator, clientTOMLPath, conf.Init.Client},
-		{confile.DefaultTOMLEncodingCreator, configTOMLPath, conf.Init.Config},
+		{confile.DefaultTOMLEncodingCreator, configTOMLPath, configChanges},
 	}
 
 	for _, ac := range appconfigs {
@@ -126,16 +215,48 @@ func (c *Chain) InitChain(ctx context.Context) error {
 
 // InitAccounts initializes the chain accounts and creates validator gentxs
 func (c *Chain) InitAccounts(ctx context.Context, conf chainconfig.Config) error {
+	return c.initAccounts(ctx, conf, false)
+}
+
+// initAccounts initializes the chain accounts and creates validator gentxs.
+// When reuseKeys is true, the accounts that exist in the keyring are not created again.
+func (c *Chain) initAccounts(ctx context.Context, conf chainconfig.Config, reuseKeys bool) error {
 	commands, err := c.Commands(ctx)
 	if err != nil {
 		return err
 	}
 
+	// the mnemonics of the generated accounts are kept in the home, they are read by chain env.
+	home, err := c.Home()
+	if err != nil {
+		return err
+	}
+	mnemonics, err := readAccountMnemonics(home)
+	if err != nil {
+		return err
+	}
+
 	// add accounts from config into genesis
 	for _, account := range conf.Accounts {
 		var generatedAccount chaincmdrunner.Account
 		accountAddress := account.Address
 
+		if accountAddress == "" && reuseKeys {
+			existingAccount, err := commands.ShowAccount(ctx, account.Name)
+			switch {
+			case err == nil:
+				accountAddress = existingAccount.Address
+				fmt.Fprintf(
+					c.stdLog().out,
+					"🙂 Kept account %q with address %q\n",
+					account.Name,
+					accountAddress,
+				)
+			case !errors.Is(err, chaincmdrunner.ErrAccountDoesNotExist):
+				return err
+			}
+		}
+
 		// If the account doesn't provide an address, we create one
 		if accountAddress == "" {
 			generatedAccount, err = commands.AddAccount(ctx, account.Name, account.Mnemonic, account.CoinType)
@@ -143,6 +264,9 @@ func (c *Chain) InitAccounts(ctx context.Context, conf chainconfig.Config) error
 				return err
 			}
 			accountAddress = generatedAccount.Address
+			if account.Mnemonic == "" {
+				mnemonics[account.Name] = generatedAccount.Mnemonic
+			}
 		}
 
 		coins := strings.Join(account.Coins, ",")
@@ -150,7 +274,7 @@ func (c *Chain) InitAccounts(ctx context.Context, conf chainconfig.Config) error
 			return err
 		}
 
-		if account.Address == "" {
+		if generatedAccount.Address != "" {
 			fmt.Fprintf(
 				c.stdLog().out,
 				"🙂 Created account %q with address %q with mnemonic: %q\n",
@@ -158,7 +282,7 @@ func (c *Chain) InitAccounts(ctx context.Context, conf chainconfig.Config) error
 				generatedAccount.Address,
 				generatedAccount.Mnemonic,
 			)
-		} else {
+		} else if account.Address != "" {
 			fmt.Fprintf(
 				c.stdLog().out,
 				"🙂 Imported an account %q with address: %q\n",
@@ -168,6 +292,10 @@ func (c *Chain) InitAccounts(ctx context.Context, conf chainconfig.Config) error
 		}
 	}
 
+	if err := writeAccountMnemonics(home, mnemonics); err != nil {
+		return err
+	}
+
 	_, err = c.IssueGentx(ctx, Validator{
 		Name:          conf.Validator.Name,
 		StakingAmount: conf.Validator.Staked,
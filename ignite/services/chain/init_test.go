@@ -0,0 +1,58 @@
+package chain
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeepHomeKeys(t *testing.T) {
+	home := t.TempDir()
+	write := func(name, content string) {
+		path := filepath.Join(home, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+	}
+	read := func(name string) string {
+		data, err := os.ReadFile(filepath.Join(home, name))
+		require.NoError(t, err)
+		return string(data)
+	}
+
+	write("keyring-test/alice.info", "alice")
+	write("keyring-file/bob.info", "bob")
+	write("config/node_key.json", "old node key")
+	write("config/priv_validator_key.json", "old validator key")
+	write("config/mnemonics.json", "old mnemonics")
+	write("config/genesis.json", "old genesis")
+	write("data/application.db", "old state")
+
+	// init removes the home and generates new keys, like the init command of the chain.
+	err := keepHomeKeys(home, func() error {
+		require.NoError(t, os.RemoveAll(home))
+		write("config/node_key.json", "new node key")
+		write("config/priv_validator_key.json", "new validator key")
+		write("config/genesis.json", "new genesis")
+		return nil
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, "alice", read("keyring-test/alice.info"))
+	require.Equal(t, "bob", read("keyring-file/bob.info"))
+	require.Equal(t, "old node key", read("config/node_key.json"))
+	require.Equal(t, "old validator key", read("config/priv_validator_key.json"))
+	require.Equal(t, "old mnemonics", read("config/mnemonics.json"))
+	require.Equal(t, "new genesis", read("config/genesis.json"))
+	require.NoFileExists(t, filepath.Join(home, "data/application.db"))
+}
+
+func TestKeepHomeKeysInitError(t *testing.T) {
+	home := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(home, "config"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(home, "config/node_key.json"), []byte("node key"), 0644))
+
+	err := keepHomeKeys(home, func() error { return os.ErrPermission })
+	require.ErrorIs(t, err, os.ErrPermission)
+}
@@ -0,0 +1,129 @@
+package chain
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/pelletier/go-toml"
+
+	"github.com/ignite-hq/cli/ignite/chainconfig"
+	"github.com/ignite-hq/cli/ignite/pkg/chaincmd"
+	chaincmdrunner "github.com/ignite-hq/cli/ignite/pkg/chaincmd/runner"
+	"github.com/ignite-hq/cli/ignite/pkg/tendermintrpc"
+)
+
+// ServePeer runs a full node of the served chain until ctx is canceled. The node has its home
+// in home, that must not be initialized yet, and listens on the addresses of host. It starts
+// from the genesis of the chain and syncs its blocks from the node of the chain.
+func (c *Chain) ServePeer(ctx context.Context, home string, host chainconfig.Host) error {
+	if err := c.WaitServed(ctx); err != nil {
+		return err
+	}
+	conf, err := c.Config()
+	if err != nil {
+		return err
+	}
+	commands, err := c.Commands(ctx)
+	if err != nil {
+		return err
+	}
+	nodeID, err := commands.ShowNodeID(ctx)
+	if err != nil {
+		return err
+	}
+	seed, err := localAddress(conf.Host.P2P)
+	if err != nil {
+		return err
+	}
+	chainHome, err := c.Home()
+	if err != nil {
+		return err
+	}
+
+	peer, err := chaincmdrunner.New(ctx, commands.Cmd().Copy(chaincmd.WithHome(home)))
+	if err != nil {
+		return err
+	}
+	if err := peer.Init(ctx, filepath.Base(home)); err != nil {
+		return err
+	}
+	genesis, err := os.ReadFile(filepath.Join(chainHome, "config/genesis.json"))
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(filepath.Join(home, "config/genesis.json"), genesis, 0644); err != nil {
+		return err
+	}
+
+	conf.Host = host
+	if err := c.plugin.Configure(home, conf); err != nil {
+		return err
+	}
+	if err := configurePeerTOML(home, nodeID+"@"+seed); err != nil {
+		return err
+	}
+
+	err = c.plugin.Start(ctx, peer, conf)
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	return err
+}
+
+// PeerEndpoints returns the endpoints of a full node of the chain served by ServePeer with
+// host. The accounts, the faucet and the home of the keys are the ones of the chain.
+func (e Endpoints) PeerEndpoints(host chainconfig.Host) (Endpoints, error) {
+	var err error
+	if e.RPC, err = localURL(host.RPC); err != nil {
+		return Endpoints{}, err
+	}
+	if e.API, err = localURL(host.API); err != nil {
+		return Endpoints{}, err
+	}
+	if e.GRPC, err = localAddress(host.GRPC); err != nil {
+		return Endpoints{}, err
+	}
+	return e, nil
+}
+
+// WaitPeer waits until the full node with the endpoints e answers or ctx is canceled.
+func WaitPeer(ctx context.Context, e Endpoints) error {
+	for {
+		status, err := tendermintrpc.New(e.RPC).Status(ctx)
+		if err == nil && status.Network == e.ChainID {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(e2eWaitDelay):
+		}
+	}
+}
+
+// configurePeerTOML connects the node in home to the node with the address peer, in the
+// <id>@<host>:<port> format. The nodes run on the same machine.
+func configurePeerTOML(home, peer string) error {
+	path := filepath.Join(home, "config/config.toml")
+	config, err := toml.LoadFile(path)
+	if err != nil {
+		return err
+	}
+
+	config.Set("mode", "full")
+	config.Set("p2p.persistent_peers", peer)
+	config.Set("p2p.allow_duplicate_ip", true)
+	config.Set("p2p.addr_book_strict", false)
+
+	file, err := os.OpenFile(path, os.O_RDWR|os.O_TRUNC, 0644)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	_, err = config.WriteTo(file)
+	return err
+}
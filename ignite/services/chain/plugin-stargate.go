@@ -71,9 +71,12 @@ func (p *stargatePlugin) appTOML(homePath string, conf chainconfig.Config) error
 		return fmt.Errorf("invalid api address format %s: %w", conf.Host.API, err)
 	}
 
+	// the api and grpc-web of the SDK either allow all origins or none, specific origins
+	// are allowed by a reverse proxy.
 	config.Set("api.enable", true)
-	config.Set("api.enabled-unsafe-cors", true)
-	config.Set("rpc.cors_allowed_origins", []string{"*"})
+	config.Set("api.enabled-unsafe-cors", conf.CORS.AllowsAll())
+	config.Set("grpc-web.enable-unsafe-cors", conf.CORS.AllowsAll())
+	config.Set("rpc.cors_allowed_origins", conf.CORS.Origins())
 	config.Set("api.address", apiAddr)
 	config.Set("grpc.address", conf.Host.GRPC)
 	config.Set("grpc-web.address", conf.Host.GRPCWeb)
@@ -85,6 +88,14 @@ func (p *stargatePlugin) appTOML(homePath string, conf chainconfig.Config) error
 	gas := sdktypes.NewInt64Coin(staked.Denom, 0)
 	config.Set("minimum-gas-prices", gas.String())
 
+	node := conf.Init.Node.Resolve()
+	if node.Pruning != "" {
+		config.Set("pruning", node.Pruning)
+	}
+	if node.MinRetainBlocks != nil {
+		config.Set("min-retain-blocks", *node.MinRetainBlocks)
+	}
+
 	file, err := os.OpenFile(path, os.O_RDWR|os.O_TRUNC, 0644)
 	if err != nil {
 		return err
@@ -114,12 +125,15 @@ func (p *stargatePlugin) configTOML(homePath string, conf chainconfig.Config) er
 	}
 
 	config.Set("mode", "validator")
-	config.Set("rpc.cors_allowed_origins", []string{"*"})
+	config.Set("rpc.cors_allowed_origins", conf.CORS.Origins())
 	config.Set("consensus.timeout_commit", "1s")
 	config.Set("consensus.timeout_propose", "1s")
 	config.Set("rpc.laddr", rpcAddr)
 	config.Set("p2p.laddr", p2pAddr)
 	config.Set("rpc.pprof_laddr", conf.Host.Prof)
+	if size := conf.Init.Node.Resolve().MempoolSize; size != nil {
+		config.Set("mempool.size", *size)
+	}
 
 	file, err := os.OpenFile(path, os.O_RDWR|os.O_TRUNC, 0644)
 	if err != nil {
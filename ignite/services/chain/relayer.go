@@ -0,0 +1,139 @@
+package chain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ignite-hq/cli/ignite/chainconfig"
+	"github.com/ignite-hq/cli/ignite/pkg/cosmosaccount"
+	"github.com/ignite-hq/cli/ignite/pkg/relayer"
+	relayerconf "github.com/ignite-hq/cli/ignite/pkg/relayer/config"
+)
+
+const (
+	// relayerLinkAttempts is the number of attempts to link the paths while the chain starts.
+	relayerLinkAttempts = 20
+
+	relayerLinkRetryDelay = 3 * time.Second
+)
+
+// runRelayer links and relays the paths of the relayer section of the config until ctx is
+// canceled. When the state of the chain is reset, the paths are reset so new clients,
+// connections and channels are created, and the relayer account is funded by the faucet
+// when it is enabled.
+// Relayer errors are printed and don't stop the chain.
+func (c *Chain) runRelayer(ctx context.Context, conf chainconfig.Config, stateReset bool) error {
+	err := c.relay(ctx, conf, stateReset)
+	if err != nil && !errors.Is(err, context.Canceled) {
+		fmt.Fprintf(c.stdLog().err, "%s\n", errorColor(fmt.Sprintf("relayer stopped: %s", err)))
+	}
+	return nil
+}
+
+func (c *Chain) relay(ctx context.Context, conf chainconfig.Config, stateReset bool) error {
+	chainID, err := c.ID()
+	if err != nil {
+		return err
+	}
+
+	ca, err := cosmosaccount.New(cosmosaccount.WithKeyringBackend(cosmosaccount.KeyringTest))
+	if err != nil {
+		return err
+	}
+
+	if err := setRelayerGas(chainID, conf.Relayer.Gas); err != nil {
+		return err
+	}
+
+	r := relayer.New(ca, relayer.WithOutput(c.stdLog().out))
+	paths := conf.Relayer.Paths
+
+	if stateReset {
+		if err := r.ResetPaths(ctx, chainID, paths...); err != nil {
+			return err
+		}
+	}
+
+	// without faucet, the relayer account is expected to be funded in the genesis.
+	funded := !stateReset || conf.Faucet.Name == nil
+	for attempt := 1; ; attempt++ {
+		// the chain is starting, the first attempts fail until its node is up.
+		if !funded {
+			err = c.fundRelayerAccount(ctx, ca, conf, chainID)
+			funded = err == nil
+		}
+		if funded {
+			if err = r.Link(ctx, paths...); err == nil {
+				break
+			}
+		}
+		if attempt == relayerLinkAttempts {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(relayerLinkRetryDelay):
+		}
+	}
+
+	fmt.Fprintf(c.stdLog().out, "🔗 Relaying packets of paths: %s\n", strings.Join(paths, ", "))
+
+	return r.Start(ctx, paths...)
+}
+
+// setRelayerGas sets the gas price and the gas limit of the chain in the relayer config to the
+// ones of the config, the settings of the relayer config are kept when they are not set.
+func setRelayerGas(chainID string, gas chainconfig.Gas) error {
+	if gas.GasPrice == "" && gas.MaxGas == 0 {
+		return nil
+	}
+
+	rconf, err := relayerconf.Get()
+	if err != nil {
+		return err
+	}
+	chain, err := rconf.ChainByID(chainID)
+	if err != nil {
+		return err
+	}
+	if gas.GasPrice != "" {
+		chain.GasPrice = gas.GasPrice
+	}
+	if gas.MaxGas > 0 {
+		chain.GasLimit = int64(gas.MaxGas)
+	}
+	if err := rconf.UpdateChain(chain); err != nil {
+		return err
+	}
+	return relayerconf.Save(rconf)
+}
+
+// fundRelayerAccount sends the coins of the faucet to the relayer account of the chain.
+func (c *Chain) fundRelayerAccount(ctx context.Context, ca cosmosaccount.Registry, conf chainconfig.Config, chainID string) error {
+	rconf, err := relayerconf.Get()
+	if err != nil {
+		return err
+	}
+	chain, err := rconf.ChainByID(chainID)
+	if err != nil {
+		return err
+	}
+	account, err := ca.GetByName(chain.Account)
+	if err != nil {
+		return err
+	}
+
+	coins, err := sdk.ParseCoinsNormalized(strings.Join(conf.Faucet.Coins, ","))
+	if err != nil {
+		return err
+	}
+
+	return c.Fund(ctx, FundFromFaucet, account.Address(chain.AddressPrefix), coins)
+}
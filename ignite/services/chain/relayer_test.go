@@ -0,0 +1,78 @@
+package chain
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite-hq/cli/ignite/chainconfig"
+	"github.com/ignite-hq/cli/ignite/pkg/cosmosaccount"
+	relayerconf "github.com/ignite-hq/cli/ignite/pkg/relayer/config"
+)
+
+func TestRunRelayer(t *testing.T) {
+	keyringHome := cosmosaccount.KeyringHome
+	cosmosaccount.KeyringHome = t.TempDir()
+	t.Cleanup(func() { cosmosaccount.KeyringHome = keyringHome })
+	relayerconf.SetPath(filepath.Join(t.TempDir(), "config.yml"))
+
+	// the node of the chain fails every query, so the paths cannot be linked.
+	node := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "node is down", http.StatusInternalServerError)
+	}))
+	defer node.Close()
+
+	require.NoError(t, relayerconf.Save(relayerconf.Config{
+		Chains: []relayerconf.Chain{
+			{ID: "mars", Account: "alice", RPCAddress: node.URL, ClientID: "07-tendermint-5"},
+			{ID: "venus", Account: "bob", RPCAddress: node.URL},
+		},
+		Paths: []relayerconf.Path{{
+			ID:  "mars-venus",
+			Src: relayerconf.PathEnd{ChainID: "mars", PortID: "transfer", ConnectionID: "connection-0", ChannelID: "channel-0"},
+			Dst: relayerconf.PathEnd{ChainID: "venus", PortID: "transfer", ConnectionID: "connection-1", ChannelID: "channel-1"},
+		}},
+	}))
+
+	var stderr bytes.Buffer
+	c := &Chain{
+		options: chainOptions{chainID: "mars"},
+		stdout:  &bytes.Buffer{},
+		stderr:  &stderr,
+	}
+
+	t.Run("reset the paths until canceled", func(t *testing.T) {
+		conf := chainconfig.Config{Relayer: chainconfig.Relayer{Paths: []string{"mars-venus"}}}
+
+		// serve cancels the relayer when the chain stops.
+		ctx, cancel := context.WithCancel(context.Background())
+		defer time.AfterFunc(100*time.Millisecond, cancel).Stop()
+
+		require.NoError(t, c.runRelayer(ctx, conf, true))
+		require.Empty(t, stderr.String(), "a canceled relayer is not reported")
+
+		rconf, err := relayerconf.Get()
+		require.NoError(t, err)
+		path, err := rconf.PathByID("mars-venus")
+		require.NoError(t, err)
+		require.Empty(t, path.Src.ConnectionID)
+		require.Empty(t, path.Dst.ChannelID)
+
+		chain, err := rconf.ChainByID("mars")
+		require.NoError(t, err)
+		require.Equal(t, "07-tendermint-5", chain.ClientID)
+	})
+
+	t.Run("report relayer errors", func(t *testing.T) {
+		conf := chainconfig.Config{Relayer: chainconfig.Relayer{Paths: []string{"unknown"}}}
+
+		require.NoError(t, c.runRelayer(context.Background(), conf, true))
+		require.Contains(t, stderr.String(), "relayer stopped: unknown: path cannot be found")
+	})
+}
@@ -0,0 +1,67 @@
+package chain
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/ignite-hq/cli/ignite/chainconfig"
+	"github.com/ignite-hq/cli/ignite/pkg/reverseproxy"
+)
+
+// ReverseProxyConfig returns the config of a reverse proxy server that exposes the RPC, the
+// API, gRPC-web and the faucet of the chain on subdomains of domain with TLS.
+// The proxy adds the CORS headers of the allowed origins to the API and gRPC-web because
+// they don't support a list of origins.
+func (c *Chain) ReverseProxyConfig(server reverseproxy.Server, domain string) (string, error) {
+	conf, err := c.Config()
+	if err != nil {
+		return "", err
+	}
+
+	services, err := reverseProxyServices(conf)
+	if err != nil {
+		return "", err
+	}
+
+	return reverseproxy.Render(server, reverseproxy.Config{
+		Domain:         domain,
+		Services:       services,
+		AllowedOrigins: conf.CORS.Origins(),
+	})
+}
+
+func reverseProxyServices(conf chainconfig.Config) ([]reverseproxy.Service, error) {
+	services := []reverseproxy.Service{
+		{Name: "rpc", Upstream: conf.Host.RPC, WebSocket: true},
+		{Name: "api", Upstream: conf.Host.API, CORS: true},
+		{Name: "grpc-web", Upstream: conf.Host.GRPCWeb, CORS: true},
+	}
+	if conf.Faucet.Name != nil {
+		services = append(services, reverseproxy.Service{Name: "faucet", Upstream: chainconfig.FaucetHost(conf)})
+	}
+
+	for i, s := range services {
+		upstream, err := localAddress(s.Upstream)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s host: %w", s.Name, err)
+		}
+		services[i].Upstream = upstream
+	}
+	return services, nil
+}
+
+// localAddress returns the local address to reach a service listening on host.
+func localAddress(host string) (string, error) {
+	if i := strings.Index(host, "://"); i != -1 {
+		host = host[i+3:]
+	}
+	h, port, err := net.SplitHostPort(host)
+	if err != nil {
+		return "", err
+	}
+	if h == "" || h == "0.0.0.0" {
+		h = "127.0.0.1"
+	}
+	return net.JoinHostPort(h, port), nil
+}
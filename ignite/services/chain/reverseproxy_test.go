@@ -0,0 +1,31 @@
+package chain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite-hq/cli/ignite/chainconfig"
+	"github.com/ignite-hq/cli/ignite/pkg/reverseproxy"
+)
+
+func TestReverseProxyServices(t *testing.T) {
+	faucet := "faucet"
+	conf := chainconfig.DefaultConf
+	conf.Host.RPC = "tcp://0.0.0.0:26659"
+	conf.Host.API = "localhost:1318"
+	conf.Faucet.Name = &faucet
+
+	services, err := reverseProxyServices(conf)
+	require.NoError(t, err)
+	require.Equal(t, []reverseproxy.Service{
+		{Name: "rpc", Upstream: "127.0.0.1:26659", WebSocket: true},
+		{Name: "api", Upstream: "localhost:1318", CORS: true},
+		{Name: "grpc-web", Upstream: "127.0.0.1:9091", CORS: true},
+		{Name: "faucet", Upstream: "127.0.0.1:4500"},
+	}, services)
+
+	conf.Host.GRPCWeb = "9091"
+	_, err = reverseProxyServices(conf)
+	require.Error(t, err)
+}
@@ -0,0 +1,108 @@
+package chain
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"golang.org/x/term"
+
+	"github.com/ignite-hq/cli/ignite/chainconfig"
+	"github.com/ignite-hq/cli/ignite/pkg/cmdrunner/exec"
+	"github.com/ignite-hq/cli/ignite/pkg/cmdrunner/step"
+	"github.com/ignite-hq/cli/ignite/pkg/xexec"
+)
+
+const (
+	// SandboxDocker serves the chain in a Docker container.
+	SandboxDocker = "docker"
+
+	sandboxAppPath = "/apps/app"
+	sandboxHome    = "/home/tendermint"
+)
+
+// ServeInSandbox serves the chain by running `ignite chain serve` with args in a sandbox
+// created from the Ignite CLI Docker image.
+// The source of the app is mounted in the sandbox so changes are reloaded like on the host,
+// and the ports of the node, the API and the faucet are published on the host. The home
+// directory of the sandbox, which holds the data of the chain, is kept in a Docker volume
+// so the host stays clean and the state is kept between serves.
+func (c *Chain) ServeInSandbox(ctx context.Context, sandbox, image string, args []string) error {
+	if sandbox != SandboxDocker {
+		return fmt.Errorf("unknown sandbox %q, available sandboxes: %s", sandbox, SandboxDocker)
+	}
+	if !xexec.IsCommandAvailable("docker") {
+		return fmt.Errorf("docker is required to serve the chain in a sandbox")
+	}
+	conf, err := c.Config()
+	if err != nil {
+		return err
+	}
+
+	appPath, err := filepath.Abs(c.app.Path)
+	if err != nil {
+		return err
+	}
+
+	name := "ignite-sandbox-" + c.app.N()
+	command := []string{
+		"docker", "run", "--rm", "-i",
+		"--name", name,
+		"-v", appPath + ":" + sandboxAppPath,
+		"-v", name + ":" + sandboxHome,
+		"-w", sandboxAppPath,
+	}
+	if term.IsTerminal(int(os.Stdin.Fd())) {
+		command = append(command, "-t")
+	}
+
+	ports, err := sandboxPorts(conf)
+	if err != nil {
+		return err
+	}
+	for _, port := range ports {
+		command = append(command, "-p", port+":"+port)
+	}
+
+	command = append(command, image, "chain", "serve")
+	command = append(command, args...)
+
+	return exec.Exec(ctx, command,
+		exec.StepOption(step.Stdin(os.Stdin)),
+		exec.StepOption(step.Stdout(os.Stdout)),
+		exec.StepOption(step.Stderr(os.Stderr)),
+	)
+}
+
+// sandboxPorts returns the ports of the chain to publish on the host.
+func sandboxPorts(conf chainconfig.Config) ([]string, error) {
+	hosts := []string{
+		conf.Host.RPC,
+		conf.Host.P2P,
+		conf.Host.GRPC,
+		conf.Host.GRPCWeb,
+		conf.Host.API,
+	}
+	if conf.Faucet.Name != nil {
+		hosts = append(hosts, chainconfig.FaucetHost(conf))
+	}
+
+	var ports []string
+	for _, host := range hosts {
+		if host == "" {
+			continue
+		}
+		if i := strings.Index(host, "://"); i != -1 {
+			host = host[i+3:]
+		}
+		_, port, err := net.SplitHostPort(host)
+		if err != nil {
+			return nil, fmt.Errorf("invalid host %q: %w", host, err)
+		}
+		ports = append(ports, port)
+	}
+	return ports, nil
+}
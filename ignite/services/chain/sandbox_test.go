@@ -0,0 +1,24 @@
+package chain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite-hq/cli/ignite/chainconfig"
+)
+
+func TestSandboxPorts(t *testing.T) {
+	faucet := "faucet"
+	conf := chainconfig.DefaultConf
+	conf.Host.RPC = "tcp://0.0.0.0:26659"
+	conf.Faucet.Name = &faucet
+
+	ports, err := sandboxPorts(conf)
+	require.NoError(t, err)
+	require.Equal(t, []string{"26659", "26656", "9090", "9091", "1317", "4500"}, ports)
+
+	conf.Host.API = "localhost"
+	_, err = sandboxPorts(conf)
+	require.Error(t, err)
+}
@@ -9,6 +9,7 @@ import (
 	"path/filepath"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/otiai10/copy"
 	"github.com/pkg/errors"
@@ -19,7 +20,9 @@ import (
 	chaincmdrunner "github.com/ignite-hq/cli/ignite/pkg/chaincmd/runner"
 	"github.com/ignite-hq/cli/ignite/pkg/cosmosfaucet"
 	"github.com/ignite-hq/cli/ignite/pkg/dirchange"
+	"github.com/ignite-hq/cli/ignite/pkg/i18n"
 	"github.com/ignite-hq/cli/ignite/pkg/localfs"
+	"github.com/ignite-hq/cli/ignite/pkg/tendermintsigner"
 	"github.com/ignite-hq/cli/ignite/pkg/xexec"
 	"github.com/ignite-hq/cli/ignite/pkg/xfilepath"
 	"github.com/ignite-hq/cli/ignite/pkg/xhttp"
@@ -39,8 +42,20 @@ const (
 	// configChecksumKey is the cache key for containing the checksum to detect config modification
 	configChecksumKey = "config_checksum"
 
+	// configResetChecksumKey is the cache key of the checksum of the config without the name
+	// of the faucet account, to detect the config modifications that reset the state
+	configResetChecksumKey = "config_reset_checksum"
+
 	// serveDirchangeCacheNamespace is the name of the cache namespace for detecting changes in directories
 	serveDirchangeCacheNamespace = "serve.dirchange"
+
+	// serveStateSourceCacheNamespace is the name of the cache namespace of the time the source
+	// of the app producing the saved state was built at
+	serveStateSourceCacheNamespace = "serve.statesource"
+
+	// serveEndpointsCacheNamespace is the name of the cache namespace of the endpoints of the
+	// served chains
+	serveEndpointsCacheNamespace = "serve.endpoints"
 )
 
 var (
@@ -55,17 +70,90 @@ var (
 )
 
 type serveOptions struct {
-	forceReset bool
-	resetOnce  bool
+	forceReset      bool
+	resetOnce       bool
+	resetStateOnly  bool
+	resetGenesis    bool
+	resetOnBreak    bool
+	skipBuild       bool
+	exitOnBuildErr  bool
+	skipStateExport bool
 }
 
 func newServeOption() serveOptions {
 	return serveOptions{
 		forceReset: false,
 		resetOnce:  false,
+		skipBuild:  false,
 	}
 }
 
+// resetMode is the way the app state is reset when the chain is served.
+type resetMode int
+
+const (
+	// resetNone keeps the app state.
+	resetNone resetMode = iota
+
+	// resetAll removes the home of the chain and initializes it again.
+	resetAll
+
+	// resetState removes the blockchain data and keeps the genesis, the keys and the node ids.
+	resetState
+
+	// resetGenesis regenerates the genesis from the config and keeps the keys and the node ids.
+	resetGenesis
+)
+
+// resetMode returns the way the app state is reset by the next serve.
+func (o serveOptions) resetMode() resetMode {
+	switch {
+	case o.forceReset || o.resetOnce:
+		return resetAll
+	case o.resetStateOnly:
+		return resetState
+	case o.resetGenesis:
+		return resetGenesis
+	}
+	return resetNone
+}
+
+// initMode is the way the app is initialized by serve.
+type initMode int
+
+const (
+	// initRestart restarts the app with its existing state.
+	initRestart initMode = iota
+
+	// initAll initializes the app from scratch.
+	initAll
+
+	// initKeepKeys initializes the app again and keeps the keys and the node ids.
+	initKeepKeys
+
+	// initResetState removes the blockchain data and restarts the app from its genesis.
+	initResetState
+
+	// initImportState removes the blockchain data and imports the exported state.
+	initImportState
+)
+
+// serveInitMode returns the way the app is initialized by serve. isInit is false when the
+// app is not initialized yet or its state must be reset.
+func serveInitMode(isInit, appModified, exportGenesisExists bool, reset resetMode) initMode {
+	switch {
+	case !isInit && reset == resetGenesis:
+		return initKeepKeys
+	case isInit && reset == resetState:
+		return initResetState
+	case !isInit || (appModified && !exportGenesisExists):
+		return initAll
+	case appModified:
+		return initImportState
+	}
+	return initRestart
+}
+
 // ServeOption provides options for the serve command
 type ServeOption func(*serveOptions)
 
@@ -83,6 +171,55 @@ func ServeResetOnce() ServeOption {
 	}
 }
 
+// ServeResetStateOnly allows to remove the blockchain data when the chain is served once.
+// The genesis, the keys of the accounts and the node ids are kept, so wallets configured
+// with the accounts keep working.
+func ServeResetStateOnly() ServeOption {
+	return func(c *serveOptions) {
+		c.resetStateOnly = true
+	}
+}
+
+// ServeResetGenesis allows to regenerate the genesis from the config when the chain is served
+// once. The keys of the accounts and the node ids are kept.
+func ServeResetGenesis() ServeOption {
+	return func(c *serveOptions) {
+		c.resetGenesis = true
+	}
+}
+
+// ServeResetOnBreaking allows to remove the blockchain data when the app hash of the app
+// doesn't match the app hash of the saved state, e.g. after a change that breaks determinism.
+func ServeResetOnBreaking() ServeOption {
+	return func(c *serveOptions) {
+		c.resetOnBreak = true
+	}
+}
+
+// ServeSkipBuild allows to serve the chain using its existing binary
+// without compiling it from the source code
+func ServeSkipBuild() ServeOption {
+	return func(c *serveOptions) {
+		c.skipBuild = true
+	}
+}
+
+// ServeExitOnBuildError allows to stop serving the chain when the app cannot be built,
+// instead of waiting for a fix of the source code.
+func ServeExitOnBuildError() ServeOption {
+	return func(c *serveOptions) {
+		c.exitOnBuildErr = true
+	}
+}
+
+// ServeSkipStateExport skips the export of the state when the chain is stopped, the next serve
+// of the chain doesn't import the state of this one.
+func ServeSkipStateExport() ServeOption {
+	return func(c *serveOptions) {
+		c.skipStateExport = true
+	}
+}
+
 // Serve serves an app.
 func (c *Chain) Serve(ctx context.Context, cacheStorage cache.Storage, options ...ServeOption) error {
 	serveOptions := newServeOption()
@@ -93,7 +230,11 @@ func (c *Chain) Serve(ctx context.Context, cacheStorage cache.Storage, options .
 	}
 
 	// initial checks and setup.
-	if err := c.setup(); err != nil {
+	if serveOptions.skipBuild {
+		if err := c.CheckBinary(); err != nil {
+			return err
+		}
+	} else if err := c.setup(); err != nil {
 		return err
 	}
 
@@ -134,21 +275,20 @@ func (c *Chain) Serve(ctx context.Context, cacheStorage cache.Storage, options .
 				)
 				serveCtx, c.serveCancel = context.WithCancel(ctx)
 
-				// determine if the chain should reset the state
-				shouldReset := serveOptions.forceReset || serveOptions.resetOnce
-
 				// serve the app.
-				err = c.serve(serveCtx, cacheStorage, shouldReset)
+				err = c.serve(serveCtx, cacheStorage, serveOptions.resetMode(), serveOptions.skipBuild)
 				serveOptions.resetOnce = false
+				serveOptions.resetStateOnly = false
+				serveOptions.resetGenesis = false
 
 				switch {
 				case err == nil:
 				case errors.Is(err, context.Canceled):
 					// If the app has been served, we save the genesis state
-					if c.served {
+					if c.served && !serveOptions.skipStateExport {
 						c.served = false
 
-						fmt.Fprintln(c.stdLog().out, "💿 Saving genesis state...")
+						fmt.Fprintln(c.stdLog().out, "💿", i18n.T("Saving genesis state..."))
 
 						// If serve has been stopped, save the genesis state
 						if err := c.saveChainState(context.TODO(), commands); err != nil {
@@ -161,8 +301,10 @@ func (c *Chain) Serve(ctx context.Context, cacheStorage cache.Storage, options .
 							fmt.Fprintln(c.stdLog().err, err.Error())
 							return err
 						}
-						fmt.Fprintf(c.stdLog().out, "💿 Genesis state saved in %s\n", genesisPath)
+						fmt.Fprintln(c.stdLog().out, "💿", i18n.T("Genesis state saved in %s", genesisPath))
 					}
+				case errors.As(err, &buildErr) && serveOptions.exitOnBuildErr:
+					return err
 				case errors.As(err, &buildErr):
 					fmt.Fprintf(c.stdLog().err, "%s\n", errorColor(err.Error()))
 
@@ -171,7 +313,23 @@ func (c *Chain) Serve(ctx context.Context, cacheStorage cache.Storage, options .
 						fmt.Fprintln(c.stdLog().out, "see: https://github.com/ignite-hq/cli#configure")
 					}
 
-					fmt.Fprintf(c.stdLog().out, "%s\n", infoColor("Waiting for a fix before retrying..."))
+					fmt.Fprintf(c.stdLog().out, "%s\n", infoColor(i18n.T("Waiting for a fix before retrying...")))
+
+				case errors.As(err, &startErr) && startErr.IsAppHashMismatch():
+					c.reportAppHashMismatch(c.stdLog().out, cacheStorage)
+
+					if !serveOptions.resetOnBreak {
+						fmt.Fprintf(c.stdLog().out, "%s %s\n", infoColor(`Waiting for a fix before retrying...
+To restart from the genesis, keeping keys and node ids, launch:`), "ignite chain serve --reset-on-breaking")
+						break
+					}
+
+					// the refresher may already be pending a source change.
+					serveOptions.resetStateOnly = true
+					select {
+					case c.serveRefresher <- struct{}{}:
+					default:
+					}
 
 				case errors.As(err, &startErr):
 					// Parse returned error logs
@@ -198,14 +356,14 @@ If the new code is no longer compatible with the saved state, you can reset the
 
 	// routine to watch back-end
 	g.Go(func() error {
-		return c.watchAppBackend(ctx)
+		return c.watchAppBackend(ctx, serveOptions.skipBuild)
 	})
 
 	return g.Wait()
 }
 
 func (c *Chain) setup() error {
-	fmt.Fprintf(c.stdLog().out, "Cosmos SDK's version is: %s\n\n", infoColor(c.Version))
+	fmt.Fprintf(c.stdLog().out, "%s\n\n", i18n.T("Cosmos SDK's version is: %s", infoColor(c.Version)))
 
 	return c.checkSystem()
 }
@@ -227,12 +385,26 @@ func (c *Chain) refreshServe() {
 	c.serveRefresher <- struct{}{}
 }
 
-func (c *Chain) watchAppBackend(ctx context.Context) error {
-	watchPaths := appBackendSourceWatchPaths
+func (c *Chain) watchAppBackend(ctx context.Context, skipBuild bool) error {
+	var watchPaths []string
+
+	// source code changes have no effect on a prebuilt binary.
+	if !skipBuild {
+		watchPaths = append(watchPaths, appBackendSourceWatchPaths...)
+	}
 	if c.ConfigPath() != "" {
 		watchPaths = append(watchPaths, c.ConfigPath())
 	}
 
+	// contracts are built when they change if the optimizer is enabled.
+	if conf, err := c.Config(); err == nil && conf.Build.Wasm.Optimizer {
+		paths, err := c.contractsSourcePaths()
+		if err != nil {
+			return err
+		}
+		watchPaths = append(watchPaths, paths...)
+	}
+
 	return localfs.Watch(
 		ctx,
 		watchPaths,
@@ -247,7 +419,8 @@ func (c *Chain) watchAppBackend(ctx context.Context) error {
 // serve performs the operations to serve the blockchain: build, init and start
 // if the chain is already initialized and the file didn't changed, the app is directly started
 // if the files changed, the state is imported
-func (c *Chain) serve(ctx context.Context, cacheStorage cache.Storage, forceReset bool) error {
+// when skipBuild is true, the existing binary is used and source changes are ignored
+func (c *Chain) serve(ctx context.Context, cacheStorage cache.Storage, reset resetMode, skipBuild bool) error {
 	conf, err := c.Config()
 	if err != nil {
 		return &CannotBuildAppError{err}
@@ -276,20 +449,35 @@ func (c *Chain) serve(ctx context.Context, cacheStorage cache.Storage, forceRese
 			if err != nil {
 				return err
 			}
+			// the state is kept when the faucet key is rotated.
+			if configModified {
+				if configModified, err = c.configModifiedForReset(ctx, dirCache, conf); err != nil {
+					return err
+				}
+			}
 		}
 
-		if forceReset || configModified {
-			// if forceReset is set, we consider the app as being not initialized
-			fmt.Fprintln(c.stdLog().out, "🔄 Resetting the app state...")
+		switch {
+		case reset == resetAll || configModified:
+			// if the state is reset, we consider the app as being not initialized
+			fmt.Fprintln(c.stdLog().out, "🔄", i18n.T("Resetting the app state..."))
 			isInit = false
+		case reset == resetGenesis:
+			fmt.Fprintln(c.stdLog().out, "🔄", i18n.T("Regenerating the genesis, keeping keys and node ids..."))
+			isInit = false
+		case reset == resetState:
+			fmt.Fprintln(c.stdLog().out, "🔄", i18n.T("Resetting the blockchain data, keeping genesis, keys and node ids..."))
 		}
 	}
 
 	// check if source has been modified since last serve
 	// if the state must not be reset but the source has changed, we rebuild the chain and import the exported state
-	sourceModified, err := dirchange.HasDirChecksumChanged(dirCache, sourceChecksumKey, c.app.Path, appBackendSourceWatchPaths...)
-	if err != nil {
-		return err
+	var sourceModified bool
+	if !skipBuild {
+		sourceModified, err = dirchange.HasDirChecksumChanged(dirCache, sourceChecksumKey, c.app.Path, appBackendSourceWatchPaths...)
+		if err != nil {
+			return err
+		}
 	}
 
 	// we also consider the binary in the checksum to ensure the binary has not been changed by a third party
@@ -326,25 +514,52 @@ func (c *Chain) serve(ctx context.Context, cacheStorage cache.Storage, forceRese
 	}
 
 	// build phase
-	if !isInit || appModified {
-		// build the blockchain app
+	if !skipBuild && (!isInit || appModified) {
+		// build the blockchain app, the changes of the source made during the build may not be
+		// part of the binary.
+		builtAt := time.Now()
 		if err := c.build(ctx, cacheStorage, ""); err != nil {
 			return err
 		}
+		c.sourceBuiltAt = builtAt
+	}
+
+	// build the contracts when they changed.
+	var contractsBuilt bool
+	if conf.Build.Wasm.Optimizer {
+		if contractsBuilt, err = c.buildContractsIfChanged(ctx, dirCache); err != nil {
+			return &CannotBuildAppError{err}
+		}
 	}
 
 	// init phase
-	// nolint:gocritic
-	if !isInit || (appModified && !exportGenesisExists) {
-		fmt.Fprintln(c.stdLog().out, "💿 Initializing the app...")
+	// stateReset is true when the chain restarts from its genesis without its previous state.
+	stateReset := true
+
+	switch serveInitMode(isInit, appModified, exportGenesisExists, reset) {
+	case initKeepKeys:
+		fmt.Fprintln(c.stdLog().out, "💿", i18n.T("Initializing the app..."))
+
+		if err := c.initKeepingKeys(ctx); err != nil {
+			return err
+		}
+	case initResetState:
+		// the chain restarts from its genesis, the saved state is not imported.
+		if err := commands.UnsafeReset(ctx); err != nil {
+			return err
+		}
+	case initAll:
+		fmt.Fprintln(c.stdLog().out, "💿", i18n.T("Initializing the app..."))
 
 		if err := c.Init(ctx, true); err != nil {
 			return err
 		}
-	} else if appModified {
+	case initImportState:
+		stateReset = false
+
 		// if the chain is already initialized but the source has been modified
 		// we reset the chain database and import the genesis state
-		fmt.Fprintln(c.stdLog().out, "💿 Existent genesis detected, restoring the database...")
+		fmt.Fprintln(c.stdLog().out, "💿", i18n.T("Existent genesis detected, restoring the database..."))
 
 		if err := commands.UnsafeReset(ctx); err != nil {
 			return err
@@ -353,8 +568,9 @@ func (c *Chain) serve(ctx context.Context, cacheStorage cache.Storage, forceRese
 		if err := c.importChainState(); err != nil {
 			return err
 		}
-	} else {
-		fmt.Fprintln(c.stdLog().out, "▶️  Restarting existing app...")
+	default:
+		stateReset = false
+		fmt.Fprintln(c.stdLog().out, "▶️ ", i18n.T("Restarting existing app..."))
 	}
 
 	// save checksums
@@ -362,9 +578,18 @@ func (c *Chain) serve(ctx context.Context, cacheStorage cache.Storage, forceRese
 		if err := dirchange.SaveDirChecksum(dirCache, configChecksumKey, c.app.Path, c.ConfigPath()); err != nil {
 			return err
 		}
+		checksum, err := configResetChecksum(conf)
+		if err != nil {
+			return err
+		}
+		if err := dirCache.Put(configResetChecksumKey, checksum); err != nil {
+			return err
+		}
 	}
-	if err := dirchange.SaveDirChecksum(dirCache, sourceChecksumKey, c.app.Path, appBackendSourceWatchPaths...); err != nil {
-		return err
+	if !skipBuild {
+		if err := dirchange.SaveDirChecksum(dirCache, sourceChecksumKey, c.app.Path, appBackendSourceWatchPaths...); err != nil {
+			return err
+		}
 	}
 	binaryPath, err = exec.LookPath(binaryName)
 	if err != nil {
@@ -374,11 +599,32 @@ func (c *Chain) serve(ctx context.Context, cacheStorage cache.Storage, forceRese
 		return err
 	}
 
+	// the app produces the saved state once its node answers, the app hash of the state matched.
+	if !c.sourceBuiltAt.IsZero() {
+		stateCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		go c.saveStateSourceTime(stateCtx, cacheStorage, c.sourceBuiltAt)
+	}
+
+	// the endpoints of the chain are read by chain env while it is served.
+	endpointsCtx, cancelEndpoints := context.WithCancel(ctx)
+	endpointsSaved := make(chan struct{})
+	go func() {
+		defer close(endpointsSaved)
+		c.saveServedEndpoints(endpointsCtx, cacheStorage)
+	}()
+	defer func() {
+		cancelEndpoints()
+		<-endpointsSaved
+	}()
+
 	// start the blockchain
-	return c.start(ctx, conf)
+	return c.start(ctx, conf, stateReset, contractsBuilt)
 }
 
-func (c *Chain) start(ctx context.Context, config chainconfig.Config) error {
+// start starts the blockchain and the services served with it. stateReset is true when the
+// chain starts from its genesis and contractsBuilt when the contracts have just been built.
+func (c *Chain) start(ctx context.Context, config chainconfig.Config, stateReset, contractsBuilt bool) error {
 	commands, err := c.Commands(ctx)
 	if err != nil {
 		return err
@@ -407,6 +653,29 @@ func (c *Chain) start(ctx context.Context, config chainconfig.Config) error {
 			}
 			return nil
 		})
+
+		// the API keys added or revoked while the chain is served are applied to the faucet.
+		g.Go(func() error { return c.reloadFaucetAPIKeys(ctx, faucet) })
+	}
+
+	// start the builtin remote signer of the validator if enabled.
+	if signer := config.Validator.Signer; signer.Builtin {
+		g.Go(func() error { return c.runSigner(ctx, signer.Address) })
+	}
+
+	// start the relayer if paths are configured.
+	if len(config.Relayer.Paths) > 0 {
+		g.Go(func() error { return c.runRelayer(ctx, config, stateReset) })
+	}
+
+	// store the contracts if enabled and deploy the contracts of the config, on a new chain
+	// or when they changed.
+	var (
+		storeContracts  = config.Build.Wasm.Store && (stateReset || contractsBuilt)
+		deployContracts = len(config.Contracts) > 0 && (stateReset || contractsBuilt)
+	)
+	if storeContracts || deployContracts {
+		g.Go(func() error { return c.runContracts(ctx, storeContracts, deployContracts) })
 	}
 
 	// set the app as being served
@@ -418,12 +687,12 @@ func (c *Chain) start(ctx context.Context, config chainconfig.Config) error {
 	apiAddr, _ := xurl.HTTP(config.Host.API)
 
 	// print the server addresses.
-	fmt.Fprintf(c.stdLog().out, "🌍 Tendermint node: %s\n", rpcAddr)
-	fmt.Fprintf(c.stdLog().out, "🌍 Blockchain API: %s\n", apiAddr)
+	fmt.Fprintln(c.stdLog().out, "🌍", i18n.T("Tendermint node: %s", rpcAddr))
+	fmt.Fprintln(c.stdLog().out, "🌍", i18n.T("Blockchain API: %s", apiAddr))
 
 	if isFaucetEnabled {
 		faucetAddr, _ := xurl.HTTP(chainconfig.FaucetHost(config))
-		fmt.Fprintf(c.stdLog().out, "🌍 Token faucet: %s\n", faucetAddr)
+		fmt.Fprintln(c.stdLog().out, "🌍", i18n.T("Token faucet: %s", faucetAddr))
 	}
 
 	return g.Wait()
@@ -441,6 +710,24 @@ func (c *Chain) runFaucetServer(ctx context.Context, faucet cosmosfaucet.Faucet)
 	})
 }
 
+func (c *Chain) runSigner(ctx context.Context, address string) error {
+	chainID, err := c.ID()
+	if err != nil {
+		return err
+	}
+	home, err := c.Home()
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(c.stdLog().out, "🔏 Remote signer: %s\n", address)
+
+	return tendermintsigner.Run(ctx, chainID, address,
+		filepath.Join(home, "config", "priv_validator_key.json"),
+		filepath.Join(home, "data", "priv_validator_state.json"),
+	)
+}
+
 // saveChainState runs the export command of the chain and store the exported genesis in the chain saved config
 func (c *Chain) saveChainState(ctx context.Context, commands chaincmdrunner.Runner) error {
 	genesisPath, err := c.exportedGenesisPath()
@@ -0,0 +1,69 @@
+package chain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestServeOptionsResetMode(t *testing.T) {
+	cases := []struct {
+		name    string
+		options []ServeOption
+		want    resetMode
+	}{
+		{name: "no reset", want: resetNone},
+		{name: "force reset", options: []ServeOption{ServeForceReset()}, want: resetAll},
+		{name: "reset once", options: []ServeOption{ServeResetOnce()}, want: resetAll},
+		{name: "reset state only", options: []ServeOption{ServeResetStateOnly()}, want: resetState},
+		{name: "reset genesis", options: []ServeOption{ServeResetGenesis()}, want: resetGenesis},
+		{
+			name:    "reset once takes precedence",
+			options: []ServeOption{ServeResetOnce(), ServeResetStateOnly(), ServeResetGenesis()},
+			want:    resetAll,
+		},
+		{
+			name:    "reset state takes precedence over genesis",
+			options: []ServeOption{ServeResetGenesis(), ServeResetStateOnly()},
+			want:    resetState,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			o := newServeOption()
+			for _, apply := range tt.options {
+				apply(&o)
+			}
+			require.Equal(t, tt.want, o.resetMode())
+		})
+	}
+}
+
+func TestServeInitMode(t *testing.T) {
+	cases := []struct {
+		name                string
+		isInit              bool
+		appModified         bool
+		exportGenesisExists bool
+		reset               resetMode
+		want                initMode
+	}{
+		{name: "not initialized", want: initAll},
+		{name: "reset all", reset: resetAll, want: initAll},
+		{name: "reset genesis", reset: resetGenesis, want: initKeepKeys},
+		{name: "reset genesis of a modified app", appModified: true, reset: resetGenesis, want: initKeepKeys},
+		{name: "reset state", isInit: true, reset: resetState, want: initResetState},
+		{name: "reset state of a modified app", isInit: true, appModified: true, exportGenesisExists: true, reset: resetState, want: initResetState},
+		{name: "reset state when the config changed", reset: resetState, want: initAll},
+		{name: "restart", isInit: true, exportGenesisExists: true, want: initRestart},
+		{name: "modified app", isInit: true, appModified: true, exportGenesisExists: true, want: initImportState},
+		{name: "modified app without exported genesis", isInit: true, appModified: true, want: initAll},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, serveInitMode(tt.isInit, tt.appModified, tt.exportGenesisExists, tt.reset))
+		})
+	}
+}
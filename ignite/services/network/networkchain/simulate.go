@@ -79,8 +79,15 @@ func (c Chain) simulateChainStart(ctx context.Context) error {
 		return err
 	}
 
-	// set the config with random ports to test the start command
-	addressAPI, err := c.setSimulationConfig()
+	// set the config with random ports to test the start command, the ports are reserved
+	// so simulations running at the same time don't use the same ports.
+	lease, err := availableport.Reserve(5)
+	if err != nil {
+		return err
+	}
+	defer lease.Release()
+
+	addressAPI, err := c.setSimulationConfig(lease.Ports())
 	if err != nil {
 		return err
 	}
@@ -111,12 +118,7 @@ func (c Chain) simulateChainStart(ctx context.Context) error {
 }
 
 // setSimulationConfig sets in the config random available ports to allow check if the chain network can start
-func (c Chain) setSimulationConfig() (string, error) {
-	// generate random server ports and servers list
-	ports, err := availableport.Find(5)
-	if err != nil {
-		return "", err
-	}
+func (c Chain) setSimulationConfig(ports []int) (string, error) {
 	genAddr := func(port int) string {
 		return fmt.Sprintf("localhost:%d", port)
 	}
@@ -0,0 +1,88 @@
+package networktypes
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+)
+
+// metadataUpgradeKey is the key of the upgrade plan in the metadata of a chain.
+const metadataUpgradeKey = "upgrade"
+
+// UpgradePlan is a coordinated upgrade of a launched chain, the coordinator publishes the plan
+// in the metadata of the chain and the validators stage its binary into cosmovisor.
+type UpgradePlan struct {
+	// Name is the name of the upgrade of the software upgrade proposal.
+	Name string `json:"name"`
+
+	// Height is the height of the upgrade.
+	Height int64 `json:"height"`
+
+	// BinaryURL is the download URL of the new binary of the chain.
+	BinaryURL string `json:"binary_url"`
+
+	// Checksum is the hex encoded sha256 checksum of the binary.
+	Checksum string `json:"checksum"`
+}
+
+// Validate checks that the plan is complete.
+func (p UpgradePlan) Validate() error {
+	if p.Name == "" {
+		return errors.New("upgrade name is required")
+	}
+	if p.Height <= 0 {
+		return errors.New("upgrade height must be positive")
+	}
+	u, err := url.Parse(p.BinaryURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("invalid binary URL %q", p.BinaryURL)
+	}
+	if b, err := hex.DecodeString(p.Checksum); err != nil || len(b) != 32 {
+		return fmt.Errorf("invalid sha256 checksum %q", p.Checksum)
+	}
+	return nil
+}
+
+// UpgradePlanFromMetadata returns the upgrade plan of the metadata of a chain, it returns false
+// when no upgrade is planned.
+func UpgradePlanFromMetadata(metadata []byte) (plan UpgradePlan, found bool, err error) {
+	fields, err := metadataFields(metadata)
+	if err != nil {
+		return plan, false, err
+	}
+	data, ok := fields[metadataUpgradeKey]
+	if !ok {
+		return plan, false, nil
+	}
+	if err := json.Unmarshal(data, &plan); err != nil {
+		return plan, false, err
+	}
+	return plan, true, nil
+}
+
+// SetUpgradePlan returns the metadata of a chain with the upgrade plan, the other fields of
+// the metadata are kept.
+func SetUpgradePlan(metadata []byte, plan UpgradePlan) ([]byte, error) {
+	fields, err := metadataFields(metadata)
+	if err != nil {
+		return nil, err
+	}
+	if fields[metadataUpgradeKey], err = json.Marshal(plan); err != nil {
+		return nil, err
+	}
+	return json.Marshal(fields)
+}
+
+// metadataFields decodes the metadata of a chain as a JSON object.
+func metadataFields(metadata []byte) (map[string]json.RawMessage, error) {
+	fields := make(map[string]json.RawMessage)
+	if len(metadata) == 0 {
+		return fields, nil
+	}
+	if err := json.Unmarshal(metadata, &fields); err != nil {
+		return nil, errors.New("the metadata of the chain is not a JSON object")
+	}
+	return fields, nil
+}
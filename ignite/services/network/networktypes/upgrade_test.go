@@ -0,0 +1,54 @@
+package networktypes_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite-hq/cli/ignite/services/network/networktypes"
+)
+
+func TestUpgradePlanMetadata(t *testing.T) {
+	plan := networktypes.UpgradePlan{
+		Name:      "v2",
+		Height:    1000,
+		BinaryURL: "https://example.com/marsd",
+		Checksum:  "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+	}
+	require.NoError(t, plan.Validate())
+
+	_, found, err := networktypes.UpgradePlanFromMetadata(nil)
+	require.NoError(t, err)
+	require.False(t, found)
+
+	metadata, err := networktypes.SetUpgradePlan([]byte(`{"website":"https://mars.network"}`), plan)
+	require.NoError(t, err)
+	require.Contains(t, string(metadata), `"website":"https://mars.network"`)
+
+	got, found, err := networktypes.UpgradePlanFromMetadata(metadata)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, plan, got)
+
+	_, err = networktypes.SetUpgradePlan([]byte("not json"), plan)
+	require.Error(t, err)
+}
+
+func TestUpgradePlanValidate(t *testing.T) {
+	valid := networktypes.UpgradePlan{
+		Name:      "v2",
+		Height:    1000,
+		BinaryURL: "https://example.com/marsd",
+		Checksum:  "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+	}
+	for name, update := range map[string]func(*networktypes.UpgradePlan){
+		"no name":     func(p *networktypes.UpgradePlan) { p.Name = "" },
+		"no height":   func(p *networktypes.UpgradePlan) { p.Height = 0 },
+		"invalid url": func(p *networktypes.UpgradePlan) { p.BinaryURL = "example.com/marsd" },
+		"short sum":   func(p *networktypes.UpgradePlan) { p.Checksum = "e3b0c442" },
+	} {
+		plan := valid
+		update(&plan)
+		require.Error(t, plan.Validate(), name)
+	}
+}
@@ -0,0 +1,72 @@
+package network
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	launchtypes "github.com/tendermint/spn/x/launch/types"
+
+	"github.com/ignite-hq/cli/ignite/pkg/events"
+	"github.com/ignite-hq/cli/ignite/services/network/networktypes"
+)
+
+// ErrNoUpgrade is returned when no upgrade is planned for the chain.
+var ErrNoUpgrade = errors.New("no upgrade planned for the chain")
+
+// PublishUpgrade publishes the upgrade plan of a chain in its metadata, only the coordinator
+// of the chain can publish it. A published plan replaces the previous one.
+func (n Network) PublishUpgrade(ctx context.Context, launchID uint64, plan networktypes.UpgradePlan) error {
+	if err := plan.Validate(); err != nil {
+		return err
+	}
+
+	n.ev.Send(events.New(events.StatusOngoing, "Fetching chain information"))
+	res, err := n.launchQuery.Chain(ctx, &launchtypes.QueryGetChainRequest{LaunchID: launchID})
+	if err != nil {
+		return err
+	}
+	metadata, err := networktypes.SetUpgradePlan(res.Chain.Metadata, plan)
+	if err != nil {
+		return err
+	}
+
+	n.ev.Send(events.New(events.StatusOngoing, fmt.Sprintf("Publishing upgrade %s", plan.Name)))
+	msg := launchtypes.NewMsgEditChain(
+		n.account.Address(networktypes.SPN),
+		launchID,
+		false,
+		0,
+		metadata,
+	)
+	if _, err := n.cosmos.BroadcastTx(n.account.Name, msg); err != nil {
+		return err
+	}
+
+	n.ev.Send(events.New(events.StatusDone, fmt.Sprintf(
+		"Upgrade %s published for the chain %d at height %d",
+		plan.Name,
+		launchID,
+		plan.Height,
+	)))
+	return nil
+}
+
+// UpgradePlan fetches the upgrade plan of a chain, it returns ErrNoUpgrade when no upgrade
+// is planned.
+func (n Network) UpgradePlan(ctx context.Context, launchID uint64) (networktypes.UpgradePlan, error) {
+	n.ev.Send(events.New(events.StatusOngoing, "Fetching the upgrade plan"))
+	res, err := n.launchQuery.Chain(ctx, &launchtypes.QueryGetChainRequest{LaunchID: launchID})
+	if err != nil {
+		return networktypes.UpgradePlan{}, err
+	}
+
+	plan, found, err := networktypes.UpgradePlanFromMetadata(res.Chain.Metadata)
+	if err != nil {
+		return networktypes.UpgradePlan{}, err
+	}
+	if !found {
+		return networktypes.UpgradePlan{}, ErrNoUpgrade
+	}
+	return plan, plan.Validate()
+}
@@ -0,0 +1,63 @@
+package network
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	launchtypes "github.com/tendermint/spn/x/launch/types"
+
+	"github.com/ignite-hq/cli/ignite/services/network/networktypes"
+	"github.com/ignite-hq/cli/ignite/services/network/testutil"
+)
+
+func TestPublishUpgrade(t *testing.T) {
+	var (
+		account        = testutil.NewTestAccount(t, testutil.TestAccountName)
+		suite, network = newSuite(account)
+		plan           = networktypes.UpgradePlan{
+			Name:      "v2",
+			Height:    1000,
+			BinaryURL: "https://example.com/marsd",
+			Checksum:  "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+		}
+	)
+
+	suite.LaunchQueryMock.
+		On("Chain", context.Background(), &launchtypes.QueryGetChainRequest{LaunchID: testutil.LaunchID}).
+		Return(&launchtypes.QueryGetChainResponse{
+			Chain: launchtypes.Chain{Metadata: []byte(`{"website":"https://mars.network"}`)},
+		}, nil).
+		Once()
+	suite.CosmosClientMock.
+		On("BroadcastTx", account.Name, &launchtypes.MsgEditChain{
+			Coordinator: account.Address(networktypes.SPN),
+			LaunchID:    testutil.LaunchID,
+			Metadata: []byte(`{"upgrade":{"name":"v2","height":1000,"binary_url":"https://example.com/marsd",` +
+				`"checksum":"e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"},"website":"https://mars.network"}`),
+		}).
+		Return(testutil.NewResponse(&launchtypes.MsgEditChainResponse{}), nil).
+		Once()
+
+	require.NoError(t, network.PublishUpgrade(context.Background(), testutil.LaunchID, plan))
+	suite.AssertAllMocks(t)
+
+	plan.Checksum = "invalid"
+	require.Error(t, network.PublishUpgrade(context.Background(), testutil.LaunchID, plan))
+}
+
+func TestUpgradePlan(t *testing.T) {
+	var (
+		account        = testutil.NewTestAccount(t, testutil.TestAccountName)
+		suite, network = newSuite(account)
+	)
+
+	suite.LaunchQueryMock.
+		On("Chain", context.Background(), &launchtypes.QueryGetChainRequest{LaunchID: testutil.LaunchID}).
+		Return(&launchtypes.QueryGetChainResponse{}, nil).
+		Once()
+
+	_, err := network.UpgradePlan(context.Background(), testutil.LaunchID)
+	require.ErrorIs(t, err, ErrNoUpgrade)
+	suite.AssertAllMocks(t)
+}
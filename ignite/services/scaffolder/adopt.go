@@ -0,0 +1,156 @@
+package scaffolder
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/ignite-hq/cli/ignite/chainconfig"
+	"github.com/ignite-hq/cli/ignite/pkg/cosmosanalysis"
+	appanalysis "github.com/ignite-hq/cli/ignite/pkg/cosmosanalysis/app"
+	"github.com/ignite-hq/cli/ignite/pkg/goanalysis"
+)
+
+// adoptedConfig is the config.yml of an adopted chain, build is set when the main package
+// of the chain is not found by default.
+const adoptedConfig = `accounts:
+  - name: alice
+    coins: ["20000token", "200000000stake"]
+  - name: bob
+    coins: ["10000token", "100000000stake"]
+validator:
+  name: alice
+  staked: "100000000stake"
+faucet:
+  name: bob
+  coins: ["5token", "100000stake"]
+%s`
+
+// Adoption describes the changes made to a chain not scaffolded by Ignite CLI so the
+// scaffold and serve commands work on it.
+type Adoption struct {
+	// AppFile is the path of the app.go of the chain.
+	AppFile string
+
+	// Placeholders are the placeholders added to app.go.
+	Placeholders []string
+
+	// MissingPlaceholders are the placeholders that could not be added to app.go, the code
+	// scaffolded at these placeholders has to be added by hand.
+	MissingPlaceholders []string
+
+	// Modules are the modules of the chain in x/.
+	Modules []string
+
+	// ConfigFile is the path of the created config.yml, it is empty when the chain already
+	// has a config.
+	ConfigFile string
+}
+
+// Adopt analyzes the chain at the path of the scaffolder, which was not scaffolded by
+// Ignite CLI, and adds the placeholders and the config needed by the other commands.
+// Placeholders and configs that already exist are kept.
+func (s Scaffolder) Adopt() (Adoption, error) {
+	var (
+		a   Adoption
+		err error
+	)
+	if a.AppFile, err = cosmosanalysis.FindAppFilePath(s.path); err != nil {
+		return Adoption{}, err
+	}
+
+	src, err := os.ReadFile(a.AppFile)
+	if err != nil {
+		return Adoption{}, err
+	}
+	out, added, missing, err := appanalysis.AddPlaceholders(src)
+	if err != nil {
+		return Adoption{}, err
+	}
+	if len(added) > 0 {
+		if err := os.WriteFile(a.AppFile, out, 0o644); err != nil {
+			return Adoption{}, err
+		}
+	}
+	a.Placeholders = added
+	a.MissingPlaceholders = missing
+
+	if a.Modules, err = adoptedModules(s.path); err != nil {
+		return Adoption{}, err
+	}
+
+	_, err = chainconfig.LocateDefault(s.path)
+	if !errors.Is(err, chainconfig.ErrCouldntLocateConfig) {
+		return a, err
+	}
+	build, err := s.adoptedBuild()
+	if err != nil {
+		return Adoption{}, err
+	}
+	a.ConfigFile = filepath.Join(s.path, chainconfig.ConfigFileNames[0])
+	if err := os.WriteFile(a.ConfigFile, []byte(fmt.Sprintf(adoptedConfig, build)), 0o644); err != nil {
+		return Adoption{}, err
+	}
+	return a, nil
+}
+
+// adoptedBuild returns the build section of the config of the adopted chain, it is empty
+// when the main package and the binary of the chain are found by default.
+func (s Scaffolder) adoptedBuild() (string, error) {
+	mains, err := goanalysis.DiscoverMain(s.path)
+	if err != nil {
+		return "", err
+	}
+	if len(mains) == 0 {
+		return "", errors.New("main package cannot be found")
+	}
+
+	// the main package of a chain is in cmd/, the binary is named after its directory.
+	sort.Strings(mains)
+	main := mains[0]
+	for _, path := range mains {
+		if strings.HasPrefix(path, filepath.Join(s.path, "cmd")+string(filepath.Separator)) {
+			main = path
+			break
+		}
+	}
+	binary := filepath.Base(main)
+
+	var build []string
+	if len(mains) > 1 {
+		rel, err := filepath.Rel(s.path, main)
+		if err != nil {
+			return "", err
+		}
+		build = append(build, fmt.Sprintf("  main: %q", filepath.ToSlash(rel)))
+	}
+	if binary != s.modpath.Root+"d" {
+		build = append(build, fmt.Sprintf("  binary: %q", binary))
+	}
+	if len(build) == 0 {
+		return "", nil
+	}
+	return "build:\n" + strings.Join(build, "\n") + "\n", nil
+}
+
+// adoptedModules returns the names of the modules in the x/ directory of the chain at path.
+func adoptedModules(path string) ([]string, error) {
+	entries, err := os.ReadDir(filepath.Join(path, moduleDir))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var modules []string
+	for _, entry := range entries {
+		if entry.IsDir() {
+			modules = append(modules, entry.Name())
+		}
+	}
+	return modules, nil
+}
@@ -0,0 +1,112 @@
+package scaffolder
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+
+	"github.com/ignite-hq/cli/ignite/chainconfig"
+	"github.com/ignite-hq/cli/ignite/pkg/devcontainer"
+	"github.com/ignite-hq/cli/ignite/pkg/gomodule"
+	"github.com/ignite-hq/cli/ignite/pkg/gomodulepath"
+)
+
+const (
+	// devcontainerGoVersion is the Go version used when go.mod has no go directive.
+	devcontainerGoVersion = "1.18"
+
+	// devcontainerNodeVersion is the Node.js version used to build the Vue.js app.
+	devcontainerNodeVersion = "16"
+)
+
+// Devcontainer generates the devcontainer.json and the .gitpod.yml of the chain at appPath,
+// existing files are replaced.
+// The containers use the Go version of the chain's go.mod and expose the ports of config.yml.
+// igniteVersion is the version of Ignite CLI installed in the containers, the latest when empty.
+func Devcontainer(appPath, igniteVersion string) (paths []string, err error) {
+	path, err := gomodulepath.ParseAt(appPath)
+	if err != nil {
+		return nil, err
+	}
+	modfile, err := gomodule.ParseAt(appPath)
+	if err != nil {
+		return nil, err
+	}
+	confPath, err := chainconfig.LocateDefault(appPath)
+	if err != nil {
+		return nil, err
+	}
+	conf, err := chainconfig.ParseFile(confPath)
+	if err != nil {
+		return nil, err
+	}
+	ports, err := devcontainerPorts(conf)
+	if err != nil {
+		return nil, err
+	}
+
+	env := devcontainer.Environment{
+		Name:          path.Root,
+		GoVersion:     devcontainerGoVersion,
+		NodeVersion:   devcontainerNodeVersion,
+		IgniteVersion: igniteVersion,
+		Ports:         ports,
+	}
+	if modfile.Go != nil {
+		env.GoVersion = modfile.Go.Version
+	}
+
+	files := []struct {
+		path     string
+		generate func(devcontainer.Environment) ([]byte, error)
+	}{
+		{devcontainer.DevcontainerPath, devcontainer.Devcontainer},
+		{devcontainer.GitpodPath, devcontainer.Gitpod},
+	}
+	for _, file := range files {
+		data, err := file.generate(env)
+		if err != nil {
+			return nil, err
+		}
+		path := filepath.Join(appPath, file.path)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			return nil, err
+		}
+		if err := os.WriteFile(path, data, 0o644); err != nil {
+			return nil, err
+		}
+		paths = append(paths, path)
+	}
+	return paths, nil
+}
+
+// devcontainerPorts returns the ports of the hosts of the config and of the faucet when it is
+// enabled. The hosts are read from the fields of chainconfig.Host so the ports of new hosts are
+// exposed without changes to the generator.
+func devcontainerPorts(conf chainconfig.Config) ([]devcontainer.Port, error) {
+	var ports []devcontainer.Port
+
+	host := reflect.ValueOf(conf.Host)
+	for i := 0; i < host.NumField(); i++ {
+		address := host.Field(i).String()
+		if address == "" {
+			continue
+		}
+		name := strings.Split(host.Type().Field(i).Tag.Get("yaml"), ",")[0]
+		port, err := devcontainer.ParsePort(name, address)
+		if err != nil {
+			return nil, err
+		}
+		ports = append(ports, port)
+	}
+
+	if conf.Faucet.Name != nil {
+		port, err := devcontainer.ParsePort("faucet", chainconfig.FaucetHost(conf))
+		if err != nil {
+			return nil, err
+		}
+		ports = append(ports, port)
+	}
+	return ports, nil
+}
@@ -0,0 +1,55 @@
+package scaffolder
+
+import (
+	"net"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite-hq/cli/ignite/chainconfig"
+	"github.com/ignite-hq/cli/ignite/pkg/devcontainer"
+)
+
+func TestDevcontainerPorts(t *testing.T) {
+	conf := chainconfig.DefaultConf
+	conf.Faucet.Name = nil
+
+	ports, err := devcontainerPorts(conf)
+	require.NoError(t, err)
+
+	// every host of config.yml has a forwarded port.
+	host := reflect.TypeOf(conf.Host)
+	require.Len(t, ports, host.NumField())
+	for i := 0; i < host.NumField(); i++ {
+		field := host.Field(i)
+		name := strings.Split(field.Tag.Get("yaml"), ",")[0]
+		require.NotEmpty(t, name, "host %s has no yaml key", field.Name)
+
+		address, ok := reflect.ValueOf(conf.Host).Field(i).Interface().(string)
+		require.True(t, ok, "host %s is not an address", field.Name)
+		_, port, err := net.SplitHostPort(address)
+		require.NoError(t, err, "host %s has no default address", field.Name)
+		number, err := strconv.Atoi(port)
+		require.NoError(t, err)
+
+		require.Contains(t, ports, devcontainer.Port{Name: name, Number: number})
+	}
+}
+
+func TestDevcontainerPortsFaucet(t *testing.T) {
+	name := "bob"
+	conf := chainconfig.DefaultConf
+	conf.Host = chainconfig.Host{RPC: "localhost:26659"}
+	conf.Faucet.Name = &name
+	conf.Faucet.Port = 4501
+
+	ports, err := devcontainerPorts(conf)
+	require.NoError(t, err)
+	require.Equal(t, []devcontainer.Port{
+		{Name: "rpc", Number: 26659},
+		{Name: "faucet", Number: 4501},
+	}, ports)
+}
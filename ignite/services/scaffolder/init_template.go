@@ -0,0 +1,63 @@
+package scaffolder
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/ignite-hq/cli/ignite/pkg/cache"
+	"github.com/ignite-hq/cli/ignite/pkg/chaintemplate"
+	"github.com/ignite-hq/cli/ignite/pkg/cmdrunner/exec"
+	"github.com/ignite-hq/cli/ignite/pkg/cmdrunner/step"
+	"github.com/ignite-hq/cli/ignite/pkg/gomodulepath"
+)
+
+// InitFromTemplate initializes a new app with name from a chain template.
+// Variables hold the answers to the variables of the template by name.
+func InitFromTemplate(
+	ctx context.Context,
+	cacheStorage cache.Storage,
+	tpl chaintemplate.Template,
+	root,
+	name,
+	addressPrefix string,
+	variables map[string]string,
+) (path string, err error) {
+	if root, err = filepath.Abs(root); err != nil {
+		return "", err
+	}
+
+	pathInfo, err := gomodulepath.Parse(name)
+	if err != nil {
+		return "", err
+	}
+
+	path = filepath.Join(root, pathInfo.Root)
+
+	if _, err := os.Stat(path); err == nil {
+		return "", fmt.Errorf("%s already exists", path)
+	}
+
+	// create the project
+	if err := tpl.Generate(path, pathInfo, addressPrefix, variables); err != nil {
+		return "", err
+	}
+
+	if err := finish(cacheStorage, path, pathInfo.RawPath); err != nil {
+		return "", err
+	}
+
+	for _, hook := range tpl.Manifest.Hooks.PostScaffold {
+		if err := exec.Exec(ctx, []string{"sh", "-c", hook}, exec.StepOption(step.Workdir(path))); err != nil {
+			return "", fmt.Errorf("post scaffold hook %q: %w", hook, err)
+		}
+	}
+
+	// initialize git repository and perform the first commit
+	if err := initGit(path); err != nil {
+		return "", err
+	}
+
+	return path, nil
+}
@@ -3,7 +3,11 @@ package scaffolder
 import (
 	"context"
 	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/gobuffalo/genny"
 
 	"github.com/ignite-hq/cli/ignite/pkg/cache"
@@ -21,6 +25,7 @@ type messageOptions struct {
 	description       string
 	signer            string
 	withoutSimulation bool
+	fee               string
 }
 
 // newMessageOptions returns a messageOptions with default options
@@ -55,6 +60,14 @@ func WithoutSimulation() MessageOption {
 	}
 }
 
+// WithFee charges a protocol fee to the signer of the message, sent to the module account.
+// The fee is stored in the module params and the given coin is its default value.
+func WithFee(fee string) MessageOption {
+	return func(m *messageOptions) {
+		m.fee = fee
+	}
+}
+
 // AddMessage adds a new message to scaffolded app
 func (s Scaffolder) AddMessage(
 	ctx context.Context,
@@ -114,6 +127,13 @@ func (s Scaffolder) AddMessage(
 		return sm, err
 	}
 
+	var fee *sdk.Coin
+	if scaffoldingOpts.fee != "" {
+		if fee, err = parseMessageFee(s.path, moduleName, scaffoldingOpts.fee); err != nil {
+			return sm, err
+		}
+	}
+
 	var (
 		g    *genny.Generator
 		opts = &message.Options{
@@ -127,6 +147,7 @@ func (s Scaffolder) AddMessage(
 			MsgDesc:      scaffoldingOpts.description,
 			MsgSigner:    mfSigner,
 			NoSimulation: scaffoldingOpts.withoutSimulation,
+			Fee:          fee,
 		}
 	)
 
@@ -163,7 +184,7 @@ func (s Scaffolder) AddMessage(
 		return sm, err
 	}
 	gens = append(gens, g)
-	sm, err = xgenny.RunWithValidation(tracer, gens...)
+	sm, err = xgenny.RunWithMerge(cacheStorage, tracer, gens...)
 	if err != nil {
 		return sm, err
 	}
@@ -183,3 +204,29 @@ func checkForbiddenMessageField(name string) error {
 
 	return checkGoReservedWord(name)
 }
+
+// parseMessageFee parses the fee of a message and checks that the module can charge it.
+func parseMessageFee(appPath, moduleName, fee string) (*sdk.Coin, error) {
+	coin, err := sdk.ParseCoinNormalized(fee)
+	if err != nil {
+		return nil, fmt.Errorf("invalid fee %s: %w", fee, err)
+	}
+	if !coin.Amount.IsInt64() {
+		return nil, fmt.Errorf("fee amount %s is too large", coin.Amount)
+	}
+
+	// the fee is sent with the bank keeper of the module
+	keeperPath := filepath.Join(appPath, moduleDir, moduleName, "keeper", "keeper.go")
+	content, err := os.ReadFile(keeperPath)
+	if err != nil {
+		return nil, err
+	}
+	if !strings.Contains(string(content), "bankKeeper") {
+		return nil, fmt.Errorf(
+			"module %s must depend on the bank module to charge a fee, scaffold it with --dep bank",
+			moduleName,
+		)
+	}
+
+	return &coin, nil
+}
@@ -104,7 +104,7 @@ func (s *Scaffolder) AddOracle(
 	if err != nil {
 		return sm, err
 	}
-	sm, err = xgenny.RunWithValidation(tracer, g)
+	sm, err = xgenny.RunWithMerge(cacheStorage, tracer, g)
 	if err != nil {
 		return sm, err
 	}
@@ -25,6 +25,7 @@ const (
 type packetOptions struct {
 	withoutMessage bool
 	signer         string
+	ackErrorFields []string
 }
 
 // newPacketOptions returns a packetOptions with default options
@@ -51,6 +52,14 @@ func PacketWithSigner(signer string) PacketOption {
 	}
 }
 
+// PacketWithAckErrorFields defines the fields of a typed error acknowledgment that can be
+// returned when the packet is received.
+func PacketWithAckErrorFields(fields ...string) PacketOption {
+	return func(o *packetOptions) {
+		o.ackErrorFields = fields
+	}
+}
+
 // AddPacket adds a new type stype to scaffolded app by using optional type fields.
 func (s Scaffolder) AddPacket(
 	ctx context.Context,
@@ -120,26 +129,36 @@ func (s Scaffolder) AddPacket(
 		return sm, err
 	}
 
+	// check and parse acknowledgment error fields
+	if err := checkCustomTypes(ctx, s.path, moduleName, o.ackErrorFields); err != nil {
+		return sm, err
+	}
+	parsedAckErrorFields, err := field.ParseFields(o.ackErrorFields, checkForbiddenAckErrorField, signer)
+	if err != nil {
+		return sm, err
+	}
+
 	// Generate the packet
 	var (
 		g    *genny.Generator
 		opts = &ibc.PacketOptions{
-			AppName:    s.modpath.Package,
-			AppPath:    s.path,
-			ModulePath: s.modpath.RawPath,
-			ModuleName: moduleName,
-			PacketName: name,
-			Fields:     parsedPacketFields,
-			AckFields:  parsedAcksFields,
-			NoMessage:  o.withoutMessage,
-			MsgSigner:  mfSigner,
+			AppName:        s.modpath.Package,
+			AppPath:        s.path,
+			ModulePath:     s.modpath.RawPath,
+			ModuleName:     moduleName,
+			PacketName:     name,
+			Fields:         parsedPacketFields,
+			AckFields:      parsedAcksFields,
+			NoMessage:      o.withoutMessage,
+			MsgSigner:      mfSigner,
+			AckErrorFields: parsedAckErrorFields,
 		}
 	)
 	g, err = ibc.NewPacket(tracer, opts)
 	if err != nil {
 		return sm, err
 	}
-	sm, err = xgenny.RunWithValidation(tracer, g)
+	sm, err = xgenny.RunWithMerge(cacheStorage, tracer, g)
 	if err != nil {
 		return sm, err
 	}
@@ -181,3 +200,18 @@ func checkForbiddenPacketField(name string) error {
 
 	return checkGoReservedWord(name)
 }
+
+// checkForbiddenAckErrorField returns true if the name is forbidden as an acknowledgment error field name
+func checkForbiddenAckErrorField(name string) error {
+	mfName, err := multiformatname.NewName(name)
+	if err != nil {
+		return err
+	}
+
+	// the acknowledgment error type implements the error interface.
+	if mfName.LowerCase == "error" {
+		return fmt.Errorf("%s is used by the packet scaffolder", name)
+	}
+
+	return checkGoReservedWord(name)
+}
@@ -83,7 +83,7 @@ func (s Scaffolder) AddQuery(
 	if err != nil {
 		return sm, err
 	}
-	sm, err = xgenny.RunWithValidation(tracer, g)
+	sm, err = xgenny.RunWithMerge(cacheStorage, tracer, g)
 	if err != nil {
 		return sm, err
 	}
@@ -2,9 +2,11 @@ package scaffolder
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 
+	"github.com/cosmos/cosmos-sdk/types/bech32"
 	"github.com/gobuffalo/genny"
 
 	"github.com/ignite-hq/cli/ignite/pkg/cache"
@@ -35,11 +37,14 @@ type addTypeOptions struct {
 	isMap       bool
 	isSingleton bool
 
-	indexes []string
+	indexes          []string
+	secondaryIndexes []string
 
 	withoutMessage    bool
 	withoutSimulation bool
+	withoutOwnership  bool
 	signer            string
+	admin             string
 }
 
 // newAddTypeOptions returns a addTypeOptions with default options
@@ -92,6 +97,14 @@ func TypeWithFields(fields ...string) AddTypeOption {
 	}
 }
 
+// TypeWithSecondaryIndexes adds secondary indexes on fields of a map type, to look up
+// its entries by the value of these fields.
+func TypeWithSecondaryIndexes(fields ...string) AddTypeOption {
+	return func(o *addTypeOptions) {
+		o.secondaryIndexes = fields
+	}
+}
+
 // TypeWithoutMessage disables generating sdk compatible messages and tx related APIs.
 func TypeWithoutMessage() AddTypeOption {
 	return func(o *addTypeOptions) {
@@ -106,6 +119,21 @@ func TypeWithoutSimulation() AddTypeOption {
 	}
 }
 
+// TypeWithoutOwnership lets any account update and delete the values instead of only their owner.
+func TypeWithoutOwnership() AddTypeOption {
+	return func(o *addTypeOptions) {
+		o.withoutOwnership = true
+	}
+}
+
+// TypeWithAdmin allows the account with the given address to update and delete any value
+// besides its owner.
+func TypeWithAdmin(address string) AddTypeOption {
+	return func(o *addTypeOptions) {
+		o.admin = address
+	}
+}
+
 // TypeWithSigner provides a custom signer name for the message
 func TypeWithSigner(signer string) AddTypeOption {
 	return func(o *addTypeOptions) {
@@ -146,6 +174,19 @@ func (s Scaffolder) AddType(
 		return sm, err
 	}
 
+	if len(o.secondaryIndexes) > 0 && !o.isMap {
+		return sm, errors.New("secondary indexes can only be added to a map")
+	}
+
+	if o.admin != "" {
+		if o.withoutOwnership {
+			return sm, errors.New("an admin cannot be set when values are not owned")
+		}
+		if _, _, err := bech32.DecodeAndConvert(o.admin); err != nil {
+			return sm, fmt.Errorf("invalid admin address %s: %w", o.admin, err)
+		}
+	}
+
 	signer := ""
 	if !o.withoutMessage {
 		signer = o.signer
@@ -183,6 +224,8 @@ func (s Scaffolder) AddType(
 			NoSimulation: o.withoutSimulation,
 			MsgSigner:    mfSigner,
 			IsIBC:        isIBC,
+			Owned:        !o.withoutOwnership,
+			Admin:        o.admin,
 		}
 		gens []*genny.Generator
 	)
@@ -228,7 +271,7 @@ func (s Scaffolder) AddType(
 	case o.isList:
 		g, err = list.NewStargate(tracer, opts)
 	case o.isMap:
-		g, err = mapGenerator(tracer, opts, o.indexes)
+		g, err = mapGenerator(tracer, opts, o.indexes, o.secondaryIndexes)
 	case o.isSingleton:
 		g, err = singleton.NewStargate(tracer, opts)
 	default:
@@ -240,7 +283,7 @@ func (s Scaffolder) AddType(
 
 	// run the generation
 	gens = append(gens, g)
-	sm, err = xgenny.RunWithValidation(tracer, gens...)
+	sm, err = xgenny.RunWithMerge(cacheStorage, tracer, gens...)
 	if err != nil {
 		return sm, err
 	}
@@ -281,7 +324,12 @@ func checkForbiddenTypeField(name string) error {
 }
 
 // mapGenerator returns the template generator for a map
-func mapGenerator(replacer placeholder.Replacer, opts *typed.Options, indexes []string) (*genny.Generator, error) {
+func mapGenerator(
+	replacer placeholder.Replacer,
+	opts *typed.Options,
+	indexes,
+	secondaryIndexes []string,
+) (*genny.Generator, error) {
 	// Parse indexes with the associated type
 	parsedIndexes, err := field.ParseFields(indexes, checkForbiddenTypeIndex)
 	if err != nil {
@@ -299,6 +347,33 @@ func mapGenerator(replacer placeholder.Replacer, opts *typed.Options, indexes []
 		}
 	}
 
+	// Secondary indexes must be fields that can be used in a store key
+	var (
+		fields    = make(map[string]field.Field)
+		secondary = make(map[string]struct{})
+	)
+	for _, f := range opts.Fields {
+		fields[f.Name.LowerCamel] = f
+	}
+	for _, name := range secondaryIndexes {
+		mfName, err := multiformatname.NewName(name)
+		if err != nil {
+			return nil, err
+		}
+		f, ok := fields[mfName.LowerCamel]
+		if !ok {
+			return nil, fmt.Errorf("secondary index %s is not a field of the type", name)
+		}
+		if dt, ok := datatype.SupportedTypes[f.DatatypeName]; !ok || dt.NonIndex {
+			return nil, fmt.Errorf("field %s of type %s cannot be used as a secondary index", name, f.DatatypeName)
+		}
+		if _, ok := secondary[f.Name.LowerCamel]; ok {
+			return nil, fmt.Errorf("secondary index %s is defined twice", name)
+		}
+		secondary[f.Name.LowerCamel] = struct{}{}
+		opts.SecondaryIndexes = append(opts.SecondaryIndexes, f)
+	}
+
 	opts.Indexes = parsedIndexes
 	return maptype.NewStargate(replacer, opts)
 }
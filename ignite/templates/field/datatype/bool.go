@@ -11,6 +11,8 @@ var (
 	DataBool = DataType{
 		DataType:          func(string) string { return "bool" },
 		DefaultTestValue:  "false",
+		CLIUsage:          "true or false",
+		CLIExample:        "true",
 		ValueLoop:         "false",
 		ValueIndex:        "false",
 		ValueInvalidIndex: "false",
@@ -23,9 +25,9 @@ var (
 		CLIArgs: func(name multiformatname.Name, _, prefix string, argIndex int) string {
 			return fmt.Sprintf(`%s%s, err := cast.ToBoolE(args[%d])
             		if err != nil {
-                		return err
+                		%[4]v
             		}`,
-				prefix, name.UpperCamel, argIndex)
+				prefix, name.UpperCamel, argIndex, cliArgError(name))
 		},
 		ToBytes: func(name string) string {
 			return fmt.Sprintf(`%[1]vBytes := []byte{0}
@@ -36,6 +38,6 @@ var (
 		ToString: func(name string) string {
 			return fmt.Sprintf("strconv.FormatBool(%s)", name)
 		},
-		GoCLIImports: []GoImport{{Name: "github.com/spf13/cast"}},
+		GoCLIImports: []GoImport{{Name: "fmt"}, {Name: "github.com/spf13/cast"}},
 	}
 )
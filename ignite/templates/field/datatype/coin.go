@@ -11,6 +11,8 @@ var (
 	DataCoin = DataType{
 		DataType:         func(string) string { return "sdk.Coin" },
 		DefaultTestValue: "10token",
+		CLIUsage:         "coin with its amount and denom",
+		CLIExample:       "10token",
 		ProtoType: func(_, name string, index int) string {
 			return fmt.Sprintf("cosmos.base.v1beta1.Coin %s = %d [(gogoproto.nullable) = false]",
 				name, index)
@@ -19,10 +21,10 @@ var (
 		CLIArgs: func(name multiformatname.Name, _, prefix string, argIndex int) string {
 			return fmt.Sprintf(`%s%s, err := sdk.ParseCoinNormalized(args[%d])
 					if err != nil {
-						return err
-					}`, prefix, name.UpperCamel, argIndex)
+						%[4]v
+					}`, prefix, name.UpperCamel, argIndex, cliArgError(name))
 		},
-		GoCLIImports: []GoImport{{Name: "github.com/cosmos/cosmos-sdk/types", Alias: "sdk"}},
+		GoCLIImports: []GoImport{{Name: "fmt"}, {Name: "github.com/cosmos/cosmos-sdk/types", Alias: "sdk"}},
 		ProtoImports: []string{"gogoproto/gogo.proto", "cosmos/base/v1beta1/coin.proto"},
 		NonIndex:     true,
 	}
@@ -31,6 +33,8 @@ var (
 	DataCoinSlice = DataType{
 		DataType:         func(string) string { return "sdk.Coins" },
 		DefaultTestValue: "10token,20stake",
+		CLIUsage:         "comma separated list of coins",
+		CLIExample:       "10token,20stake",
 		ProtoType: func(_, name string, index int) string {
 			return fmt.Sprintf("repeated cosmos.base.v1beta1.Coin %s = %d [(gogoproto.nullable) = false]",
 				name, index)
@@ -39,10 +43,10 @@ var (
 		CLIArgs: func(name multiformatname.Name, _, prefix string, argIndex int) string {
 			return fmt.Sprintf(`%s%s, err := sdk.ParseCoinsNormalized(args[%d])
 					if err != nil {
-						return err
-					}`, prefix, name.UpperCamel, argIndex)
+						%[4]v
+					}`, prefix, name.UpperCamel, argIndex, cliArgError(name))
 		},
-		GoCLIImports: []GoImport{{Name: "github.com/cosmos/cosmos-sdk/types", Alias: "sdk"}},
+		GoCLIImports: []GoImport{{Name: "fmt"}, {Name: "github.com/cosmos/cosmos-sdk/types", Alias: "sdk"}},
 		ProtoImports: []string{"gogoproto/gogo.proto", "cosmos/base/v1beta1/coin.proto"},
 		NonIndex:     true,
 	}
@@ -11,6 +11,8 @@ var (
 	DataCustom = DataType{
 		DataType:         func(datatype string) string { return fmt.Sprintf("*%s", datatype) },
 		DefaultTestValue: "null",
+		CLIUsage:         "JSON object",
+		CLIExample:       "{}",
 		ProtoType: func(datatype, name string, index int) string {
 			return fmt.Sprintf("%s %s = %d", datatype, name, index)
 		},
@@ -21,10 +23,10 @@ var (
 			return fmt.Sprintf(`%[1]v%[2]v := new(types.%[3]v)
 					err = json.Unmarshal([]byte(args[%[4]v]), %[1]v%[2]v)
     				if err != nil {
-                		return err
-            		}`, prefix, name.UpperCamel, datatype, argIndex)
+                		%[5]v
+            		}`, prefix, name.UpperCamel, datatype, argIndex, cliArgError(name))
 		},
-		GoCLIImports: []GoImport{{Name: "encoding/json"}},
+		GoCLIImports: []GoImport{{Name: "encoding/json"}, {Name: "fmt"}},
 		NonIndex:     true,
 	}
 )
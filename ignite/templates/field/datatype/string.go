@@ -11,6 +11,8 @@ var (
 	DataString = DataType{
 		DataType:          func(string) string { return "string" },
 		DefaultTestValue:  "xyz",
+		CLIUsage:          "text",
+		CLIExample:        "foo",
 		ValueLoop:         "strconv.Itoa(i)",
 		ValueIndex:        "strconv.Itoa(0)",
 		ValueInvalidIndex: "strconv.Itoa(100000)",
@@ -35,6 +37,8 @@ var (
 	DataStringSlice = DataType{
 		DataType:         func(string) string { return "[]string" },
 		DefaultTestValue: "abc,xyz",
+		CLIUsage:         "comma separated list of texts",
+		CLIExample:       "foo,bar",
 		ProtoType: func(_, name string, index int) string {
 			return fmt.Sprintf("repeated string %s = %d", name, index)
 		},
@@ -1,6 +1,8 @@
 package datatype
 
 import (
+	"fmt"
+
 	"github.com/ignite-hq/cli/ignite/pkg/multiformatname"
 )
 
@@ -73,6 +75,8 @@ type DataType struct {
 	ProtoImports      []string
 	GoCLIImports      []GoImport
 	DefaultTestValue  string
+	CLIUsage          string
+	CLIExample        string
 	ValueLoop         string
 	ValueIndex        string
 	ValueInvalidIndex string
@@ -87,3 +91,9 @@ type GoImport struct {
 	Name  string
 	Alias string
 }
+
+// cliArgError returns the statement that returns the parse error of the CLI arg name. The
+// message is quoted with backquotes because plush escapes double quotes.
+func cliArgError(name multiformatname.Name) string {
+	return fmt.Sprintf("return fmt.Errorf(`invalid [%s] argument: %%w`, err)", name.Kebab)
+}
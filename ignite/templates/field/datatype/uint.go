@@ -11,6 +11,8 @@ var (
 	DataUint = DataType{
 		DataType:          func(string) string { return "uint64" },
 		DefaultTestValue:  "111",
+		CLIUsage:          "unsigned integer",
+		CLIExample:        "10",
 		ValueLoop:         "uint64(i)",
 		ValueIndex:        "0",
 		ValueInvalidIndex: "100000",
@@ -23,9 +25,9 @@ var (
 		CLIArgs: func(name multiformatname.Name, _, prefix string, argIndex int) string {
 			return fmt.Sprintf(`%s%s, err := cast.ToUint64E(args[%d])
             		if err != nil {
-                		return err
+                		%[4]v
             		}`,
-				prefix, name.UpperCamel, argIndex)
+				prefix, name.UpperCamel, argIndex, cliArgError(name))
 		},
 		ToBytes: func(name string) string {
 			return fmt.Sprintf(`%[1]vBytes := make([]byte, 8)
@@ -34,13 +36,15 @@ var (
 		ToString: func(name string) string {
 			return fmt.Sprintf("strconv.Itoa(int(%s))", name)
 		},
-		GoCLIImports: []GoImport{{Name: "github.com/spf13/cast"}},
+		GoCLIImports: []GoImport{{Name: "fmt"}, {Name: "github.com/spf13/cast"}},
 	}
 
 	// DataUintSlice uint array data type definition
 	DataUintSlice = DataType{
 		DataType:         func(string) string { return "[]uint64" },
 		DefaultTestValue: "1,2,3,4,5",
+		CLIUsage:         "comma separated list of unsigned integers",
+		CLIExample:       "1,2,3",
 		ProtoType: func(_, name string, index int) string {
 			return fmt.Sprintf("repeated uint64 %s = %d", name, index)
 		},
@@ -53,13 +57,13 @@ var (
 					for i, arg := range %[1]vCast%[2]v {
 						value, err := cast.ToUint64E(arg)
 						if err != nil {
-							return err
+							%[4]v
 						}
 						%[1]v%[2]v[i] = value
 					}`,
-				prefix, name.UpperCamel, argIndex)
+				prefix, name.UpperCamel, argIndex, cliArgError(name))
 		},
-		GoCLIImports: []GoImport{{Name: "github.com/spf13/cast"}, {Name: "strings"}},
+		GoCLIImports: []GoImport{{Name: "fmt"}, {Name: "github.com/spf13/cast"}, {Name: "strings"}},
 		NonIndex:     true,
 	}
 )
@@ -102,6 +102,24 @@ func (f Field) CLIArgs(prefix string, argIndex int) string {
 	return dt.CLIArgs(f.Name, f.Datatype, prefix, argIndex)
 }
 
+// CLIUsage returns the description of the format of the Datatype CLI arg
+func (f Field) CLIUsage() string {
+	dt, ok := datatype.SupportedTypes[f.DatatypeName]
+	if !ok {
+		panic(fmt.Sprintf("unknown type %s", f.DatatypeName))
+	}
+	return dt.CLIUsage
+}
+
+// CLIExample returns an example of the Datatype CLI arg
+func (f Field) CLIExample() string {
+	dt, ok := datatype.SupportedTypes[f.DatatypeName]
+	if !ok {
+		panic(fmt.Sprintf("unknown type %s", f.DatatypeName))
+	}
+	return dt.CLIExample
+}
+
 // ToBytes returns the Datatype byte array cast
 func (f Field) ToBytes(name string) string {
 	dt, ok := datatype.SupportedTypes[f.DatatypeName]
@@ -2,6 +2,7 @@ package field
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/ignite-hq/cli/ignite/pkg/multiformatname"
 	"github.com/ignite-hq/cli/ignite/templates/field/datatype"
@@ -51,6 +52,31 @@ func (f Fields) String() string {
 	return args
 }
 
+// CLIUsage return the description of all args for command long help, one arg per line
+func (f Fields) CLIUsage() string {
+	width := 0
+	for _, field := range f {
+		if len(field.Name.Kebab) > width {
+			width = len(field.Name.Kebab)
+		}
+	}
+	var lines []string
+	for _, field := range f {
+		arg := fmt.Sprintf("[%s]", field.Name.Kebab)
+		lines = append(lines, fmt.Sprintf("  %-*s  %s", width+2, arg, field.CLIUsage()))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// CLIExample return all inline example args for command examples
+func (f Fields) CLIExample() string {
+	args := ""
+	for _, field := range f {
+		args += " " + field.CLIExample()
+	}
+	return args
+}
+
 // Custom return a list of custom fields
 func (f Fields) Custom() []string {
 	fields := make([]string, 0)
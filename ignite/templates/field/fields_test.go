@@ -0,0 +1,17 @@
+package field
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFieldsCLI(t *testing.T) {
+	fields, err := ParseFields([]string{"amount:coin", "ids:array.uint", "owner"}, noCheck)
+	require.NoError(t, err)
+
+	require.Equal(t, `  [amount]  coin with its amount and denom
+  [ids]     comma separated list of unsigned integers
+  [owner]   text`, fields.CLIUsage())
+	require.Equal(t, " 10token 1,2,3 foo", fields.CLIExample())
+}
@@ -1,6 +1,8 @@
 package plushhelpers
 
 import (
+	"sort"
+
 	"github.com/gobuffalo/plush"
 
 	"github.com/ignite-hq/cli/ignite/pkg/xstrings"
@@ -9,6 +11,8 @@ import (
 )
 
 // ExtendPlushContext sets available field helpers on the provided context.
+// The merge helpers return sorted imports so the output of the templates doesn't depend on
+// the order of the fields.
 func ExtendPlushContext(ctx *plush.Context) {
 	ctx.Set("mergeGoImports", mergeGoImports)
 	ctx.Set("mergeProtoImports", mergeProtoImports)
@@ -28,6 +32,7 @@ func mergeCustomImports(fields ...field.Fields) []string {
 			allImports = append(allImports, customImport)
 		}
 	}
+	sort.Strings(allImports)
 	return allImports
 }
 
@@ -43,6 +48,7 @@ func mergeGoImports(fields ...field.Fields) []datatype.GoImport {
 			allImports = append(allImports, goImport)
 		}
 	}
+	sort.Slice(allImports, func(i, j int) bool { return allImports[i].Name < allImports[j].Name })
 	return allImports
 }
 
@@ -58,5 +64,6 @@ func mergeProtoImports(fields ...field.Fields) []string {
 			allImports = append(allImports, protoImport)
 		}
 	}
+	sort.Strings(allImports)
 	return allImports
 }
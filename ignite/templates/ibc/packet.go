@@ -39,6 +39,10 @@ type PacketOptions struct {
 	Fields     field.Fields
 	AckFields  field.Fields
 	NoMessage  bool
+
+	// AckErrorFields are the fields of the typed error acknowledgment. No typed
+	// error is scaffolded when there are no fields.
+	AckErrorFields field.Fields
 }
 
 // NewPacket returns the generator to scaffold a packet in an IBC module
@@ -85,6 +89,7 @@ func NewPacket(replacer placeholder.Replacer, opts *PacketOptions) (*genny.Gener
 	ctx.Set("MsgSigner", opts.MsgSigner)
 	ctx.Set("fields", opts.Fields)
 	ctx.Set("ackFields", opts.AckFields)
+	ctx.Set("ackErrorFields", opts.AckErrorFields)
 
 	plushhelpers.ExtendPlushContext(ctx)
 	g.Transformer(plushgen.Transformer(ctx))
@@ -111,7 +116,7 @@ func moduleModify(replacer placeholder.Replacer, opts *PacketOptions) genny.RunF
 		templateRecv := `case *types.%[2]vPacketData_%[3]vPacket:
 	packetAck, err := am.keeper.OnRecv%[3]vPacket(ctx, modulePacket, *packet.%[3]vPacket)
 	if err != nil {
-		ack = channeltypes.NewErrorAcknowledgement(err.Error())
+		ack = %[4]v
 	} else {
 		// Encode packet acknowledgment
 		packetAckBytes, err := types.ModuleCdc.MarshalJSON(&packetAck)
@@ -128,11 +133,17 @@ func moduleModify(replacer placeholder.Replacer, opts *PacketOptions) genny.RunF
 		),
 	)
 %[1]v`
+		// Typed acknowledgment errors are encoded in the error acknowledgment
+		errorAck := "channeltypes.NewErrorAcknowledgement(err.Error())"
+		if len(opts.AckErrorFields) > 0 {
+			errorAck = fmt.Sprintf("types.New%vPacketErrorAcknowledgement(err)", opts.PacketName.UpperCamel)
+		}
 		replacementRecv := fmt.Sprintf(
 			templateRecv,
 			PlaceholderIBCPacketModuleRecv,
 			xstrings.Title(opts.ModuleName),
 			opts.PacketName.UpperCamel,
+			errorAck,
 		)
 		content := replacer.Replace(f.String(), PlaceholderIBCPacketModuleRecv, replacementRecv)
 
@@ -207,9 +218,16 @@ func protoModify(replacer placeholder.Replacer, opts *PacketOptions) genny.RunFn
 			ackFields += fmt.Sprintf("  %s;\n", field.ProtoType(i+1))
 		}
 
+		var ackErrorFields string
+		for i, field := range opts.AckErrorFields {
+			ackErrorFields += fmt.Sprintf("  %s;\n", field.ProtoType(i+1))
+		}
+
 		// Ensure custom types are imported
 		protoImports := append(opts.Fields.ProtoImports(), opts.AckFields.ProtoImports()...)
+		protoImports = append(protoImports, opts.AckErrorFields.ProtoImports()...)
 		customFields := append(opts.Fields.Custom(), opts.AckFields.Custom()...)
+		customFields = append(customFields, opts.AckErrorFields.Custom()...)
 		for _, f := range customFields {
 			protoImports = append(protoImports,
 				fmt.Sprintf("%[1]v/%[2]v.proto", opts.ModuleName, f),
@@ -231,13 +249,24 @@ message %[2]vPacketData {
 // %[2]vPacketAck defines a struct for the packet acknowledgment
 message %[2]vPacketAck {
 	%[4]v}
-%[1]v`
+%[5]v%[1]v`
+
+		var ackErrorMessage string
+		if len(opts.AckErrorFields) > 0 {
+			ackErrorMessage = fmt.Sprintf(`
+// %[1]vPacketAckError defines a struct for the packet acknowledgment error
+message %[1]vPacketAckError {
+%[2]v}
+`, opts.PacketName.UpperCamel, ackErrorFields)
+		}
+
 		replacementMessage := fmt.Sprintf(
 			templateMessage,
 			PlaceholderIBCPacketProtoMessage,
 			opts.PacketName.UpperCamel,
 			packetFields,
 			ackFields,
+			ackErrorMessage,
 		)
 		content = replacer.Replace(content, PlaceholderIBCPacketProtoMessage, replacementMessage)
 
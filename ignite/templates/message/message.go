@@ -18,6 +18,9 @@ var (
 
 	//go:embed stargate/simapp/* stargate/simapp/**/*
 	fsStargateSimapp embed.FS
+
+	//go:embed stargate/fee/* stargate/fee/**/*
+	fsStargateFee embed.FS
 )
 
 func Box(box packd.Walker, opts *Options, g *genny.Generator) error {
@@ -33,6 +36,7 @@ func Box(box packd.Walker, opts *Options, g *genny.Generator) error {
 	ctx.Set("ModulePath", opts.ModulePath)
 	ctx.Set("Fields", opts.Fields)
 	ctx.Set("ResFields", opts.ResFields)
+	ctx.Set("HasFee", opts.Fee != nil)
 
 	plushhelpers.ExtendPlushContext(ctx)
 	g.Transformer(plushgen.Transformer(ctx))
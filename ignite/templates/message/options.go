@@ -1,6 +1,8 @@
 package message
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/ignite-hq/cli/ignite/pkg/multiformatname"
 	"github.com/ignite-hq/cli/ignite/templates/field"
 )
@@ -17,6 +19,9 @@ type Options struct {
 	Fields       field.Fields
 	ResFields    field.Fields
 	NoSimulation bool
+
+	// Fee is the protocol fee charged to the signer of the message, when set.
+	Fee *sdk.Coin
 }
 
 // Validate that options are usuable
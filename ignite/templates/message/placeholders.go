@@ -9,4 +9,7 @@ const (
 	PlaceholderProtoTxMessage = "// this line is used by starport scaffolding # proto/tx/message"
 
 	PlaceholderHandlerMsgServer = "// this line is used by starport scaffolding # handler/msgServer"
+
+	// PlaceholderBankKeeperMethods is the comment of the expected bank keeper where its methods are added.
+	PlaceholderBankKeeperMethods = "// Methods imported from bank should be defined here"
 )
@@ -3,12 +3,16 @@ package message
 import (
 	"fmt"
 	"path/filepath"
+	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/gobuffalo/genny"
 
+	"github.com/ignite-hq/cli/ignite/pkg/multiformatname"
 	"github.com/ignite-hq/cli/ignite/pkg/placeholder"
 	"github.com/ignite-hq/cli/ignite/pkg/xgenny"
+	"github.com/ignite-hq/cli/ignite/templates/module"
 	"github.com/ignite-hq/cli/ignite/templates/typed"
 )
 
@@ -22,12 +26,30 @@ func NewStargate(replacer placeholder.Replacer, opts *Options) (*genny.Generator
 	g.RunFn(typesCodecModify(replacer, opts))
 	g.RunFn(clientCliTxModify(replacer, opts))
 
+	if opts.Fee != nil {
+		g.RunFn(protoParamsFeeModify(replacer, opts))
+		g.RunFn(typesParamsFeeModify(replacer, opts))
+		g.RunFn(keeperParamsFeeModify(replacer, opts))
+		g.RunFn(expectedKeepersFeeModify(replacer, opts))
+	}
+
 	template := xgenny.NewEmbedWalker(
 		fsStargateMessage,
 		"stargate/message",
 		opts.AppPath,
 	)
 
+	if opts.Fee != nil {
+		feeTemplate := xgenny.NewEmbedWalker(
+			fsStargateFee,
+			"stargate/fee",
+			opts.AppPath,
+		)
+		if err := Box(feeTemplate, opts, g); err != nil {
+			return nil, err
+		}
+	}
+
 	if !opts.NoSimulation {
 		g.RunFn(moduleSimulationModify(replacer, opts))
 		simappTemplate := xgenny.NewEmbedWalker(
@@ -213,3 +235,176 @@ func moduleSimulationModify(replacer placeholder.Replacer, opts *Options) genny.
 		return r.File(newFile)
 	}
 }
+
+func protoParamsFeeModify(replacer placeholder.Replacer, opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "proto", opts.ModuleName, "params.proto")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+		content := f.String()
+
+		// Ensure the coin type is imported
+		coinImport := `import "cosmos/base/v1beta1/coin.proto";`
+		if !strings.Contains(content, coinImport) {
+			gogoImport := `import "gogoproto/gogo.proto";`
+			content = strings.Replace(content, gogoImport, gogoImport+"\n"+coinImport, 1)
+		}
+
+		// Number the field after the last field of the params
+		var number int
+		for _, m := range protoFieldNumber.FindAllStringSubmatch(content, -1) {
+			if n, _ := strconv.Atoi(m[1]); n > number {
+				number = n
+			}
+		}
+
+		template := `cosmos.base.v1beta1.Coin %[2]v = %[3]v [(gogoproto.nullable) = false, (gogoproto.moretags) = "yaml:\"%[2]v\""];
+  %[1]v`
+		replacement := fmt.Sprintf(template,
+			module.PlaceholderParamsProtoField,
+			feeParamName(opts).Snake,
+			number+1,
+		)
+		content = replacer.Replace(content, module.PlaceholderParamsProtoField, replacement)
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+func typesParamsFeeModify(replacer placeholder.Replacer, opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "x", opts.ModuleName, "types/params.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+		content := f.String()
+
+		// Ensure the packages used by the param are imported
+		for _, imp := range []string{`"fmt"`, `sdk "github.com/cosmos/cosmos-sdk/types"`} {
+			if !strings.Contains(content, imp) {
+				content = strings.Replace(content, "import (", "import (\n\t"+imp, 1)
+			}
+		}
+
+		name := feeParamName(opts).UpperCamel
+
+		template := `var (
+	Key%[2]v = []byte("%[2]v")
+	Default%[2]v = sdk.NewInt64Coin("%[3]v", %[4]v)
+)
+
+%[1]v`
+		replacement := fmt.Sprintf(template,
+			module.PlaceholderParamsKeys,
+			name,
+			opts.Fee.Denom,
+			opts.Fee.Amount.Int64(),
+		)
+		content = replacer.Replace(content, module.PlaceholderParamsKeys, replacement)
+
+		replacement = fmt.Sprintf("%[2]v sdk.Coin,\n%[1]v", module.PlaceholderParamsNewArgs, feeParamName(opts).LowerCamel)
+		content = replacer.Replace(content, module.PlaceholderParamsNewArgs, replacement)
+
+		replacement = fmt.Sprintf("%[2]v: %[3]v,\n%[1]v", module.PlaceholderParamsNewFields, name, feeParamName(opts).LowerCamel)
+		content = replacer.Replace(content, module.PlaceholderParamsNewFields, replacement)
+
+		replacement = fmt.Sprintf("Default%[2]v,\n%[1]v", module.PlaceholderParamsDefaults, name)
+		content = replacer.Replace(content, module.PlaceholderParamsDefaults, replacement)
+
+		replacement = fmt.Sprintf(
+			"paramtypes.NewParamSetPair(Key%[2]v, &p.%[2]v, validate%[2]v),\n%[1]v",
+			module.PlaceholderParamsPairs,
+			name,
+		)
+		content = replacer.Replace(content, module.PlaceholderParamsPairs, replacement)
+
+		template = `if err := validate%[2]v(p.%[2]v); err != nil {
+		return err
+	}
+	%[1]v`
+		replacement = fmt.Sprintf(template, module.PlaceholderParamsValidate, name)
+		content = replacer.Replace(content, module.PlaceholderParamsValidate, replacement)
+
+		template = `// validate%[2]v validates the %[2]v param
+func validate%[2]v(v interface{}) error {
+	fee, ok := v.(sdk.Coin)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %%T", v)
+	}
+
+	return fee.Validate()
+}
+
+%[1]v`
+		replacement = fmt.Sprintf(template, module.PlaceholderParamsValidateFuncs, name)
+		content = replacer.Replace(content, module.PlaceholderParamsValidateFuncs, replacement)
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+func keeperParamsFeeModify(replacer placeholder.Replacer, opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "x", opts.ModuleName, "keeper/params.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		name := feeParamName(opts).UpperCamel
+
+		replacement := fmt.Sprintf("k.%[2]v(ctx),\n%[1]v", module.PlaceholderParamsGetterArgs, name)
+		content := replacer.Replace(f.String(), module.PlaceholderParamsGetterArgs, replacement)
+
+		template := `// %[2]v returns the %[2]v param
+func (k Keeper) %[2]v(ctx sdk.Context) (res sdk.Coin) {
+	k.paramstore.Get(ctx, types.Key%[2]v, &res)
+	return
+}
+
+%[1]v`
+		replacement = fmt.Sprintf(template, module.PlaceholderParamsGetters, name)
+		content = replacer.Replace(content, module.PlaceholderParamsGetters, replacement)
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+func expectedKeepersFeeModify(replacer placeholder.Replacer, opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "x", opts.ModuleName, "types/expected_keepers.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+		content := f.String()
+
+		// The method is already expected when another message charges a fee
+		if strings.Contains(content, "SendCoinsFromAccountToModule(") {
+			return nil
+		}
+
+		template := `SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	%[1]v`
+		replacement := fmt.Sprintf(template, PlaceholderBankKeeperMethods)
+		content = replacer.Replace(content, PlaceholderBankKeeperMethods, replacement)
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+// protoFieldNumber matches the number of the fields in a proto message.
+var protoFieldNumber = regexp.MustCompile(`=\s*(\d+)\s*[\[;]`)
+
+// feeParamName returns the name of the param that holds the fee of the message.
+func feeParamName(opts *Options) multiformatname.Name {
+	name, _ := multiformatname.NewName(opts.MsgName.LowerCamel + "Fee")
+	return name
+}
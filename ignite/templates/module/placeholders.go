@@ -38,4 +38,16 @@ const (
 	PlaceholderTypesGenesisValidField = "// this line is used by starport scaffolding # types/genesis/validField"
 	PlaceholderGenesisTestState       = "// this line is used by starport scaffolding # genesis/test/state"
 	PlaceholderGenesisTestAssert      = "// this line is used by starport scaffolding # genesis/test/assert"
+
+	// Params
+	PlaceholderParamsKeys          = "// this line is used by starport scaffolding # params/keys"
+	PlaceholderParamsNewArgs       = "// this line is used by starport scaffolding # params/new/args"
+	PlaceholderParamsNewFields     = "// this line is used by starport scaffolding # params/new/fields"
+	PlaceholderParamsDefaults      = "// this line is used by starport scaffolding # params/defaults"
+	PlaceholderParamsPairs         = "// this line is used by starport scaffolding # params/pairs"
+	PlaceholderParamsValidate      = "// this line is used by starport scaffolding # params/validate"
+	PlaceholderParamsValidateFuncs = "// this line is used by starport scaffolding # params/validateFuncs"
+	PlaceholderParamsGetterArgs    = "// this line is used by starport scaffolding # params/getter/args"
+	PlaceholderParamsGetters       = "// this line is used by starport scaffolding # params/getters"
+	PlaceholderParamsProtoField    = "// this line is used by starport scaffolding # params/proto/field"
 )
@@ -0,0 +1,68 @@
+package list
+
+import (
+	"context"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gobuffalo/genny"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite-hq/cli/ignite/pkg/multiformatname"
+	"github.com/ignite-hq/cli/ignite/pkg/xgenny"
+	"github.com/ignite-hq/cli/ignite/templates/field"
+	"github.com/ignite-hq/cli/ignite/templates/typed"
+)
+
+var update = flag.Bool("update", false, "update the golden files")
+
+// TestStargateComponentGolden guards the output of the scaffolded proto type, imports must be
+// sorted whatever the order of the fields is so scaffolding is reproducible across machines.
+func TestStargateComponentGolden(t *testing.T) {
+	fields, err := field.ParseFields(
+		[]string{"zeta:ZetaType", "amount:coin", "alpha:AlphaType", "count:uint"},
+		func(string) error { return nil },
+	)
+	require.NoError(t, err)
+
+	typeName, err := multiformatname.NewName("post")
+	require.NoError(t, err)
+	msgSigner, err := multiformatname.NewName("creator")
+	require.NoError(t, err)
+
+	opts := &typed.Options{
+		AppName:    "mars",
+		AppPath:    "mars",
+		ModuleName: "blog",
+		ModulePath: "github.com/ignite-hq/mars",
+		TypeName:   typeName,
+		MsgSigner:  msgSigner,
+		Fields:     fields,
+	}
+
+	render := func() string {
+		g := genny.New()
+		require.NoError(t, typed.Box(xgenny.NewEmbedWalker(fsStargateComponent, "stargate/component/", opts.AppPath), opts, g))
+
+		r := genny.DryRunner(context.Background())
+		require.NoError(t, r.With(g))
+		require.NoError(t, r.Run())
+
+		f, err := r.FindFile(filepath.Join(opts.AppPath, "proto", "blog", "post.proto"))
+		require.NoError(t, err)
+		return f.String()
+	}
+
+	got := render()
+	require.Equal(t, got, render(), "scaffolded output must be deterministic")
+
+	goldenPath := filepath.Join("testdata", "post.proto.golden")
+	if *update {
+		require.NoError(t, os.WriteFile(goldenPath, []byte(got), 0644))
+	}
+	want, err := os.ReadFile(goldenPath)
+	require.NoError(t, err)
+	require.Equal(t, string(want), got)
+}
@@ -156,8 +156,29 @@ func protoRPCModify(replacer placeholder.Replacer, opts *typed.Options) genny.Ru
 
 %[1]v`
 		appModulePath := gomodulepath.ExtractAppPath(opts.ModulePath)
+
+		// Add a service to look up the type by each secondary index
+		templateSecondaryService := `// Queries a list of %[1]v items by %[5]v.
+	rpc %[1]vBy%[6]v(Query%[1]vBy%[6]vRequest) returns (Query%[1]vBy%[6]vResponse) {
+		option (google.api.http).get = "/%[2]v/%[3]v/%[4]v_by_%[7]v/{%[7]v}";
+	}
+
+`
+		var secondaryServices string
+		for _, index := range opts.SecondaryIndexes {
+			secondaryServices += fmt.Sprintf(templateSecondaryService,
+				opts.TypeName.UpperCamel,
+				appModulePath,
+				opts.ModuleName,
+				opts.TypeName.Snake,
+				index.Name.LowerCamel,
+				index.Name.UpperCamel,
+				index.ProtoFieldName(),
+			)
+		}
+
 		replacementService := fmt.Sprintf(templateService,
-			typed.Placeholder2,
+			secondaryServices+typed.Placeholder2,
 			opts.TypeName.UpperCamel,
 			appModulePath,
 			opts.ModuleName,
@@ -205,8 +226,29 @@ message QueryAll%[2]vResponse {
 }
 
 %[1]v`
+		templateSecondaryMessage := `message Query%[1]vBy%[3]vRequest {
+	%[4]v;
+	cosmos.base.query.v1beta1.PageRequest pagination = 2;
+}
+
+message Query%[1]vBy%[3]vResponse {
+	repeated %[1]v %[2]v = 1 [(gogoproto.nullable) = false];
+	cosmos.base.query.v1beta1.PageResponse pagination = 2;
+}
+
+`
+		var secondaryMessages string
+		for _, index := range opts.SecondaryIndexes {
+			secondaryMessages += fmt.Sprintf(templateSecondaryMessage,
+				opts.TypeName.UpperCamel,
+				opts.TypeName.LowerCamel,
+				index.Name.UpperCamel,
+				index.ProtoType(1),
+			)
+		}
+
 		replacementMessage := fmt.Sprintf(templateMessage,
-			typed.Placeholder3,
+			secondaryMessages+typed.Placeholder3,
 			opts.TypeName.UpperCamel,
 			opts.TypeName.LowerCamel,
 			queryIndexFields,
@@ -246,7 +288,11 @@ func clientCliQueryModify(replacer placeholder.Replacer, opts *typed.Options) ge
 		template := `cmd.AddCommand(CmdList%[2]v())
 	cmd.AddCommand(CmdShow%[2]v())
 %[1]v`
-		replacement := fmt.Sprintf(template, typed.Placeholder,
+		var secondaryCommands string
+		for _, index := range opts.SecondaryIndexes {
+			secondaryCommands += fmt.Sprintf("cmd.AddCommand(CmdList%vBy%v())\n\t", opts.TypeName.UpperCamel, index.Name.UpperCamel)
+		}
+		replacement := fmt.Sprintf(template, secondaryCommands+typed.Placeholder,
 			opts.TypeName.UpperCamel,
 		)
 		content := replacer.Replace(f.String(), typed.Placeholder, replacement)
@@ -18,6 +18,15 @@ type Options struct {
 	NoMessage    bool
 	NoSimulation bool
 	IsIBC        bool
+
+	// SecondaryIndexes are the fields of a map type that get their own index to look up entries by them.
+	SecondaryIndexes field.Fields
+
+	// Owned restricts the update and deletion of a value to its owner.
+	Owned bool
+
+	// Admin is an address allowed to update and delete any value besides its owner.
+	Admin string
 }
 
 // Validate that options are usable
@@ -36,6 +36,12 @@ var (
 	Head = "-"
 )
 
+// IsRelease reports whether Ignite CLI is a released version rather than a development
+// or a nightly build.
+func IsRelease() bool {
+	return Version != versionDev && Version != versionNightly
+}
+
 // CheckNext checks whether there is a new version of Ignite CLI.
 func CheckNext(ctx context.Context) (isAvailable bool, version string, err error) {
 	if Version == versionDev || Version == versionNightly {
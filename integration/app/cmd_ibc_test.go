@@ -221,6 +221,26 @@ func TestCreateIBCPacket(t *testing.T) {
 		)),
 	))
 
+	env.Must(env.Exec("create a packet with a typed acknowledgment error",
+		step.NewSteps(step.New(
+			step.Exec(
+				envtest.IgniteApp,
+				"s",
+				"packet",
+				"--yes",
+				"baz",
+				"text",
+				"--module",
+				"foo",
+				"--ack",
+				"foo:string",
+				"--ack-error",
+				"code:uint,reason",
+			),
+			step.Workdir(path),
+		)),
+	))
+
 	env.Must(env.Exec("should prevent creating a packet with no module specified",
 		step.NewSteps(step.New(
 			step.Exec(envtest.IgniteApp, "s", "packet", "--yes", "bar", "text"),
@@ -70,6 +70,29 @@ func TestGenerateAnAppWithStargateWithListAndVerify(t *testing.T) {
 		)),
 	))
 
+	env.Must(env.Exec("create a list with an admin",
+		step.NewSteps(step.New(
+			step.Exec(envtest.IgniteApp, "s", "list", "--yes", "ticket", "title", "--signer", "owner",
+				"--admin", "cosmos1adn9gxjmrc3hrsdx5zpc9sj2ra7kgqkmphf8yw"),
+			step.Workdir(path),
+		)),
+	))
+
+	env.Must(env.Exec("create a list that is not owned",
+		step.NewSteps(step.New(
+			step.Exec(envtest.IgniteApp, "s", "list", "--yes", "note", "text", "--owned=false"),
+			step.Workdir(path),
+		)),
+	))
+
+	env.Must(env.Exec("should prevent creating a list with an invalid admin address",
+		step.NewSteps(step.New(
+			step.Exec(envtest.IgniteApp, "s", "list", "--yes", "review", "text", "--admin", "foo"),
+			step.Workdir(path),
+		)),
+		envtest.ExecShouldError(),
+	))
+
 	env.Must(env.Exec("should prevent creating a list with duplicated fields",
 		step.NewSteps(step.New(
 			step.Exec(envtest.IgniteApp, "s", "list", "--yes", "company", "name", "name"),
@@ -148,6 +148,34 @@ func TestCreateMapWithStargate(t *testing.T) {
 		envtest.ExecShouldError(),
 	))
 
+	env.Must(env.Exec("create a map with secondary indexes",
+		step.NewSteps(step.New(
+			step.Exec(
+				envtest.IgniteApp,
+				"s",
+				"map",
+				"--yes",
+				"map_with_secondary_index",
+				"author",
+				"score:uint",
+				"tags:strings",
+				"--index-by",
+				"author,score",
+				"--module",
+				"example",
+			),
+			step.Workdir(path),
+		)),
+	))
+
+	env.Must(env.Exec("should prevent creating a map with a secondary index on a non indexable field",
+		step.NewSteps(step.New(
+			step.Exec(envtest.IgniteApp, "s", "map", "--yes", "map_with_invalid_secondary_index", "tags:strings", "--index-by", "tags"),
+			step.Workdir(path),
+		)),
+		envtest.ExecShouldError(),
+	))
+
 	env.Must(env.Exec("create a message and a map with no-message flag to check conflicts",
 		step.NewSteps(step.New(
 			step.Exec(envtest.IgniteApp, "s", "message", "--yes", "create-scavenge", "description"),
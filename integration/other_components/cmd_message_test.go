@@ -130,5 +130,28 @@ func TestGenerateAnAppWithMessage(t *testing.T) {
 		)),
 	))
 
+	env.Must(env.Exec("should prevent creating a message with a fee in a module without bank",
+		step.NewSteps(step.New(
+			step.Exec(envtest.IgniteApp, "s", "message", "--yes", "pay-foo", "--module", "foo", "--fee", "10token"),
+			step.Workdir(path),
+		)),
+		envtest.ExecShouldError(),
+	))
+
+	env.Must(env.Exec("create a module with bank",
+		step.NewSteps(step.New(
+			step.Exec(envtest.IgniteApp, "s", "module", "--yes", "bar", "--dep", "bank", "--require-registration"),
+			step.Workdir(path),
+		)),
+	))
+
+	env.Must(env.Exec("create messages with a fee",
+		step.NewSteps(step.New(
+			step.Exec(envtest.IgniteApp, "s", "message", "--yes", "pay-bar", "text", "--module", "bar", "--fee", "10token"),
+			step.Exec(envtest.IgniteApp, "s", "message", "--yes", "pay-baz", "--module", "bar", "--fee", "5stake"),
+			step.Workdir(path),
+		)),
+	))
+
 	env.EnsureAppIsSteady(path)
 }
@@ -0,0 +1,85 @@
+//go:build !relayer
+// +build !relayer
+
+package testapp_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	envtest "github.com/ignite-hq/cli/integration"
+)
+
+// appTest delivers transactions and advances blocks with the in-process test app.
+const appTest = `package app_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/test/planet/testutil/app"
+)
+
+func TestSend(t *testing.T) {
+	a := app.New(t)
+	alice, bob := a.Account("alice"), a.Account("bob")
+
+	res, err := a.DeliverMsgs(alice, banktypes.NewMsgSend(
+		alice.Address,
+		bob.Address,
+		sdk.NewCoins(sdk.NewInt64Coin("token", 10)),
+	))
+	require.NoError(t, err)
+	app.RequireEvent(t, res.Events, "transfer", map[string]string{
+		"recipient": bob.Address.String(),
+	})
+	app.RequireNoEvent(t, res.Events, "transfer", map[string]string{
+		"recipient": alice.Address.String(),
+	})
+
+	balance := a.BankKeeper.GetBalance(a.Ctx(), bob.Address, "token")
+	require.Equal(t, int64(100_000_010), balance.Amount.Int64())
+
+	// the sequence of the signer is incremented once the block is committed.
+	a.NextBlock()
+	_, err = a.DeliverMsgs(alice, banktypes.NewMsgSend(
+		alice.Address,
+		bob.Address,
+		sdk.NewCoins(sdk.NewInt64Coin("token", 10)),
+	))
+	require.NoError(t, err)
+}
+
+func TestAdvanceBlocks(t *testing.T) {
+	a := app.New(t, app.WithAccounts("carol"))
+	require.Equal(t, "carol", a.Accounts[0].Name)
+
+	height, blockTime := a.Height(), a.Ctx().BlockTime()
+
+	a.AdvanceBlocks(3)
+	require.Equal(t, height+3, a.Height())
+	require.Equal(t, blockTime.Add(3*app.BlockTime), a.Ctx().BlockTime())
+
+	a.AdvanceTime(time.Hour)
+	require.Equal(t, height+4, a.Height())
+	require.Equal(t, blockTime.Add(3*app.BlockTime+time.Hour), a.Ctx().BlockTime())
+}
+`
+
+func TestGenerateAnAppWithTestApp(t *testing.T) {
+	var (
+		env  = envtest.New(t)
+		path = env.Scaffold("github.com/test/planet")
+	)
+
+	require.NoError(t, os.WriteFile(filepath.Join(path, "testutil/app/app_test.go"), []byte(appTest), 0644))
+
+	env.EnsureAppIsSteady(path)
+}